@@ -0,0 +1,73 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pagination"
+)
+
+type listDetailRequest struct {
+	PageLmt   int32  `form:"page_lmt" binding:"min=0,max=100"`
+	PageToken string `form:"page_token"`
+}
+
+type listDetailResponse struct {
+	Details       []db.Detail `json:"details"`
+	NextPageToken string      `json:"next_page_token"`
+}
+
+func (server *Server) listDetails(ctx *gin.Context) {
+	var uri getTraderRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listDetailRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	trader, valid := server.ownTrader(ctx, uri.ID)
+	if !valid {
+		return
+	}
+
+	scope := strconv.FormatInt(trader.ID, 10)
+	afterID, err := server.pageTokens.Decode(req.PageToken, "details", scope)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	lmt := pagination.PageLmt(req.PageLmt)
+	arg := db.ListDetailsParams{
+		TraderID: trader.ID,
+		AfterID:  afterID,
+		Limit:    lmt + 1,
+	}
+
+	details, err := server.store.ListDetails(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	details, more := pagination.Page(details, lmt)
+	rsp := listDetailResponse{
+		Details: details,
+	}
+	if more {
+		rsp.NextPageToken, err = server.pageTokens.Encode("details", scope, details[len(details)-1].ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
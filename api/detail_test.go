@@ -0,0 +1,147 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/YuanData/allegro-trade/db/mock"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pagination"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func TestListDetailsAPI(t *testing.T) {
+	member, _ := randomMember(t)
+	otherMember, _ := randomMember(t)
+	trader := randomTrader(member.Membername)
+	otherTrader := randomTrader(otherMember.Membername)
+
+	n := 5
+	details := make([]db.Detail, n)
+	for i := 0; i < n; i++ {
+		details[i] = db.Detail{
+			ID:       int64(i + 1),
+			TraderID: trader.ID,
+			Number:   util.RandomAmount(),
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		traderID      int64
+		pageToken     func(t *testing.T, pageTokens *pagination.TokenSigner) string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder)
+	}{
+		{
+			name:     "Successful",
+			traderID: trader.ID,
+			pageToken: func(t *testing.T, pageTokens *pagination.TokenSigner) string {
+				pageToken, err := pageTokens.Encode("details", fmt.Sprint(trader.ID), 3)
+				require.NoError(t, err)
+				return pageToken
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTrader(gomock.Any(), gomock.Eq(trader.ID)).
+					Times(1).
+					Return(trader, nil)
+
+				arg := db.ListDetailsParams{
+					TraderID: trader.ID,
+					AfterID:  3,
+					Limit:    pagination.DefaultPageLmt + 1,
+				}
+
+				store.EXPECT().
+					ListDetails(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(details[3:], nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp listDetailResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &rsp)
+				require.NoError(t, err)
+				require.Equal(t, details[3:], rsp.Details)
+				require.Empty(t, rsp.NextPageToken)
+			},
+		},
+		{
+			name:     "OtherTraderPageToken",
+			traderID: trader.ID,
+			pageToken: func(t *testing.T, pageTokens *pagination.TokenSigner) string {
+				pageToken, err := pageTokens.Encode("details", fmt.Sprint(otherTrader.ID), 3)
+				require.NoError(t, err)
+				return pageToken
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTrader(gomock.Any(), gomock.Eq(trader.ID)).
+					Times(1).
+					Return(trader, nil)
+
+				store.EXPECT().
+					ListDetails(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "UnauthorizedMember",
+			traderID: otherTrader.ID,
+			pageToken: func(t *testing.T, pageTokens *pagination.TokenSigner) string {
+				return ""
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTrader(gomock.Any(), gomock.Eq(otherTrader.ID)).
+					Times(1).
+					Return(otherTrader, nil)
+
+				store.EXPECT().
+					ListDetails(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/traders/%d/details", tc.traderID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			q := request.URL.Query()
+			q.Add("page_token", tc.pageToken(t, server.pageTokens))
+			request.URL.RawQuery = q.Encode()
+
+			addAuthztn(t, request, server.tokenAuthzr, authztnTypeBearer, member.Membername, member.Role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder)
+		})
+	}
+}
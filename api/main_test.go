@@ -17,12 +17,23 @@ func newTestServer(t *testing.T, store db.Store) *Server {
 		AccessTokenDuration: time.Minute,
 	}
 
-	server, err := NewServer(config, store)
+	server, err := NewServer(config, store, testDecimals)
 	require.NoError(t, err)
 
 	return server
 }
 
+// testDecimals stands in for the symbol registry with the seeded symbols.
+func testDecimals(symbol string) (int32, bool) {
+	switch symbol {
+	case util.BTC:
+		return 8, true
+	case util.ETH:
+		return 9, true
+	}
+	return 0, false
+}
+
 func TestMain(m *testing.M) {
 	gin.SetMode(gin.TestMode)
 
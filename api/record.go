@@ -4,16 +4,23 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/money"
+	"github.com/YuanData/allegro-trade/pagination"
 	"github.com/YuanData/allegro-trade/token"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
 )
 
+const idempotencyKeyHeader = "Idempotency-Key"
+
 type recordRequest struct {
 	FromTraderID int64  `json:"from_trader_id" binding:"required,min=1"`
 	ToTraderID   int64  `json:"to_trader_id" binding:"required,min=1"`
-	Number        int64  `json:"number" binding:"required,gt=0"`
+	Number        string `json:"number" binding:"required"`
 	Symbol      string `json:"symbol" binding:"required,symbol"`
 }
 
@@ -24,6 +31,11 @@ func (server *Server) createRecord(ctx *gin.Context) {
 		return
 	}
 
+	number, valid := server.parseNumber(ctx, req.Number, req.Symbol)
+	if !valid {
+		return
+	}
+
 	fromTrader, valid := server.validTrader(ctx, req.FromTraderID, req.Symbol)
 	if !valid {
 		return
@@ -41,14 +53,45 @@ func (server *Server) createRecord(ctx *gin.Context) {
 		return
 	}
 
+	idempotencyKey := ctx.GetHeader(idempotencyKeyHeader)
+	if idempotencyKey != "" {
+		if err := vld.ValidateIdempotencyKey(idempotencyKey); err != nil {
+			ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("%s %w", idempotencyKeyHeader, err)))
+			return
+		}
+	}
+
 	arg := db.RecordTxParams{
-		FromTraderID: req.FromTraderID,
-		ToTraderID:   req.ToTraderID,
-		Number:        req.Number,
+		FromTraderID:   req.FromTraderID,
+		ToTraderID:     req.ToTraderID,
+		Number:         number,
+		Membername:     authPayload.Membername,
+		IdempotencyKey: idempotencyKey,
 	}
 
 	result, err := server.store.RecordTx(ctx, arg)
+	if errors.Is(err, db.ErrTransferHeld) {
+		ctx.JSON(http.StatusAccepted, result)
+		return
+	}
 	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			ctx.JSON(http.StatusUnprocessableEntity, errorResponse(err))
+			return
+		}
+		var limitErr *db.TransferLimitError
+		if errors.As(err, &limitErr) {
+			ctx.JSON(http.StatusUnprocessableEntity, transferLimitResponse(limitErr))
+			return
+		}
+		if errors.Is(err, db.ErrTraderNotActive) || errors.Is(err, db.ErrTransferRejected) {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		if errors.Is(err, db.ErrIdempotencyKeyReused) {
+			ctx.JSON(http.StatusConflict, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
@@ -56,6 +99,92 @@ func (server *Server) createRecord(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, result)
 }
 
+type listRecordRequest struct {
+	PageLmt   int32  `form:"page_lmt" binding:"min=0,max=100"`
+	PageToken string `form:"page_token"`
+}
+
+type listRecordResponse struct {
+	Records       []db.Record `json:"records"`
+	NextPageToken string      `json:"next_page_token"`
+}
+
+func (server *Server) listRecords(ctx *gin.Context) {
+	var uri getTraderRequest
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	var req listRecordRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	trader, valid := server.ownTrader(ctx, uri.ID)
+	if !valid {
+		return
+	}
+
+	scope := strconv.FormatInt(trader.ID, 10)
+	afterID, err := server.pageTokens.Decode(req.PageToken, "records", scope)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	lmt := pagination.PageLmt(req.PageLmt)
+	arg := db.ListRecordsParams{
+		TraderID: trader.ID,
+		AfterID:  afterID,
+		Limit:    lmt + 1,
+	}
+
+	records, err := server.store.ListRecords(ctx, arg)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return
+	}
+
+	records, more := pagination.Page(records, lmt)
+	rsp := listRecordResponse{
+		Records: records,
+	}
+	if more {
+		rsp.NextPageToken, err = server.pageTokens.Encode("records", scope, records[len(records)-1].ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// ownTrader loads a trader and checks that it is held by the calling member.
+func (server *Server) ownTrader(ctx *gin.Context, traderID int64) (db.Trader, bool) {
+	trader, err := server.store.GetTrader(ctx, traderID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, errorResponse(err))
+			return trader, false
+		}
+
+		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+		return trader, false
+	}
+
+	authPayload := ctx.MustGet(authztnPayloadKey).(*token.Payload)
+	if trader.Holder != authPayload.Membername {
+		err := errors.New("trader not under member")
+		ctx.JSON(http.StatusUnauthorized, errorResponse(err))
+		return trader, false
+	}
+
+	return trader, true
+}
+
 func (server *Server) validTrader(ctx *gin.Context, traderID int64, symbol string) (db.Trader, bool) {
 	trader, err := server.store.GetTrader(ctx, traderID)
 	if err != nil {
@@ -74,5 +203,35 @@ func (server *Server) validTrader(ctx *gin.Context, traderID int64, symbol strin
 		return trader, false
 	}
 
+	if trader.Status != util.ActiveTraderStatus {
+		err := fmt.Errorf("trader [%d] is %s", trader.ID, trader.Status)
+		ctx.JSON(http.StatusForbidden, errorResponse(err))
+		return trader, false
+	}
+
 	return trader, true
 }
+
+// parseNumber converts the decimal number of a transfer into minor units of its
+// symbol. Like the gRPC API it refuses digits beyond the precision of the symbol
+// rather than move a rounded amount.
+func (server *Server) parseNumber(ctx *gin.Context, value string, symbol string) (int64, bool) {
+	decimals, ok := server.decimals(symbol)
+	if !ok {
+		err := fmt.Errorf("unknown symbol %s", symbol)
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return 0, false
+	}
+
+	number, err := money.Parse(value, decimals, money.RoundExact)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(fmt.Errorf("number %w", err)))
+		return 0, false
+	}
+	if number <= 0 {
+		ctx.JSON(http.StatusBadRequest, errorResponse(errors.New("number must be positive")))
+		return 0, false
+	}
+
+	return number, true
+}
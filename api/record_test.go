@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -14,12 +15,14 @@ import (
 	"github.com/stretchr/testify/require"
 	mockdb "github.com/YuanData/allegro-trade/db/mock"
 	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pagination"
 	"github.com/YuanData/allegro-trade/token"
 	"github.com/YuanData/allegro-trade/util"
 )
 
 func TestRecordAPI(t *testing.T) {
-	number := int64(10)
+	number := "0.5"
+	minorNumber := int64(500_000_000) // 0.5 ETH at 9 decimals
 
 	member1, _ := randomMember(t)
 	member2, _ := randomMember(t)
@@ -33,6 +36,9 @@ func TestRecordAPI(t *testing.T) {
 	trader2.Symbol = util.ETH
 	trader3.Symbol = util.BTC
 
+	frozenTrader := trader2
+	frozenTrader.Status = util.FrozenTraderStatus
+
 	testCases := []struct {
 		name          string
 		body          gin.H
@@ -58,7 +64,8 @@ func TestRecordAPI(t *testing.T) {
 				arg := db.RecordTxParams{
 					FromTraderID: trader1.ID,
 					ToTraderID:   trader2.ID,
-					Number:        number,
+					Number:        minorNumber,
+					Membername:   member1.Membername,
 				}
 				store.EXPECT().RecordTx(gomock.Any(), gomock.Eq(arg)).Times(1)
 			},
@@ -66,6 +73,56 @@ func TestRecordAPI(t *testing.T) {
 				require.Equal(t, http.StatusOK, recorder.Code)
 			},
 		},
+		{
+			name: "WithIdempotencyKey",
+			body: gin.H{
+				"from_trader_id": trader1.ID,
+				"to_trader_id":   trader2.ID,
+				"number":          number,
+				"symbol":        util.ETH,
+			},
+			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
+				addAuthztn(t, request, tokenAuthzr, authztnTypeBearer, member1.Membername, member1.Role, time.Minute)
+				request.Header.Set(idempotencyKeyHeader, "retry-key")
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(trader1.ID)).Times(1).Return(trader1, nil)
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(trader2.ID)).Times(1).Return(trader2, nil)
+
+				arg := db.RecordTxParams{
+					FromTraderID:   trader1.ID,
+					ToTraderID:     trader2.ID,
+					Number:         minorNumber,
+					Membername:     member1.Membername,
+					IdempotencyKey: "retry-key",
+				}
+				store.EXPECT().RecordTx(gomock.Any(), gomock.Eq(arg)).Times(1)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "IdempotencyKeyReused",
+			body: gin.H{
+				"from_trader_id": trader1.ID,
+				"to_trader_id":   trader2.ID,
+				"number":          number,
+				"symbol":        util.ETH,
+			},
+			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
+				addAuthztn(t, request, tokenAuthzr, authztnTypeBearer, member1.Membername, member1.Role, time.Minute)
+				request.Header.Set(idempotencyKeyHeader, "retry-key")
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(trader1.ID)).Times(1).Return(trader1, nil)
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(trader2.ID)).Times(1).Return(trader2, nil)
+				store.EXPECT().RecordTx(gomock.Any(), gomock.Any()).Times(1).Return(db.RecordTxResult{}, db.ErrIdempotencyKeyReused)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
 		{
 			name: "MemberNotAuthorized",
 			body: gin.H{
@@ -184,6 +241,26 @@ func TestRecordAPI(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name: "ToTraderFrozen",
+			body: gin.H{
+				"from_trader_id": trader1.ID,
+				"to_trader_id":   frozenTrader.ID,
+				"number":          number,
+				"symbol":        util.ETH,
+			},
+			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
+				addAuthztn(t, request, tokenAuthzr, authztnTypeBearer, member1.Membername, member1.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(trader1.ID)).Times(1).Return(trader1, nil)
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(frozenTrader.ID)).Times(1).Return(frozenTrader, nil)
+				store.EXPECT().RecordTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
 		{
 			name: "InvalidSymbol",
 			body: gin.H{
@@ -208,7 +285,26 @@ func TestRecordAPI(t *testing.T) {
 			body: gin.H{
 				"from_trader_id": trader1.ID,
 				"to_trader_id":   trader2.ID,
-				"number":          -number,
+				"number":          "-" + number,
+				"symbol":        util.ETH,
+			},
+			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
+				addAuthztn(t, request, tokenAuthzr, authztnTypeBearer, member1.Membername, member1.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().RecordTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "NumberTooPrecise",
+			body: gin.H{
+				"from_trader_id": trader1.ID,
+				"to_trader_id":   trader2.ID,
+				"number":          "0.0000000001",
 				"symbol":        util.ETH,
 			},
 			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
@@ -261,6 +357,117 @@ func TestRecordAPI(t *testing.T) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
+		{
+			name: "InsufficientFunds",
+			body: gin.H{
+				"from_trader_id": trader1.ID,
+				"to_trader_id":   trader2.ID,
+				"number":          number,
+				"symbol":        util.ETH,
+			},
+			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
+				addAuthztn(t, request, tokenAuthzr, authztnTypeBearer, member1.Membername, member1.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(trader1.ID)).Times(1).Return(trader1, nil)
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(trader2.ID)).Times(1).Return(trader2, nil)
+				store.EXPECT().RecordTx(gomock.Any(), gomock.Any()).Times(1).Return(db.RecordTxResult{}, db.ErrInsufficientFunds)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "TransferHeld",
+			body: gin.H{
+				"from_trader_id": trader1.ID,
+				"to_trader_id":   trader2.ID,
+				"number":          number,
+				"symbol":        util.ETH,
+			},
+			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
+				addAuthztn(t, request, tokenAuthzr, authztnTypeBearer, member1.Membername, member1.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				result := db.RecordTxResult{
+					FromTrader: trader1,
+					Review: db.TransferReview{
+						ID:           util.RandomInt(1, 1000),
+						FromTraderID: trader1.ID,
+						ToTraderID:   trader2.ID,
+						Number:       minorNumber,
+						Status:       util.PendingReviewStatus,
+					},
+				}
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(trader1.ID)).Times(1).Return(trader1, nil)
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(trader2.ID)).Times(1).Return(trader2, nil)
+				store.EXPECT().RecordTx(gomock.Any(), gomock.Any()).Times(1).Return(result, db.ErrTransferHeld)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusAccepted, recorder.Code)
+
+				var rsp db.RecordTxResult
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+				require.NotZero(t, rsp.Review.ID)
+				require.Zero(t, rsp.Record.ID)
+			},
+		},
+		{
+			name: "TransferRejected",
+			body: gin.H{
+				"from_trader_id": trader1.ID,
+				"to_trader_id":   trader2.ID,
+				"number":          number,
+				"symbol":        util.ETH,
+			},
+			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
+				addAuthztn(t, request, tokenAuthzr, authztnTypeBearer, member1.Membername, member1.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(trader1.ID)).Times(1).Return(trader1, nil)
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(trader2.ID)).Times(1).Return(trader2, nil)
+				store.EXPECT().RecordTx(gomock.Any(), gomock.Any()).Times(1).Return(db.RecordTxResult{}, db.ErrTransferRejected)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name: "TransferLimitExceeded",
+			body: gin.H{
+				"from_trader_id": trader1.ID,
+				"to_trader_id":   trader2.ID,
+				"number":          number,
+				"symbol":        util.ETH,
+			},
+			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
+				addAuthztn(t, request, tokenAuthzr, authztnTypeBearer, member1.Membername, member1.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				limitErr := &db.TransferLimitError{
+					Holder:    member1.Membername,
+					Symbol:    util.ETH,
+					Period:    util.DailyLimitPeriod,
+					Limit:     100,
+					Sent:      95,
+					Remaining: 5,
+				}
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(trader1.ID)).Times(1).Return(trader1, nil)
+				store.EXPECT().GetTrader(gomock.Any(), gomock.Eq(trader2.ID)).Times(1).Return(trader2, nil)
+				store.EXPECT().RecordTx(gomock.Any(), gomock.Any()).Times(1).Return(db.RecordTxResult{}, limitErr)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+
+				var rsp struct {
+					Period    string `json:"period"`
+					Remaining int64  `json:"remaining"`
+				}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &rsp))
+				require.Equal(t, util.DailyLimitPeriod, rsp.Period)
+				require.Equal(t, int64(5), rsp.Remaining)
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -289,3 +496,163 @@ func TestRecordAPI(t *testing.T) {
 		})
 	}
 }
+
+func TestListRecordsAPI(t *testing.T) {
+	member, _ := randomMember(t)
+	otherMember, _ := randomMember(t)
+	trader := randomTrader(member.Membername)
+	otherTrader := randomTrader(otherMember.Membername)
+
+	n := 5
+	records := make([]db.Record, n)
+	for i := 0; i < n; i++ {
+		records[i] = db.Record{
+			ID:           int64(i + 1),
+			FromTraderID: trader.ID,
+			ToTraderID:   otherTrader.ID,
+			Number:       util.RandomAmount(),
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		traderID      int64
+		pageLmt       int
+		pageToken     string
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(recoder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner)
+	}{
+		{
+			name:     "Successful",
+			traderID: trader.ID,
+			pageLmt:  n - 1,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTrader(gomock.Any(), gomock.Eq(trader.ID)).
+					Times(1).
+					Return(trader, nil)
+
+				arg := db.ListRecordsParams{
+					TraderID: trader.ID,
+					AfterID:  0,
+					Limit:    int32(n),
+				}
+
+				store.EXPECT().
+					ListRecords(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(records, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var rsp listRecordResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &rsp)
+				require.NoError(t, err)
+				require.Equal(t, records[:n-1], rsp.Records)
+
+				afterID, err := pageTokens.Decode(rsp.NextPageToken, "records", fmt.Sprint(trader.ID))
+				require.NoError(t, err)
+				require.Equal(t, records[n-2].ID, afterID)
+			},
+		},
+		{
+			name:     "UnauthorizedMember",
+			traderID: otherTrader.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTrader(gomock.Any(), gomock.Eq(otherTrader.ID)).
+					Times(1).
+					Return(otherTrader, nil)
+
+				store.EXPECT().
+					ListRecords(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:     "TraderNotFound",
+			traderID: trader.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTrader(gomock.Any(), gomock.Eq(trader.ID)).
+					Times(1).
+					Return(db.Trader{}, db.ErrRecordNotFound)
+
+				store.EXPECT().
+					ListRecords(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+		{
+			name:      "WrongPageToken",
+			traderID:  trader.ID,
+			pageToken: "wrong.token",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTrader(gomock.Any(), gomock.Eq(trader.ID)).
+					Times(1).
+					Return(trader, nil)
+
+				store.EXPECT().
+					ListRecords(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:     "ServerFailure",
+			traderID: trader.ID,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					GetTrader(gomock.Any(), gomock.Eq(trader.ID)).
+					Times(1).
+					Return(trader, nil)
+
+				store.EXPECT().
+					ListRecords(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]db.Record{}, sql.ErrConnDone)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
+				require.Equal(t, http.StatusInternalServerError, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store)
+			recorder := httptest.NewRecorder()
+
+			url := fmt.Sprintf("/traders/%d/records", tc.traderID)
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			q := request.URL.Query()
+			q.Add("page_lmt", fmt.Sprintf("%d", tc.pageLmt))
+			q.Add("page_token", tc.pageToken)
+			request.URL.RawQuery = q.Encode()
+
+			addAuthztn(t, request, server.tokenAuthzr, authztnTypeBearer, member.Membername, member.Role, time.Minute)
+			server.router.ServeHTTP(recorder, request)
+			tc.checkResponse(recorder, server.pageTokens)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pagination"
 	"github.com/YuanData/allegro-trade/token"
 	"github.com/YuanData/allegro-trade/util"
 )
@@ -15,19 +16,29 @@ type Server struct {
 	config     util.Config
 	store      db.Store
 	tokenAuthzr token.Authzr
+	pageTokens *pagination.TokenSigner
 	router     *gin.Engine
+	// decimals tells the precision amounts of a symbol are written with
+	decimals func(symbol string) (int32, bool)
 }
 
-func NewServer(config util.Config, store db.Store) (*Server, error) {
+func NewServer(config util.Config, store db.Store, decimals func(symbol string) (int32, bool)) (*Server, error) {
 	tokenAuthzr, err := token.NewJWTAuthzr(config.TokenSecretKey)
 	if err != nil {
 		return nil, fmt.Errorf("token authzr err: %w", err)
 	}
 
+	pageTokens, err := pagination.NewTokenSigner(config.TokenSecretKey)
+	if err != nil {
+		return nil, fmt.Errorf("page token signer err: %w", err)
+	}
+
 	server := &Server{
 		config:     config,
 		store:      store,
 		tokenAuthzr: tokenAuthzr,
+		pageTokens: pageTokens,
+		decimals:   decimals,
 	}
 
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
@@ -49,6 +60,8 @@ func (server *Server) setupRouter() {
 	authztnRoutes.POST("/traders", server.createTrader)
 	authztnRoutes.GET("/traders/:id", server.getTrader)
 	authztnRoutes.GET("/traders", server.listTraders)
+	authztnRoutes.GET("/traders/:id/records", server.listRecords)
+	authztnRoutes.GET("/traders/:id/details", server.listDetails)
 
 	authztnRoutes.POST("/records", server.createRecord)
 
@@ -62,3 +75,13 @@ func (server *Server) Start(address string) error {
 func errorResponse(err error) gin.H {
 	return gin.H{"err resp": err.Error()}
 }
+
+func transferLimitResponse(err *db.TransferLimitError) gin.H {
+	return gin.H{
+		"err resp":  err.Error(),
+		"period":    err.Period,
+		"limit":     err.Limit,
+		"sent":      err.Sent,
+		"remaining": err.Remaining,
+	}
+}
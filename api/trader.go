@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pagination"
 	"github.com/YuanData/allegro-trade/token"
 )
 
@@ -74,8 +75,13 @@ func (server *Server) getTrader(ctx *gin.Context) {
 }
 
 type listTraderRequest struct {
-	PageNum   int32 `form:"page_num" binding:"required,min=1"`
-	PageLmt int32 `form:"page_lmt" binding:"required,min=5,max=10"`
+	PageLmt   int32  `form:"page_lmt" binding:"min=0,max=100"`
+	PageToken string `form:"page_token"`
+}
+
+type listTraderResponse struct {
+	Traders       []db.Trader `json:"traders"`
+	NextPageToken string      `json:"next_page_token"`
 }
 
 func (server *Server) listTraders(ctx *gin.Context) {
@@ -86,10 +92,17 @@ func (server *Server) listTraders(ctx *gin.Context) {
 	}
 
 	authPayload := ctx.MustGet(authztnPayloadKey).(*token.Payload)
+	afterID, err := server.pageTokens.Decode(req.PageToken, "traders", authPayload.Membername)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, errorResponse(err))
+		return
+	}
+
+	lmt := pagination.PageLmt(req.PageLmt)
 	arg := db.ListTradersParams{
 		Holder:  authPayload.Membername,
-		Limit:  req.PageLmt,
-		Offset: (req.PageNum - 1) * req.PageLmt,
+		AfterID: afterID,
+		Limit:   lmt + 1,
 	}
 
 	traders, err := server.store.ListTraders(ctx, arg)
@@ -98,5 +111,17 @@ func (server *Server) listTraders(ctx *gin.Context) {
 		return
 	}
 
-	ctx.JSON(http.StatusOK, traders)
+	traders, more := pagination.Page(traders, lmt)
+	rsp := listTraderResponse{
+		Traders: traders,
+	}
+	if more {
+		rsp.NextPageToken, err = server.pageTokens.Encode("traders", authPayload.Membername, traders[len(traders)-1].ID)
+		if err != nil {
+			ctx.JSON(http.StatusInternalServerError, errorResponse(err))
+			return
+		}
+	}
+
+	ctx.JSON(http.StatusOK, rsp)
 }
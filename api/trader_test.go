@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 	mockdb "github.com/YuanData/allegro-trade/db/mock"
 	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pagination"
 	"github.com/YuanData/allegro-trade/token"
 	"github.com/YuanData/allegro-trade/util"
 )
@@ -275,11 +276,12 @@ func TestListTradersAPI(t *testing.T) {
 	traders := make([]db.Trader, n)
 	for i := 0; i < n; i++ {
 		traders[i] = randomTrader(member.Membername)
+		traders[i].ID = int64(i + 1)
 	}
 
 	type Query struct {
-		PageNum   int
-		PageLmt int
+		PageLmt   int
+		PageToken func(t *testing.T, pageTokens *pagination.TokenSigner) string
 	}
 
 	testCases := []struct {
@@ -287,12 +289,11 @@ func TestListTradersAPI(t *testing.T) {
 		query         Query
 		setupAuthztn     func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr)
 		buildStubs    func(store *mockdb.MockStore)
-		checkResponse func(recoder *httptest.ResponseRecorder)
+		checkResponse func(recoder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner)
 	}{
 		{
 			name: "Successful",
 			query: Query{
-				PageNum:   1,
 				PageLmt: n,
 			},
 			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
@@ -301,8 +302,8 @@ func TestListTradersAPI(t *testing.T) {
 			buildStubs: func(store *mockdb.MockStore) {
 				arg := db.ListTradersParams{
 					Holder:  member.Membername,
-					Limit:  int32(n),
-					Offset: 0,
+					AfterID: 0,
+					Limit:   int32(n + 1),
 				}
 
 				store.EXPECT().
@@ -310,15 +311,91 @@ func TestListTradersAPI(t *testing.T) {
 					Times(1).
 					Return(traders, nil)
 			},
-			checkResponse: func(recorder *httptest.ResponseRecorder) {
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				rsp := verifyResponseBufferTraders(t, recorder.Body, traders)
+				require.Empty(t, rsp.NextPageToken)
+			},
+		},
+		{
+			name: "DefaultPageLmt",
+			query: Query{},
+			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
+				addAuthztn(t, request, tokenAuthzr, authztnTypeBearer, member.Membername, member.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListTradersParams{
+					Holder:  member.Membername,
+					AfterID: 0,
+					Limit:   pagination.DefaultPageLmt + 1,
+				}
+
+				store.EXPECT().
+					ListTraders(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(traders, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
 				require.Equal(t, http.StatusOK, recorder.Code)
 				verifyResponseBufferTraders(t, recorder.Body, traders)
 			},
 		},
+		{
+			name: "NextPage",
+			query: Query{
+				PageLmt: n - 1,
+			},
+			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
+				addAuthztn(t, request, tokenAuthzr, authztnTypeBearer, member.Membername, member.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTraders(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(traders, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				rsp := verifyResponseBufferTraders(t, recorder.Body, traders[:n-1])
+
+				afterID, err := pageTokens.Decode(rsp.NextPageToken, "traders", member.Membername)
+				require.NoError(t, err)
+				require.Equal(t, traders[n-2].ID, afterID)
+			},
+		},
+		{
+			name: "ContinuePage",
+			query: Query{
+				PageLmt: n,
+				PageToken: func(t *testing.T, pageTokens *pagination.TokenSigner) string {
+					pageToken, err := pageTokens.Encode("traders", member.Membername, 42)
+					require.NoError(t, err)
+					return pageToken
+				},
+			},
+			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
+				addAuthztn(t, request, tokenAuthzr, authztnTypeBearer, member.Membername, member.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListTradersParams{
+					Holder:  member.Membername,
+					AfterID: 42,
+					Limit:   int32(n + 1),
+				}
+
+				store.EXPECT().
+					ListTraders(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return([]db.Trader{}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				verifyResponseBufferTraders(t, recorder.Body, []db.Trader{})
+			},
+		},
 		{
 			name: "NoAuthztnCredentials",
 			query: Query{
-				PageNum:   1,
 				PageLmt: n,
 			},
 			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
@@ -328,14 +405,13 @@ func TestListTradersAPI(t *testing.T) {
 					ListTraders(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
-			checkResponse: func(recorder *httptest.ResponseRecorder) {
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
 				require.Equal(t, http.StatusUnauthorized, recorder.Code)
 			},
 		},
 		{
 			name: "ServerFailure",
 			query: Query{
-				PageNum:   1,
 				PageLmt: n,
 			},
 			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
@@ -347,15 +423,17 @@ func TestListTradersAPI(t *testing.T) {
 					Times(1).
 					Return([]db.Trader{}, sql.ErrConnDone)
 			},
-			checkResponse: func(recorder *httptest.ResponseRecorder) {
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
 		{
-			name: "WrongPageNum",
+			name: "WrongPageToken",
 			query: Query{
-				PageNum:   -1,
 				PageLmt: n,
+				PageToken: func(t *testing.T, pageTokens *pagination.TokenSigner) string {
+					return "wrong.token"
+				},
 			},
 			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
 				addAuthztn(t, request, tokenAuthzr, authztnTypeBearer, member.Membername, member.Role, time.Minute)
@@ -365,14 +443,35 @@ func TestListTradersAPI(t *testing.T) {
 					ListTraders(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
-			checkResponse: func(recorder *httptest.ResponseRecorder) {
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "OtherMemberPageToken",
+			query: Query{
+				PageLmt: n,
+				PageToken: func(t *testing.T, pageTokens *pagination.TokenSigner) string {
+					pageToken, err := pageTokens.Encode("traders", "other_member", 42)
+					require.NoError(t, err)
+					return pageToken
+				},
+			},
+			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
+				addAuthztn(t, request, tokenAuthzr, authztnTypeBearer, member.Membername, member.Role, time.Minute)
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListTraders(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
 		{
 			name: "WrongPageLmt",
 			query: Query{
-				PageNum:   1,
 				PageLmt: 9999,
 			},
 			setupAuthztn: func(t *testing.T, request *http.Request, tokenAuthzr token.Authzr) {
@@ -383,7 +482,7 @@ func TestListTradersAPI(t *testing.T) {
 					ListTraders(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
-			checkResponse: func(recorder *httptest.ResponseRecorder) {
+			checkResponse: func(recorder *httptest.ResponseRecorder, pageTokens *pagination.TokenSigner) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
@@ -407,13 +506,15 @@ func TestListTradersAPI(t *testing.T) {
 			require.NoError(t, err)
 
 			q := request.URL.Query()
-			q.Add("page_num", fmt.Sprintf("%d", tc.query.PageNum))
 			q.Add("page_lmt", fmt.Sprintf("%d", tc.query.PageLmt))
+			if tc.query.PageToken != nil {
+				q.Add("page_token", tc.query.PageToken(t, server.pageTokens))
+			}
 			request.URL.RawQuery = q.Encode()
 
 			tc.setupAuthztn(t, request, server.tokenAuthzr)
 			server.router.ServeHTTP(recorder, request)
-			tc.checkResponse(recorder)
+			tc.checkResponse(recorder, server.pageTokens)
 		})
 	}
 }
@@ -424,6 +525,7 @@ func randomTrader(holder string) db.Trader {
 		Holder:    holder,
 		Rest:  util.RandomAmount(),
 		Symbol: util.RandomSymbol(),
+		Status: util.ActiveTraderStatus,
 	}
 }
 
@@ -437,12 +539,13 @@ func verifyResponseBufferTrader(t *testing.T, body *bytes.Buffer, trader db.Trad
 	require.Equal(t, trader, gotTrader)
 }
 
-func verifyResponseBufferTraders(t *testing.T, body *bytes.Buffer, traders []db.Trader) {
+func verifyResponseBufferTraders(t *testing.T, body *bytes.Buffer, traders []db.Trader) listTraderResponse {
 	data, err := io.ReadAll(body)
 	require.NoError(t, err)
 
-	var gotTraders []db.Trader
-	err = json.Unmarshal(data, &gotTraders)
+	var rsp listTraderResponse
+	err = json.Unmarshal(data, &rsp)
 	require.NoError(t, err)
-	require.Equal(t, traders, gotTraders)
+	require.Equal(t, traders, rsp.Traders)
+	return rsp
 }
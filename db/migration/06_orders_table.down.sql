@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "fills";
+
+DROP TABLE IF EXISTS "orders";
@@ -0,0 +1,52 @@
+CREATE TABLE "orders" (
+  "id" bigserial PRIMARY KEY,
+  "holder" varchar NOT NULL,
+  "base_trader_id" bigint NOT NULL,
+  "quote_trader_id" bigint NOT NULL,
+  "base_symbol" varchar NOT NULL,
+  "quote_symbol" varchar NOT NULL,
+  "side" varchar NOT NULL,
+  "kind" varchar NOT NULL,
+  "price" bigint NOT NULL DEFAULT 0,
+  "quantity" bigint NOT NULL,
+  "filled" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'open',
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "fills" (
+  "id" bigserial PRIMARY KEY,
+  "maker_order_id" bigint NOT NULL,
+  "taker_order_id" bigint NOT NULL,
+  "price" bigint NOT NULL,
+  "quantity" bigint NOT NULL,
+  "base_record_id" bigint NOT NULL,
+  "quote_record_id" bigint NOT NULL,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "orders" ADD FOREIGN KEY ("holder") REFERENCES "members" ("membername");
+
+ALTER TABLE "orders" ADD FOREIGN KEY ("base_trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "orders" ADD FOREIGN KEY ("quote_trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "fills" ADD FOREIGN KEY ("maker_order_id") REFERENCES "orders" ("id");
+
+ALTER TABLE "fills" ADD FOREIGN KEY ("taker_order_id") REFERENCES "orders" ("id");
+
+ALTER TABLE "fills" ADD FOREIGN KEY ("base_record_id") REFERENCES "records" ("id");
+
+ALTER TABLE "fills" ADD FOREIGN KEY ("quote_record_id") REFERENCES "records" ("id");
+
+CREATE INDEX ON "orders" ("holder");
+
+CREATE INDEX ON "orders" ("base_symbol", "quote_symbol", "status");
+
+CREATE INDEX ON "fills" ("maker_order_id");
+
+CREATE INDEX ON "fills" ("taker_order_id");
+
+COMMENT ON COLUMN "orders"."price" IS 'quote units per base unit, 0 for market orders';
+
+COMMENT ON COLUMN "orders"."quantity" IS 'in base units, must be positive';
@@ -0,0 +1,27 @@
+CREATE TABLE "exchanges" (
+  "id" bigserial PRIMARY KEY,
+  "from_trader_id" bigint NOT NULL,
+  "to_trader_id" bigint NOT NULL,
+  "from_symbol" varchar NOT NULL,
+  "to_symbol" varchar NOT NULL,
+  "from_number" bigint NOT NULL,
+  "to_number" bigint NOT NULL,
+  "rate" bigint NOT NULL,
+  "from_detail_id" bigint NOT NULL,
+  "to_detail_id" bigint NOT NULL,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "exchanges" ADD FOREIGN KEY ("from_trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "exchanges" ADD FOREIGN KEY ("to_trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "exchanges" ADD FOREIGN KEY ("from_detail_id") REFERENCES "details" ("id");
+
+ALTER TABLE "exchanges" ADD FOREIGN KEY ("to_detail_id") REFERENCES "details" ("id");
+
+CREATE INDEX ON "exchanges" ("from_trader_id");
+
+CREATE INDEX ON "exchanges" ("to_trader_id");
+
+COMMENT ON COLUMN "exchanges"."rate" IS 'to_symbol units per from_symbol unit, scaled by 10^8';
@@ -0,0 +1,3 @@
+ALTER TABLE "traders" DROP CONSTRAINT IF EXISTS "overdraft_limit_check";
+
+ALTER TABLE "traders" DROP COLUMN "overdraft_limit";
@@ -0,0 +1,5 @@
+ALTER TABLE "traders" ADD COLUMN "overdraft_limit" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "traders" ADD CONSTRAINT "overdraft_limit_check" CHECK ("overdraft_limit" >= 0);
+
+COMMENT ON COLUMN "traders"."overdraft_limit" IS 'how far below zero rest may go';
@@ -0,0 +1,7 @@
+ALTER TABLE "details" DROP COLUMN "record_id";
+
+ALTER TABLE "records" DROP CONSTRAINT IF EXISTS "membername_idempotency_key";
+
+ALTER TABLE "records" DROP COLUMN "idempotency_key";
+
+ALTER TABLE "records" DROP COLUMN "membername";
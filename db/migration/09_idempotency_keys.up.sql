@@ -0,0 +1,41 @@
+ALTER TABLE "records" ADD COLUMN "membername" varchar;
+
+ALTER TABLE "records" ADD COLUMN "idempotency_key" varchar;
+
+ALTER TABLE "records" ADD FOREIGN KEY ("membername") REFERENCES "members" ("membername");
+
+ALTER TABLE "records" ADD CONSTRAINT "membername_idempotency_key" UNIQUE ("membername", "idempotency_key");
+
+ALTER TABLE "details" ADD COLUMN "record_id" bigint;
+
+ALTER TABLE "details" ADD FOREIGN KEY ("record_id") REFERENCES "records" ("id");
+
+CREATE INDEX ON "details" ("record_id");
+
+-- pair the details written before this column with their records: a transfer
+-- wrote its record and both details in one transaction, so they share the
+-- created_time, and the legs are told apart by trader and amount
+WITH "legs" AS (
+  SELECT "id" AS "record_id", "from_trader_id" AS "trader_id", -"number" AS "number", "created_time" FROM "records"
+  UNION ALL
+  SELECT "id", "to_trader_id", "number", "created_time" FROM "records"
+), "numbered_legs" AS (
+  SELECT *, row_number() OVER (PARTITION BY "trader_id", "number", "created_time" ORDER BY "record_id") AS "n"
+  FROM "legs"
+), "numbered_details" AS (
+  SELECT "id", "trader_id", "number", "created_time",
+    row_number() OVER (PARTITION BY "trader_id", "number", "created_time" ORDER BY "id") AS "n"
+  FROM "details"
+)
+UPDATE "details" SET "record_id" = l."record_id"
+FROM "numbered_details" nd
+JOIN "numbered_legs" l ON
+  l."trader_id" = nd."trader_id" AND
+  l."number" = nd."number" AND
+  l."created_time" = nd."created_time" AND
+  l."n" = nd."n"
+WHERE "details"."id" = nd."id";
+
+COMMENT ON COLUMN "records"."membername" IS 'member who requested the transfer';
+
+COMMENT ON COLUMN "records"."idempotency_key" IS 'client supplied, unique per member';
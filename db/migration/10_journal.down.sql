@@ -0,0 +1,11 @@
+ALTER TABLE "exchanges" DROP COLUMN IF EXISTS "entry_id";
+
+DROP TABLE IF EXISTS "postings";
+
+DROP TABLE IF EXISTS "journal_entries";
+
+DROP FUNCTION IF EXISTS check_journal_entry_balanced();
+
+DELETE FROM "traders" WHERE "holder" = 'allegro_system';
+
+DELETE FROM "members" WHERE "membername" = 'allegro_system';
@@ -0,0 +1,66 @@
+CREATE TABLE "journal_entries" (
+  "id" bigserial PRIMARY KEY,
+  "kind" varchar NOT NULL,
+  "record_id" bigint,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "postings" (
+  "id" bigserial PRIMARY KEY,
+  "entry_id" bigint NOT NULL,
+  "trader_id" bigint NOT NULL,
+  "symbol" varchar NOT NULL,
+  "number" bigint NOT NULL,
+  "detail_id" bigint NOT NULL,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "journal_entries" ADD FOREIGN KEY ("record_id") REFERENCES "records" ("id");
+
+ALTER TABLE "postings" ADD FOREIGN KEY ("entry_id") REFERENCES "journal_entries" ("id");
+
+ALTER TABLE "postings" ADD FOREIGN KEY ("trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "postings" ADD FOREIGN KEY ("detail_id") REFERENCES "details" ("id");
+
+ALTER TABLE "postings" ADD CONSTRAINT "number_check" CHECK ("number" <> 0);
+
+CREATE INDEX ON "journal_entries" ("record_id");
+
+CREATE INDEX ON "postings" ("entry_id");
+
+CREATE INDEX ON "postings" ("trader_id");
+
+ALTER TABLE "exchanges" ADD COLUMN "entry_id" bigint;
+
+ALTER TABLE "exchanges" ADD FOREIGN KEY ("entry_id") REFERENCES "journal_entries" ("id");
+
+COMMENT ON COLUMN "postings"."number" IS 'postings of one entry sum to zero per symbol';
+
+CREATE FUNCTION check_journal_entry_balanced() RETURNS trigger AS $$
+BEGIN
+  IF EXISTS (
+    SELECT 1 FROM postings
+    WHERE entry_id = NEW.entry_id
+    GROUP BY symbol
+    HAVING sum(number) <> 0
+  ) THEN
+    RAISE EXCEPTION 'journal entry % is not balanced', NEW.entry_id;
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER "postings_balanced"
+  AFTER INSERT OR UPDATE ON "postings"
+  DEFERRABLE INITIALLY DEFERRED
+  FOR EACH ROW EXECUTE FUNCTION check_journal_entry_balanced();
+
+INSERT INTO "members" ("membername", "password_hash", "name_entire", "email", "role")
+VALUES ('allegro_system', '!', 'Allegro System', 'system@allegro-trade.invalid', 'system');
+
+INSERT INTO "traders" ("holder", "rest", "symbol", "overdraft_limit")
+VALUES
+  ('allegro_system', 0, 'ETH', 9223372036854775807),
+  ('allegro_system', 0, 'BTC', 9223372036854775807),
+  ('allegro_system', 0, 'ADA', 9223372036854775807);
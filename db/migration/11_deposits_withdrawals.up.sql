@@ -0,0 +1,49 @@
+CREATE TABLE "deposits" (
+  "id" bigserial PRIMARY KEY,
+  "trader_id" bigint NOT NULL,
+  "number" bigint NOT NULL,
+  "reference" varchar NOT NULL,
+  "entry_id" bigint NOT NULL,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "withdrawals" (
+  "id" bigserial PRIMARY KEY,
+  "trader_id" bigint NOT NULL,
+  "number" bigint NOT NULL,
+  "destination" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "entry_id" bigint NOT NULL,
+  "refund_entry_id" bigint,
+  "reviewer" varchar,
+  "reviewed_time" timestamptz,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "deposits" ADD FOREIGN KEY ("trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "deposits" ADD FOREIGN KEY ("entry_id") REFERENCES "journal_entries" ("id");
+
+ALTER TABLE "withdrawals" ADD FOREIGN KEY ("trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "withdrawals" ADD FOREIGN KEY ("entry_id") REFERENCES "journal_entries" ("id");
+
+ALTER TABLE "withdrawals" ADD FOREIGN KEY ("refund_entry_id") REFERENCES "journal_entries" ("id");
+
+ALTER TABLE "withdrawals" ADD FOREIGN KEY ("reviewer") REFERENCES "members" ("membername");
+
+ALTER TABLE "deposits" ADD CONSTRAINT "deposit_number_check" CHECK ("number" > 0);
+
+ALTER TABLE "withdrawals" ADD CONSTRAINT "withdrawal_number_check" CHECK ("number" > 0);
+
+CREATE INDEX ON "deposits" ("trader_id");
+
+CREATE INDEX ON "withdrawals" ("trader_id");
+
+CREATE INDEX ON "withdrawals" ("status");
+
+COMMENT ON COLUMN "deposits"."reference" IS 'id of the transfer on the external rail';
+
+COMMENT ON COLUMN "withdrawals"."status" IS 'pending, approved or rejected';
+
+COMMENT ON COLUMN "withdrawals"."refund_entry_id" IS 'set when a rejected withdrawal was credited back';
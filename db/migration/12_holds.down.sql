@@ -0,0 +1,9 @@
+ALTER TABLE "withdrawals" DROP COLUMN IF EXISTS "hold_id";
+
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "hold_id";
+
+DROP TABLE IF EXISTS "holds";
+
+ALTER TABLE "traders" DROP CONSTRAINT IF EXISTS "held_check";
+
+ALTER TABLE "traders" DROP COLUMN "held";
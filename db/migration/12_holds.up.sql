@@ -0,0 +1,38 @@
+ALTER TABLE "traders" ADD COLUMN "held" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "traders" ADD CONSTRAINT "held_check" CHECK ("held" >= 0);
+
+CREATE TABLE "holds" (
+  "id" bigserial PRIMARY KEY,
+  "trader_id" bigint NOT NULL,
+  "number" bigint NOT NULL,
+  "remaining" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "reason" varchar NOT NULL,
+  "expires_time" timestamptz,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "holds" ADD CONSTRAINT "remaining_check" CHECK ("remaining" >= 0 AND "remaining" <= "number");
+
+CREATE INDEX ON "holds" ("trader_id");
+
+CREATE INDEX ON "holds" ("status", "expires_time");
+
+ALTER TABLE "orders" ADD COLUMN "hold_id" bigint;
+
+ALTER TABLE "orders" ADD FOREIGN KEY ("hold_id") REFERENCES "holds" ("id");
+
+ALTER TABLE "withdrawals" ADD COLUMN "hold_id" bigint;
+
+ALTER TABLE "withdrawals" ADD FOREIGN KEY ("hold_id") REFERENCES "holds" ("id");
+
+ALTER TABLE "withdrawals" ALTER COLUMN "entry_id" DROP NOT NULL;
+
+COMMENT ON COLUMN "traders"."held" IS 'part of rest reserved by active holds';
+
+COMMENT ON COLUMN "holds"."remaining" IS 'part of number not yet released or captured';
+
+COMMENT ON COLUMN "withdrawals"."entry_id" IS 'set when the withdrawal is paid out, or at request time before holds existed';
@@ -0,0 +1,7 @@
+ALTER TABLE "records" ADD COLUMN "reversal_of" bigint;
+
+ALTER TABLE "records" ADD FOREIGN KEY ("reversal_of") REFERENCES "records" ("id");
+
+CREATE INDEX ON "records" ("reversal_of");
+
+COMMENT ON COLUMN "records"."reversal_of" IS 'record this one compensates, in full or in part';
@@ -0,0 +1,48 @@
+CREATE TABLE "scheduled_transfers" (
+  "id" bigserial PRIMARY KEY,
+  "holder" varchar NOT NULL,
+  "from_trader_id" bigint NOT NULL,
+  "to_trader_id" bigint NOT NULL,
+  "number" bigint NOT NULL,
+  "cronspec" varchar NOT NULL,
+  "on_insufficient_funds" varchar NOT NULL DEFAULT 'skip',
+  "active" boolean NOT NULL DEFAULT true,
+  "next_run_time" timestamptz NOT NULL,
+  "last_run_time" timestamptz,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "scheduled_transfer_runs" (
+  "id" bigserial PRIMARY KEY,
+  "schedule_id" bigint NOT NULL,
+  "scheduled_time" timestamptz NOT NULL,
+  "status" varchar NOT NULL,
+  "record_id" bigint,
+  "error" varchar NOT NULL DEFAULT '',
+  "attempts" int NOT NULL,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("holder") REFERENCES "members" ("membername");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("from_trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("to_trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "scheduled_transfer_runs" ADD FOREIGN KEY ("schedule_id") REFERENCES "scheduled_transfers" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "scheduled_transfer_runs" ADD FOREIGN KEY ("record_id") REFERENCES "records" ("id");
+
+ALTER TABLE "scheduled_transfers" ADD CONSTRAINT "scheduled_number_check" CHECK ("number" > 0);
+
+CREATE INDEX ON "scheduled_transfers" ("holder");
+
+CREATE INDEX ON "scheduled_transfers" ("active", "next_run_time");
+
+CREATE UNIQUE INDEX ON "scheduled_transfer_runs" ("schedule_id", "scheduled_time");
+
+COMMENT ON COLUMN "scheduled_transfers"."cronspec" IS 'cron expression, descriptor such as @weekly, or interval such as @every 24h';
+
+COMMENT ON COLUMN "scheduled_transfers"."on_insufficient_funds" IS 'skip the run, or retry it with backoff';
+
+COMMENT ON COLUMN "scheduled_transfer_runs"."status" IS 'succeeded, skipped or failed';
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "escrows";
+
+DELETE FROM "traders" WHERE "holder" = 'allegro_escrow';
+
+DELETE FROM "members" WHERE "membername" = 'allegro_escrow';
@@ -0,0 +1,57 @@
+CREATE TABLE "escrows" (
+  "id" bigserial PRIMARY KEY,
+  "buyer" varchar NOT NULL,
+  "seller" varchar NOT NULL,
+  "buyer_trader_id" bigint NOT NULL,
+  "seller_trader_id" bigint NOT NULL,
+  "escrow_trader_id" bigint NOT NULL,
+  "number" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'open',
+  "buyer_confirmed" boolean NOT NULL DEFAULT false,
+  "seller_confirmed" boolean NOT NULL DEFAULT false,
+  "fund_entry_id" bigint NOT NULL,
+  "settle_entry_id" bigint,
+  "resolver" varchar,
+  "expires_time" timestamptz NOT NULL,
+  "settled_time" timestamptz,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "escrows" ADD FOREIGN KEY ("buyer") REFERENCES "members" ("membername");
+
+ALTER TABLE "escrows" ADD FOREIGN KEY ("seller") REFERENCES "members" ("membername");
+
+ALTER TABLE "escrows" ADD FOREIGN KEY ("buyer_trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "escrows" ADD FOREIGN KEY ("seller_trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "escrows" ADD FOREIGN KEY ("escrow_trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "escrows" ADD FOREIGN KEY ("fund_entry_id") REFERENCES "journal_entries" ("id");
+
+ALTER TABLE "escrows" ADD FOREIGN KEY ("settle_entry_id") REFERENCES "journal_entries" ("id");
+
+ALTER TABLE "escrows" ADD FOREIGN KEY ("resolver") REFERENCES "members" ("membername");
+
+ALTER TABLE "escrows" ADD CONSTRAINT "escrow_number_check" CHECK ("number" > 0);
+
+CREATE INDEX ON "escrows" ("buyer");
+
+CREATE INDEX ON "escrows" ("seller");
+
+CREATE INDEX ON "escrows" ("status", "expires_time");
+
+COMMENT ON COLUMN "escrows"."status" IS 'open, disputed, released, cancelled or expired';
+
+COMMENT ON COLUMN "escrows"."settle_entry_id" IS 'entry that paid the seller or refunded the buyer';
+
+COMMENT ON COLUMN "escrows"."resolver" IS 'priest who settled the escrow after a dispute';
+
+INSERT INTO "members" ("membername", "password_hash", "name_entire", "email", "role")
+VALUES ('allegro_escrow', '!', 'Allegro Escrow', 'escrow@allegro-trade.invalid', 'system');
+
+INSERT INTO "traders" ("holder", "rest", "symbol")
+VALUES
+  ('allegro_escrow', 0, 'ETH'),
+  ('allegro_escrow', 0, 'BTC'),
+  ('allegro_escrow', 0, 'ADA');
@@ -0,0 +1,11 @@
+ALTER TABLE "records" DROP COLUMN IF EXISTS "fee_rule_id";
+
+ALTER TABLE "records" DROP COLUMN IF EXISTS "fee";
+
+DROP TABLE IF EXISTS "fee_tiers";
+
+DROP TABLE IF EXISTS "fee_rules";
+
+DELETE FROM "traders" WHERE "holder" = 'allegro_house';
+
+DELETE FROM "members" WHERE "membername" = 'allegro_house';
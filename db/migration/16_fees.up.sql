@@ -0,0 +1,55 @@
+CREATE TABLE "fee_rules" (
+  "id" bigserial PRIMARY KEY,
+  "symbol" varchar NOT NULL,
+  "role" varchar NOT NULL DEFAULT '',
+  "kind" varchar NOT NULL,
+  "flat_fee" bigint NOT NULL DEFAULT 0,
+  "rate_bps" int NOT NULL DEFAULT 0,
+  "min_fee" bigint NOT NULL DEFAULT 0,
+  "max_fee" bigint NOT NULL DEFAULT 0,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "fee_tiers" (
+  "id" bigserial PRIMARY KEY,
+  "rule_id" bigint NOT NULL,
+  "min_volume" bigint NOT NULL,
+  "rate_bps" int NOT NULL
+);
+
+ALTER TABLE "fee_tiers" ADD FOREIGN KEY ("rule_id") REFERENCES "fee_rules" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "fee_rules" ADD CONSTRAINT "fee_amounts_check" CHECK ("flat_fee" >= 0 AND "min_fee" >= 0 AND "max_fee" >= 0);
+
+ALTER TABLE "fee_rules" ADD CONSTRAINT "fee_rate_check" CHECK ("rate_bps" >= 0 AND "rate_bps" <= 10000);
+
+ALTER TABLE "fee_tiers" ADD CONSTRAINT "tier_rate_check" CHECK ("rate_bps" >= 0 AND "rate_bps" <= 10000);
+
+CREATE UNIQUE INDEX ON "fee_rules" ("symbol", "role");
+
+CREATE UNIQUE INDEX ON "fee_tiers" ("rule_id", "min_volume");
+
+ALTER TABLE "records" ADD COLUMN "fee" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "records" ADD COLUMN "fee_rule_id" bigint;
+
+ALTER TABLE "records" ADD FOREIGN KEY ("fee_rule_id") REFERENCES "fee_rules" ("id") ON DELETE SET NULL;
+
+COMMENT ON COLUMN "fee_rules"."role" IS 'member role the rule applies to, empty for every role without a rule of its own';
+
+COMMENT ON COLUMN "fee_rules"."kind" IS 'flat, percentage or tiered';
+
+COMMENT ON COLUMN "fee_rules"."max_fee" IS 'zero for no maximum';
+
+COMMENT ON COLUMN "fee_tiers"."min_volume" IS 'monthly volume sent from which the tier rate applies';
+
+COMMENT ON COLUMN "records"."fee" IS 'charged to the sender on top of number and credited to the house trader';
+
+INSERT INTO "members" ("membername", "password_hash", "name_entire", "email", "role")
+VALUES ('allegro_house', '!', 'Allegro House', 'house@allegro-trade.invalid', 'system');
+
+INSERT INTO "traders" ("holder", "rest", "symbol")
+VALUES
+  ('allegro_house', 0, 'ETH'),
+  ('allegro_house', 0, 'BTC'),
+  ('allegro_house', 0, 'ADA');
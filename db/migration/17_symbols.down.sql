@@ -0,0 +1,5 @@
+ALTER TABLE IF EXISTS "fee_rules" DROP CONSTRAINT IF EXISTS "fee_rules_symbol_fkey";
+
+ALTER TABLE IF EXISTS "traders" DROP CONSTRAINT IF EXISTS "traders_symbol_fkey";
+
+DROP TABLE IF EXISTS "symbols";
@@ -0,0 +1,31 @@
+CREATE TABLE "symbols" (
+  "code" varchar PRIMARY KEY,
+  "display_name" varchar NOT NULL,
+  "decimals" int NOT NULL,
+  "min_transfer" bigint NOT NULL DEFAULT 1,
+  "enabled" boolean NOT NULL DEFAULT true,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "symbols" ADD CONSTRAINT "decimals_check" CHECK ("decimals" >= 0 AND "decimals" <= 18);
+
+ALTER TABLE "symbols" ADD CONSTRAINT "min_transfer_check" CHECK ("min_transfer" > 0);
+
+COMMENT ON COLUMN "symbols"."decimals" IS 'number of decimal places of one unit, amounts are stored in the smallest unit';
+
+COMMENT ON COLUMN "symbols"."enabled" IS 'disabled symbols keep their traders but accept no new traders, transfers or orders';
+
+INSERT INTO "symbols" ("code", "display_name", "decimals")
+VALUES
+  ('ETH', 'Ether', 18),
+  ('BTC', 'Bitcoin', 8),
+  ('ADA', 'Cardano', 6);
+
+INSERT INTO "symbols" ("code", "display_name", "decimals", "enabled")
+SELECT "symbol", "symbol", 0, false
+FROM (SELECT "symbol" FROM "traders" UNION SELECT "symbol" FROM "fee_rules") AS "used"
+WHERE "symbol" NOT IN (SELECT "code" FROM "symbols");
+
+ALTER TABLE "traders" ADD FOREIGN KEY ("symbol") REFERENCES "symbols" ("code");
+
+ALTER TABLE "fee_rules" ADD FOREIGN KEY ("symbol") REFERENCES "symbols" ("code");
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "details_trader_id_created_time_idx";
+
+DROP TABLE IF EXISTS "balance_snapshots";
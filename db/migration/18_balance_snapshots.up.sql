@@ -0,0 +1,13 @@
+CREATE TABLE "balance_snapshots" (
+  "trader_id" bigint NOT NULL,
+  "snapshot_time" timestamptz NOT NULL,
+  "balance" bigint NOT NULL,
+  "created_time" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("trader_id", "snapshot_time")
+);
+
+ALTER TABLE "balance_snapshots" ADD FOREIGN KEY ("trader_id") REFERENCES "traders" ("id");
+
+CREATE INDEX ON "details" ("trader_id", "created_time");
+
+COMMENT ON COLUMN "balance_snapshots"."balance" IS 'sum of the details of the trader created before snapshot_time';
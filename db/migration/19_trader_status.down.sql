@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS "trader_status_changes";
+
+ALTER TABLE "traders" DROP CONSTRAINT IF EXISTS "trader_status_check";
+
+ALTER TABLE "traders" DROP COLUMN IF EXISTS "status_reason";
+
+ALTER TABLE "traders" DROP COLUMN IF EXISTS "status";
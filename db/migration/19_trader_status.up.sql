@@ -0,0 +1,25 @@
+ALTER TABLE "traders" ADD COLUMN "status" varchar NOT NULL DEFAULT 'active';
+
+ALTER TABLE "traders" ADD COLUMN "status_reason" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "traders" ADD CONSTRAINT "trader_status_check" CHECK ("status" IN ('active', 'frozen', 'closed'));
+
+CREATE TABLE "trader_status_changes" (
+  "id" bigserial PRIMARY KEY,
+  "trader_id" bigint NOT NULL,
+  "from_status" varchar NOT NULL,
+  "to_status" varchar NOT NULL,
+  "reason" varchar NOT NULL,
+  "membername" varchar NOT NULL,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "trader_status_changes" ADD FOREIGN KEY ("trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "trader_status_changes" ADD FOREIGN KEY ("membername") REFERENCES "members" ("membername");
+
+CREATE INDEX ON "trader_status_changes" ("trader_id");
+
+COMMENT ON COLUMN "traders"."status" IS 'active, frozen or closed; only active traders send or receive transfers';
+
+COMMENT ON COLUMN "trader_status_changes"."membername" IS 'priest who changed the status';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "records_from_trader_id_created_time_idx";
+
+DROP TABLE IF EXISTS "transfer_limits";
@@ -0,0 +1,20 @@
+CREATE TABLE "transfer_limits" (
+  "id" bigserial PRIMARY KEY,
+  "symbol" varchar NOT NULL,
+  "role" varchar NOT NULL DEFAULT '',
+  "daily_limit" bigint NOT NULL DEFAULT 0,
+  "monthly_limit" bigint NOT NULL DEFAULT 0,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "transfer_limits" ADD CONSTRAINT "transfer_limits_check" CHECK ("daily_limit" >= 0 AND "monthly_limit" >= 0);
+
+CREATE UNIQUE INDEX ON "transfer_limits" ("symbol", "role");
+
+CREATE INDEX ON "records" ("from_trader_id", "created_time");
+
+COMMENT ON COLUMN "transfer_limits"."role" IS 'member role the limit applies to, empty for every role without a limit of its own';
+
+COMMENT ON COLUMN "transfer_limits"."daily_limit" IS 'most a member may send in any 24 hours, zero for no limit';
+
+COMMENT ON COLUMN "transfer_limits"."monthly_limit" IS 'most a member may send in any 30 days, zero for no limit';
@@ -0,0 +1,3 @@
+COMMENT ON COLUMN "scheduled_transfer_runs"."status" IS 'succeeded, skipped or failed';
+
+DROP TABLE IF EXISTS "transfer_reviews";
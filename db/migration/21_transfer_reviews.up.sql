@@ -0,0 +1,45 @@
+CREATE TABLE "transfer_reviews" (
+  "id" bigserial PRIMARY KEY,
+  "from_trader_id" bigint NOT NULL,
+  "to_trader_id" bigint NOT NULL,
+  "number" bigint NOT NULL,
+  "membername" varchar,
+  "idempotency_key" varchar,
+  "reasons" varchar[] NOT NULL,
+  "hold_id" bigint NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "record_id" bigint,
+  "reviewer" varchar,
+  "reviewed_time" timestamptz,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "transfer_reviews" ADD FOREIGN KEY ("from_trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "transfer_reviews" ADD FOREIGN KEY ("to_trader_id") REFERENCES "traders" ("id");
+
+ALTER TABLE "transfer_reviews" ADD FOREIGN KEY ("membername") REFERENCES "members" ("membername");
+
+ALTER TABLE "transfer_reviews" ADD FOREIGN KEY ("hold_id") REFERENCES "holds" ("id");
+
+ALTER TABLE "transfer_reviews" ADD FOREIGN KEY ("record_id") REFERENCES "records" ("id");
+
+ALTER TABLE "transfer_reviews" ADD FOREIGN KEY ("reviewer") REFERENCES "members" ("membername");
+
+ALTER TABLE "transfer_reviews" ADD CONSTRAINT "transfer_review_number_check" CHECK ("number" > 0);
+
+ALTER TABLE "transfer_reviews" ADD CONSTRAINT "transfer_review_status_check" CHECK ("status" IN ('pending', 'approved', 'rejected'));
+
+ALTER TABLE "transfer_reviews" ADD CONSTRAINT "review_membername_idempotency_key" UNIQUE ("membername", "idempotency_key");
+
+CREATE INDEX ON "transfer_reviews" ("status");
+
+COMMENT ON TABLE "transfer_reviews" IS 'transfers held by screening until a priest approves or rejects them';
+
+COMMENT ON COLUMN "transfer_reviews"."reasons" IS 'screening rules the transfer tripped';
+
+COMMENT ON COLUMN "transfer_reviews"."hold_id" IS 'reserves the number and fee on the sender while the review is pending';
+
+COMMENT ON COLUMN "transfer_reviews"."record_id" IS 'set when the transfer is approved and recorded';
+
+COMMENT ON COLUMN "scheduled_transfer_runs"."status" IS 'succeeded, held, skipped or failed';
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS "transfer_limits_symbol_role_kyc_level_idx";
+
+DELETE FROM "transfer_limits" WHERE "kyc_level" <> '';
+
+ALTER TABLE "transfer_limits" DROP COLUMN IF EXISTS "kyc_level";
+
+CREATE UNIQUE INDEX ON "transfer_limits" ("symbol", "role");
+
+DROP TABLE IF EXISTS "kyc_submissions";
+
+ALTER TABLE "members" DROP COLUMN IF EXISTS "kyc_level";
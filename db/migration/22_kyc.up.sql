@@ -0,0 +1,50 @@
+ALTER TABLE "members" ADD COLUMN "kyc_level" varchar NOT NULL DEFAULT 'none';
+
+ALTER TABLE "members" ADD CONSTRAINT "kyc_level_check" CHECK ("kyc_level" IN ('none', 'basic', 'full'));
+
+-- members who signed up before KYC keep what they could do until then
+UPDATE "members" SET "kyc_level" = 'basic' WHERE "role" <> 'system';
+
+CREATE TABLE "kyc_submissions" (
+  "id" bigserial PRIMARY KEY,
+  "membername" varchar NOT NULL,
+  "level" varchar NOT NULL,
+  "legal_name" varchar NOT NULL,
+  "date_of_birth" date NOT NULL,
+  "country" varchar NOT NULL,
+  "document_type" varchar NOT NULL,
+  "document_number" varchar NOT NULL,
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "reason" varchar NOT NULL DEFAULT '',
+  "reviewer" varchar,
+  "reviewed_time" timestamptz,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "kyc_submissions" ADD FOREIGN KEY ("membername") REFERENCES "members" ("membername");
+
+ALTER TABLE "kyc_submissions" ADD FOREIGN KEY ("reviewer") REFERENCES "members" ("membername");
+
+ALTER TABLE "kyc_submissions" ADD CONSTRAINT "kyc_submission_level_check" CHECK ("level" IN ('basic', 'full'));
+
+ALTER TABLE "kyc_submissions" ADD CONSTRAINT "kyc_submission_status_check" CHECK ("status" IN ('pending', 'approved', 'rejected'));
+
+CREATE INDEX ON "kyc_submissions" ("membername");
+
+CREATE INDEX ON "kyc_submissions" ("status");
+
+CREATE UNIQUE INDEX "kyc_submissions_pending_key" ON "kyc_submissions" ("membername") WHERE "status" = 'pending';
+
+ALTER TABLE "transfer_limits" ADD COLUMN "kyc_level" varchar NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS "transfer_limits_symbol_role_idx";
+
+CREATE UNIQUE INDEX ON "transfer_limits" ("symbol", "role", "kyc_level");
+
+COMMENT ON COLUMN "members"."kyc_level" IS 'none, basic or full, raised when a priest approves a kyc submission';
+
+COMMENT ON COLUMN "kyc_submissions"."level" IS 'level the member asks for';
+
+COMMENT ON COLUMN "kyc_submissions"."reason" IS 'given by the reviewer, shown to the member';
+
+COMMENT ON COLUMN "transfer_limits"."kyc_level" IS 'kyc level the limit applies to, empty for every level without a limit of its own';
@@ -0,0 +1,21 @@
+CREATE TABLE "prices" (
+  "id" bigserial PRIMARY KEY,
+  "base" varchar NOT NULL,
+  "quote" varchar NOT NULL,
+  "rate" bigint NOT NULL,
+  "source" varchar NOT NULL,
+  "price_time" timestamptz NOT NULL,
+  "created_time" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "prices" ADD FOREIGN KEY ("base") REFERENCES "symbols" ("code");
+
+ALTER TABLE "prices" ADD FOREIGN KEY ("quote") REFERENCES "symbols" ("code");
+
+ALTER TABLE "prices" ADD CONSTRAINT "rate_positive" CHECK ("rate" > 0);
+
+CREATE UNIQUE INDEX ON "prices" ("base", "quote", "price_time");
+
+COMMENT ON COLUMN "prices"."rate" IS 'quote per whole base unit, with 8 decimals';
+
+COMMENT ON COLUMN "prices"."source" IS 'the feed the price came from';
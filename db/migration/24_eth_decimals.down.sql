@@ -0,0 +1,75 @@
+-- Amounts rescaled down to 9 decimals multiply back exactly. Overdraft limits
+-- and the minimum transfer that were rounded keep their rounded value, and
+-- balances above about 9.22 ETH no longer fit and abort the migration.
+UPDATE "traders" SET
+  "rest" = "rest" * 1000000000,
+  "held" = "held" * 1000000000,
+  "overdraft_limit" = CASE WHEN "overdraft_limit" = 9223372036854775807 THEN "overdraft_limit" ELSE "overdraft_limit" * 1000000000 END
+WHERE "symbol" = 'ETH';
+
+UPDATE "details" SET "number" = "number" * 1000000000
+WHERE "trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "records" SET "number" = "number" * 1000000000, "fee" = "fee" * 1000000000
+WHERE "from_trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "postings" SET "number" = "number" * 1000000000
+WHERE "symbol" = 'ETH';
+
+UPDATE "deposits" SET "number" = "number" * 1000000000
+WHERE "trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "withdrawals" SET "number" = "number" * 1000000000
+WHERE "trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "holds" SET "number" = "number" * 1000000000, "remaining" = "remaining" * 1000000000
+WHERE "trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "scheduled_transfers" SET "number" = "number" * 1000000000
+WHERE "from_trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "escrows" SET "number" = "number" * 1000000000
+WHERE "escrow_trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "transfer_reviews" SET "number" = "number" * 1000000000
+WHERE "from_trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "balance_snapshots" SET "balance" = "balance" * 1000000000
+WHERE "trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "fee_tiers" SET "min_volume" = "min_volume" * 1000000000
+WHERE "rule_id" IN (SELECT "id" FROM "fee_rules" WHERE "symbol" = 'ETH');
+
+UPDATE "fee_rules" SET
+  "flat_fee" = "flat_fee" * 1000000000,
+  "min_fee" = "min_fee" * 1000000000,
+  "max_fee" = "max_fee" * 1000000000
+WHERE "symbol" = 'ETH';
+
+UPDATE "transfer_limits" SET
+  "daily_limit" = "daily_limit" * 1000000000,
+  "monthly_limit" = "monthly_limit" * 1000000000
+WHERE "symbol" = 'ETH';
+
+UPDATE "exchanges" SET
+  "from_number" = CASE WHEN "from_symbol" = 'ETH' THEN "from_number" * 1000000000 ELSE "from_number" END,
+  "to_number" = CASE WHEN "to_symbol" = 'ETH' THEN "to_number" * 1000000000 ELSE "to_number" END,
+  "rate" = CASE WHEN "from_symbol" = 'ETH' THEN "rate" / 1000000000 ELSE "rate" * 1000000000 END
+WHERE "from_symbol" = 'ETH' OR "to_symbol" = 'ETH';
+
+UPDATE "fills" f SET
+  "quantity" = CASE WHEN o."base_symbol" = 'ETH' THEN f."quantity" * 1000000000 ELSE f."quantity" END,
+  "price" = CASE WHEN o."base_symbol" = 'ETH' THEN f."price" / 1000000000 ELSE f."price" * 1000000000 END
+FROM "orders" o
+WHERE o."id" = f."maker_order_id" AND (o."base_symbol" = 'ETH' OR o."quote_symbol" = 'ETH');
+
+UPDATE "orders" SET
+  "quantity" = CASE WHEN "base_symbol" = 'ETH' THEN "quantity" * 1000000000 ELSE "quantity" END,
+  "filled" = CASE WHEN "base_symbol" = 'ETH' THEN "filled" * 1000000000 ELSE "filled" END,
+  "price" = CASE WHEN "base_symbol" = 'ETH' THEN "price" / 1000000000 ELSE "price" * 1000000000 END
+WHERE "base_symbol" = 'ETH' OR "quote_symbol" = 'ETH';
+
+UPDATE "symbols" SET
+  "decimals" = 18,
+  "min_transfer" = "min_transfer" * 1000000000
+WHERE "code" = 'ETH';
@@ -0,0 +1,129 @@
+-- ETH was seeded with 18 decimals, which caps an int64 amount at about 9.22 ETH.
+-- Rescale it to 9 decimals (gwei), which leaves room for 9.2 billion ETH.
+-- Every stored ETH amount is divided by 10^9 and has to divide exactly, so
+-- dust below one gwei must be settled before migrating or the migration
+-- aborts. Overdraft limits are rounded down, except the unlimited one of the
+-- system trader, and the minimum transfer is rounded up to at least one gwei.
+-- Prices in the smallest unit scale the other way: an ETH base multiplies
+-- order and fill prices and exchange rates from ETH by 10^9, an ETH quote
+-- divides them. Rates in the prices table are per whole unit and stay.
+DO $$
+BEGIN
+  IF EXISTS (
+    SELECT 1 FROM "traders" WHERE "symbol" = 'ETH' AND ("rest" % 1000000000 <> 0 OR "held" % 1000000000 <> 0)
+    UNION ALL
+    SELECT 1 FROM "details" d JOIN "traders" t ON t."id" = d."trader_id" WHERE t."symbol" = 'ETH' AND d."number" % 1000000000 <> 0
+    UNION ALL
+    SELECT 1 FROM "records" r JOIN "traders" t ON t."id" = r."from_trader_id" WHERE t."symbol" = 'ETH' AND (r."number" % 1000000000 <> 0 OR r."fee" % 1000000000 <> 0)
+    UNION ALL
+    SELECT 1 FROM "postings" WHERE "symbol" = 'ETH' AND "number" % 1000000000 <> 0
+    UNION ALL
+    SELECT 1 FROM "deposits" d JOIN "traders" t ON t."id" = d."trader_id" WHERE t."symbol" = 'ETH' AND d."number" % 1000000000 <> 0
+    UNION ALL
+    SELECT 1 FROM "withdrawals" w JOIN "traders" t ON t."id" = w."trader_id" WHERE t."symbol" = 'ETH' AND w."number" % 1000000000 <> 0
+    UNION ALL
+    SELECT 1 FROM "holds" h JOIN "traders" t ON t."id" = h."trader_id" WHERE t."symbol" = 'ETH' AND (h."number" % 1000000000 <> 0 OR h."remaining" % 1000000000 <> 0)
+    UNION ALL
+    SELECT 1 FROM "scheduled_transfers" s JOIN "traders" t ON t."id" = s."from_trader_id" WHERE t."symbol" = 'ETH' AND s."number" % 1000000000 <> 0
+    UNION ALL
+    SELECT 1 FROM "escrows" e JOIN "traders" t ON t."id" = e."escrow_trader_id" WHERE t."symbol" = 'ETH' AND e."number" % 1000000000 <> 0
+    UNION ALL
+    SELECT 1 FROM "transfer_reviews" r JOIN "traders" t ON t."id" = r."from_trader_id" WHERE t."symbol" = 'ETH' AND r."number" % 1000000000 <> 0
+    UNION ALL
+    SELECT 1 FROM "balance_snapshots" b JOIN "traders" t ON t."id" = b."trader_id" WHERE t."symbol" = 'ETH' AND b."balance" % 1000000000 <> 0
+    UNION ALL
+    SELECT 1 FROM "fee_rules" WHERE "symbol" = 'ETH' AND ("flat_fee" % 1000000000 <> 0 OR "min_fee" % 1000000000 <> 0 OR "max_fee" % 1000000000 <> 0)
+    UNION ALL
+    SELECT 1 FROM "fee_tiers" ft JOIN "fee_rules" fr ON fr."id" = ft."rule_id" WHERE fr."symbol" = 'ETH' AND ft."min_volume" % 1000000000 <> 0
+    UNION ALL
+    SELECT 1 FROM "transfer_limits" WHERE "symbol" = 'ETH' AND ("daily_limit" % 1000000000 <> 0 OR "monthly_limit" % 1000000000 <> 0)
+    UNION ALL
+    SELECT 1 FROM "exchanges" WHERE
+      ("from_symbol" = 'ETH' AND "from_number" % 1000000000 <> 0) OR
+      ("to_symbol" = 'ETH' AND ("to_number" % 1000000000 <> 0 OR "rate" % 1000000000 <> 0))
+    UNION ALL
+    SELECT 1 FROM "orders" WHERE
+      ("base_symbol" = 'ETH' AND ("quantity" % 1000000000 <> 0 OR "filled" % 1000000000 <> 0)) OR
+      ("quote_symbol" = 'ETH' AND "price" % 1000000000 <> 0)
+    UNION ALL
+    SELECT 1 FROM "fills" f JOIN "orders" o ON o."id" = f."maker_order_id" WHERE
+      (o."base_symbol" = 'ETH' AND f."quantity" % 1000000000 <> 0) OR
+      (o."quote_symbol" = 'ETH' AND f."price" % 1000000000 <> 0)
+  ) THEN
+    RAISE EXCEPTION 'ETH amounts below one gwei must be settled before rescaling ETH to 9 decimals';
+  END IF;
+END;
+$$;
+
+UPDATE "traders" SET
+  "rest" = "rest" / 1000000000,
+  "held" = "held" / 1000000000,
+  "overdraft_limit" = CASE WHEN "overdraft_limit" = 9223372036854775807 THEN "overdraft_limit" ELSE "overdraft_limit" / 1000000000 END
+WHERE "symbol" = 'ETH';
+
+UPDATE "details" SET "number" = "number" / 1000000000
+WHERE "trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "records" SET "number" = "number" / 1000000000, "fee" = "fee" / 1000000000
+WHERE "from_trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "postings" SET "number" = "number" / 1000000000
+WHERE "symbol" = 'ETH';
+
+UPDATE "deposits" SET "number" = "number" / 1000000000
+WHERE "trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "withdrawals" SET "number" = "number" / 1000000000
+WHERE "trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "holds" SET "number" = "number" / 1000000000, "remaining" = "remaining" / 1000000000
+WHERE "trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "scheduled_transfers" SET "number" = "number" / 1000000000
+WHERE "from_trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "escrows" SET "number" = "number" / 1000000000
+WHERE "escrow_trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "transfer_reviews" SET "number" = "number" / 1000000000
+WHERE "from_trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "balance_snapshots" SET "balance" = "balance" / 1000000000
+WHERE "trader_id" IN (SELECT "id" FROM "traders" WHERE "symbol" = 'ETH');
+
+UPDATE "fee_tiers" SET "min_volume" = "min_volume" / 1000000000
+WHERE "rule_id" IN (SELECT "id" FROM "fee_rules" WHERE "symbol" = 'ETH');
+
+UPDATE "fee_rules" SET
+  "flat_fee" = "flat_fee" / 1000000000,
+  "min_fee" = "min_fee" / 1000000000,
+  "max_fee" = "max_fee" / 1000000000
+WHERE "symbol" = 'ETH';
+
+UPDATE "transfer_limits" SET
+  "daily_limit" = "daily_limit" / 1000000000,
+  "monthly_limit" = "monthly_limit" / 1000000000
+WHERE "symbol" = 'ETH';
+
+UPDATE "exchanges" SET
+  "from_number" = CASE WHEN "from_symbol" = 'ETH' THEN "from_number" / 1000000000 ELSE "from_number" END,
+  "to_number" = CASE WHEN "to_symbol" = 'ETH' THEN "to_number" / 1000000000 ELSE "to_number" END,
+  "rate" = CASE WHEN "from_symbol" = 'ETH' THEN "rate" * 1000000000 ELSE "rate" / 1000000000 END
+WHERE "from_symbol" = 'ETH' OR "to_symbol" = 'ETH';
+
+UPDATE "fills" f SET
+  "quantity" = CASE WHEN o."base_symbol" = 'ETH' THEN f."quantity" / 1000000000 ELSE f."quantity" END,
+  "price" = CASE WHEN o."base_symbol" = 'ETH' THEN f."price" * 1000000000 ELSE f."price" / 1000000000 END
+FROM "orders" o
+WHERE o."id" = f."maker_order_id" AND (o."base_symbol" = 'ETH' OR o."quote_symbol" = 'ETH');
+
+UPDATE "orders" SET
+  "quantity" = CASE WHEN "base_symbol" = 'ETH' THEN "quantity" / 1000000000 ELSE "quantity" END,
+  "filled" = CASE WHEN "base_symbol" = 'ETH' THEN "filled" / 1000000000 ELSE "filled" END,
+  "price" = CASE WHEN "base_symbol" = 'ETH' THEN "price" * 1000000000 ELSE "price" / 1000000000 END
+WHERE "base_symbol" = 'ETH' OR "quote_symbol" = 'ETH';
+
+UPDATE "symbols" SET
+  "decimals" = 9,
+  "min_transfer" = GREATEST(("min_transfer" + 999999999) / 1000000000, 1)
+WHERE "code" = 'ETH';
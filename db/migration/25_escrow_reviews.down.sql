@@ -0,0 +1,3 @@
+ALTER TABLE "transfer_reviews" DROP COLUMN IF EXISTS "escrow_id";
+
+ALTER TABLE "transfer_reviews" DROP COLUMN IF EXISTS "escrow_expires_time";
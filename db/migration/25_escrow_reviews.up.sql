@@ -0,0 +1,9 @@
+ALTER TABLE "transfer_reviews" ADD COLUMN "escrow_expires_time" timestamptz;
+
+ALTER TABLE "transfer_reviews" ADD COLUMN "escrow_id" bigint;
+
+ALTER TABLE "transfer_reviews" ADD FOREIGN KEY ("escrow_id") REFERENCES "escrows" ("id");
+
+COMMENT ON COLUMN "transfer_reviews"."escrow_expires_time" IS 'set when the review holds the funding of an escrow, which approving opens instead of recording a transfer';
+
+COMMENT ON COLUMN "transfer_reviews"."escrow_id" IS 'set when the escrow funding is approved and the escrow opened';
@@ -0,0 +1,27 @@
+-- Prices per whole base unit only go back to per base minor unit when they
+-- divide exactly; the migration aborts otherwise.
+DO $$
+BEGIN
+  IF EXISTS (
+    SELECT 1 FROM "orders" o JOIN "symbols" s ON s."code" = o."base_symbol"
+    WHERE o."price" % power(10::numeric, s."decimals") <> 0
+    UNION ALL
+    SELECT 1 FROM "fills" f JOIN "orders" o ON o."id" = f."maker_order_id" JOIN "symbols" s ON s."code" = o."base_symbol"
+    WHERE f."price" % power(10::numeric, s."decimals") <> 0
+  ) THEN
+    RAISE EXCEPTION 'order prices below one quote minor unit per base minor unit cannot be scaled back';
+  END IF;
+END;
+$$;
+
+UPDATE "fills" f SET "price" = f."price" / power(10::numeric, s."decimals")::bigint
+FROM "orders" o, "symbols" s
+WHERE o."id" = f."maker_order_id" AND s."code" = o."base_symbol";
+
+UPDATE "orders" o SET "price" = o."price" / power(10::numeric, s."decimals")::bigint
+FROM "symbols" s
+WHERE s."code" = o."base_symbol";
+
+COMMENT ON COLUMN "orders"."price" IS 'quote units per base unit, 0 for market orders';
+
+COMMENT ON COLUMN "fills"."price" IS NULL;
@@ -0,0 +1,31 @@
+-- Order and fill prices were in quote minor units per base minor unit, so a
+-- base worth less than one quote minor unit per base minor unit, such as ADA
+-- in BTC, could not be quoted. They become quote minor units per whole base
+-- unit, that is multiplied by 10^decimals of the base symbol, and what an
+-- order costs is quantity * price / 10^decimals rounded down. Prices that no
+-- longer fit in a bigint abort the migration.
+DO $$
+BEGIN
+  IF EXISTS (
+    SELECT 1 FROM "orders" o JOIN "symbols" s ON s."code" = o."base_symbol"
+    WHERE o."price" * power(10::numeric, s."decimals") > 9223372036854775807
+    UNION ALL
+    SELECT 1 FROM "fills" f JOIN "orders" o ON o."id" = f."maker_order_id" JOIN "symbols" s ON s."code" = o."base_symbol"
+    WHERE f."price" * power(10::numeric, s."decimals") > 9223372036854775807
+  ) THEN
+    RAISE EXCEPTION 'order prices per whole base unit do not fit in a bigint';
+  END IF;
+END;
+$$;
+
+UPDATE "fills" f SET "price" = f."price" * power(10::numeric, s."decimals")::bigint
+FROM "orders" o, "symbols" s
+WHERE o."id" = f."maker_order_id" AND s."code" = o."base_symbol";
+
+UPDATE "orders" o SET "price" = o."price" * power(10::numeric, s."decimals")::bigint
+FROM "symbols" s
+WHERE s."code" = o."base_symbol";
+
+COMMENT ON COLUMN "orders"."price" IS 'quote minor units per whole base unit, 0 for market orders';
+
+COMMENT ON COLUMN "fills"."price" IS 'quote minor units per whole base unit';
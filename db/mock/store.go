@@ -11,6 +11,7 @@ import (
 	db "github.com/YuanData/allegro-trade/db/sqlc"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 )
 
 // MockStore is a mock of Store interface.
@@ -36,288 +37,2085 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// AddOrderFilled mocks base method.
+func (m *MockStore) AddOrderFilled(arg0 context.Context, arg1 db.AddOrderFilledParams) (db.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrderFilled", arg0, arg1)
+	ret0, _ := ret[0].(db.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOrderFilled indicates an expected call of AddOrderFilled.
+func (mr *MockStoreMockRecorder) AddOrderFilled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrderFilled", reflect.TypeOf((*MockStore)(nil).AddOrderFilled), arg0, arg1)
+}
+
+// AddTraderHeld mocks base method.
+func (m *MockStore) AddTraderHeld(arg0 context.Context, arg1 db.AddTraderHeldParams) (db.Trader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTraderHeld", arg0, arg1)
+	ret0, _ := ret[0].(db.Trader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTraderHeld indicates an expected call of AddTraderHeld.
+func (mr *MockStoreMockRecorder) AddTraderHeld(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTraderHeld", reflect.TypeOf((*MockStore)(nil).AddTraderHeld), arg0, arg1)
+}
+
 // AddTraderRest mocks base method.
 func (m *MockStore) AddTraderRest(arg0 context.Context, arg1 db.AddTraderRestParams) (db.Trader, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddTraderRest", arg0, arg1)
-	ret0, _ := ret[0].(db.Trader)
+	ret := m.ctrl.Call(m, "AddTraderRest", arg0, arg1)
+	ret0, _ := ret[0].(db.Trader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTraderRest indicates an expected call of AddTraderRest.
+func (mr *MockStoreMockRecorder) AddTraderRest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTraderRest", reflect.TypeOf((*MockStore)(nil).AddTraderRest), arg0, arg1)
+}
+
+// BatchRecordTx mocks base method.
+func (m *MockStore) BatchRecordTx(arg0 context.Context, arg1 db.BatchRecordTxParams) (db.BatchRecordTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchRecordTx", arg0, arg1)
+	ret0, _ := ret[0].(db.BatchRecordTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchRecordTx indicates an expected call of BatchRecordTx.
+func (mr *MockStoreMockRecorder) BatchRecordTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchRecordTx", reflect.TypeOf((*MockStore)(nil).BatchRecordTx), arg0, arg1)
+}
+
+// CancelEscrowTx mocks base method.
+func (m *MockStore) CancelEscrowTx(arg0 context.Context, arg1 db.EscrowActionParams) (db.EscrowTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelEscrowTx", arg0, arg1)
+	ret0, _ := ret[0].(db.EscrowTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelEscrowTx indicates an expected call of CancelEscrowTx.
+func (mr *MockStoreMockRecorder) CancelEscrowTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelEscrowTx", reflect.TypeOf((*MockStore)(nil).CancelEscrowTx), arg0, arg1)
+}
+
+// CancelOrderTx mocks base method.
+func (m *MockStore) CancelOrderTx(arg0 context.Context, arg1 int64) (db.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrderTx", arg0, arg1)
+	ret0, _ := ret[0].(db.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOrderTx indicates an expected call of CancelOrderTx.
+func (mr *MockStoreMockRecorder) CancelOrderTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrderTx", reflect.TypeOf((*MockStore)(nil).CancelOrderTx), arg0, arg1)
+}
+
+// CaptureHold mocks base method.
+func (m *MockStore) CaptureHold(arg0 context.Context, arg1 db.CaptureHoldParams) (db.CaptureHoldResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", arg0, arg1)
+	ret0, _ := ret[0].(db.CaptureHoldResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockStoreMockRecorder) CaptureHold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockStore)(nil).CaptureHold), arg0, arg1)
+}
+
+// ClaimScheduledTransfer mocks base method.
+func (m *MockStore) ClaimScheduledTransfer(arg0 context.Context, arg1 db.ClaimScheduledTransferParams) (db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimScheduledTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimScheduledTransfer indicates an expected call of ClaimScheduledTransfer.
+func (mr *MockStoreMockRecorder) ClaimScheduledTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimScheduledTransfer", reflect.TypeOf((*MockStore)(nil).ClaimScheduledTransfer), arg0, arg1)
+}
+
+// ConfirmEscrowTx mocks base method.
+func (m *MockStore) ConfirmEscrowTx(arg0 context.Context, arg1 db.EscrowActionParams) (db.EscrowTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEscrowTx", arg0, arg1)
+	ret0, _ := ret[0].(db.EscrowTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmEscrowTx indicates an expected call of ConfirmEscrowTx.
+func (mr *MockStoreMockRecorder) ConfirmEscrowTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEscrowTx", reflect.TypeOf((*MockStore)(nil).ConfirmEscrowTx), arg0, arg1)
+}
+
+// CountPendingTransferReviewsByTrader mocks base method.
+func (m *MockStore) CountPendingTransferReviewsByTrader(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPendingTransferReviewsByTrader", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPendingTransferReviewsByTrader indicates an expected call of CountPendingTransferReviewsByTrader.
+func (mr *MockStoreMockRecorder) CountPendingTransferReviewsByTrader(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPendingTransferReviewsByTrader", reflect.TypeOf((*MockStore)(nil).CountPendingTransferReviewsByTrader), arg0, arg1)
+}
+
+// CountTraders mocks base method.
+func (m *MockStore) CountTraders(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTraders", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTraders indicates an expected call of CountTraders.
+func (mr *MockStoreMockRecorder) CountTraders(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTraders", reflect.TypeOf((*MockStore)(nil).CountTraders), arg0)
+}
+
+// CountTransfersSince mocks base method.
+func (m *MockStore) CountTransfersSince(arg0 context.Context, arg1 db.CountTransfersSinceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTransfersSince", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTransfersSince indicates an expected call of CountTransfersSince.
+func (mr *MockStoreMockRecorder) CountTransfersSince(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTransfersSince", reflect.TypeOf((*MockStore)(nil).CountTransfersSince), arg0, arg1)
+}
+
+// CountUnsettledEscrowsByTrader mocks base method.
+func (m *MockStore) CountUnsettledEscrowsByTrader(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnsettledEscrowsByTrader", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnsettledEscrowsByTrader indicates an expected call of CountUnsettledEscrowsByTrader.
+func (mr *MockStoreMockRecorder) CountUnsettledEscrowsByTrader(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnsettledEscrowsByTrader", reflect.TypeOf((*MockStore)(nil).CountUnsettledEscrowsByTrader), arg0, arg1)
+}
+
+// CreateBalanceSnapshots mocks base method.
+func (m *MockStore) CreateBalanceSnapshots(arg0 context.Context, arg1 db.CreateBalanceSnapshotsParams) ([]db.BalanceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBalanceSnapshots", arg0, arg1)
+	ret0, _ := ret[0].([]db.BalanceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBalanceSnapshots indicates an expected call of CreateBalanceSnapshots.
+func (mr *MockStoreMockRecorder) CreateBalanceSnapshots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBalanceSnapshots", reflect.TypeOf((*MockStore)(nil).CreateBalanceSnapshots), arg0, arg1)
+}
+
+// CreateDeposit mocks base method.
+func (m *MockStore) CreateDeposit(arg0 context.Context, arg1 db.CreateDepositParams) (db.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeposit", arg0, arg1)
+	ret0, _ := ret[0].(db.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeposit indicates an expected call of CreateDeposit.
+func (mr *MockStoreMockRecorder) CreateDeposit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeposit", reflect.TypeOf((*MockStore)(nil).CreateDeposit), arg0, arg1)
+}
+
+// CreateDetail mocks base method.
+func (m *MockStore) CreateDetail(arg0 context.Context, arg1 db.CreateDetailParams) (db.Detail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDetail", arg0, arg1)
+	ret0, _ := ret[0].(db.Detail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDetail indicates an expected call of CreateDetail.
+func (mr *MockStoreMockRecorder) CreateDetail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDetail", reflect.TypeOf((*MockStore)(nil).CreateDetail), arg0, arg1)
+}
+
+// CreateEscrow mocks base method.
+func (m *MockStore) CreateEscrow(arg0 context.Context, arg1 db.CreateEscrowParams) (db.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEscrow", arg0, arg1)
+	ret0, _ := ret[0].(db.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEscrow indicates an expected call of CreateEscrow.
+func (mr *MockStoreMockRecorder) CreateEscrow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEscrow", reflect.TypeOf((*MockStore)(nil).CreateEscrow), arg0, arg1)
+}
+
+// CreateExchange mocks base method.
+func (m *MockStore) CreateExchange(arg0 context.Context, arg1 db.CreateExchangeParams) (db.Exchange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExchange", arg0, arg1)
+	ret0, _ := ret[0].(db.Exchange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExchange indicates an expected call of CreateExchange.
+func (mr *MockStoreMockRecorder) CreateExchange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExchange", reflect.TypeOf((*MockStore)(nil).CreateExchange), arg0, arg1)
+}
+
+// CreateFeeTier mocks base method.
+func (m *MockStore) CreateFeeTier(arg0 context.Context, arg1 db.CreateFeeTierParams) (db.FeeTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeeTier", arg0, arg1)
+	ret0, _ := ret[0].(db.FeeTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeeTier indicates an expected call of CreateFeeTier.
+func (mr *MockStoreMockRecorder) CreateFeeTier(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeeTier", reflect.TypeOf((*MockStore)(nil).CreateFeeTier), arg0, arg1)
+}
+
+// CreateFill mocks base method.
+func (m *MockStore) CreateFill(arg0 context.Context, arg1 db.CreateFillParams) (db.Fill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFill", arg0, arg1)
+	ret0, _ := ret[0].(db.Fill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFill indicates an expected call of CreateFill.
+func (mr *MockStoreMockRecorder) CreateFill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFill", reflect.TypeOf((*MockStore)(nil).CreateFill), arg0, arg1)
+}
+
+// CreateHold mocks base method.
+func (m *MockStore) CreateHold(arg0 context.Context, arg1 db.CreateHoldParams) (db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHold", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHold indicates an expected call of CreateHold.
+func (mr *MockStoreMockRecorder) CreateHold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*MockStore)(nil).CreateHold), arg0, arg1)
+}
+
+// CreateJournalEntry mocks base method.
+func (m *MockStore) CreateJournalEntry(arg0 context.Context, arg1 db.CreateJournalEntryParams) (db.JournalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJournalEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.JournalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJournalEntry indicates an expected call of CreateJournalEntry.
+func (mr *MockStoreMockRecorder) CreateJournalEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJournalEntry", reflect.TypeOf((*MockStore)(nil).CreateJournalEntry), arg0, arg1)
+}
+
+// CreateKYCSubmission mocks base method.
+func (m *MockStore) CreateKYCSubmission(arg0 context.Context, arg1 db.CreateKYCSubmissionParams) (db.KycSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKYCSubmission", arg0, arg1)
+	ret0, _ := ret[0].(db.KycSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKYCSubmission indicates an expected call of CreateKYCSubmission.
+func (mr *MockStoreMockRecorder) CreateKYCSubmission(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKYCSubmission", reflect.TypeOf((*MockStore)(nil).CreateKYCSubmission), arg0, arg1)
+}
+
+// CreateMember mocks base method.
+func (m *MockStore) CreateMember(arg0 context.Context, arg1 db.CreateMemberParams) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMember", arg0, arg1)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMember indicates an expected call of CreateMember.
+func (mr *MockStoreMockRecorder) CreateMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMember", reflect.TypeOf((*MockStore)(nil).CreateMember), arg0, arg1)
+}
+
+// CreateMemberTx mocks base method.
+func (m *MockStore) CreateMemberTx(arg0 context.Context, arg1 db.CreateMemberTxParams) (db.CreateMemberTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMemberTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateMemberTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMemberTx indicates an expected call of CreateMemberTx.
+func (mr *MockStoreMockRecorder) CreateMemberTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMemberTx", reflect.TypeOf((*MockStore)(nil).CreateMemberTx), arg0, arg1)
+}
+
+// CreateOrder mocks base method.
+func (m *MockStore) CreateOrder(arg0 context.Context, arg1 db.CreateOrderParams) (db.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrder", arg0, arg1)
+	ret0, _ := ret[0].(db.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrder indicates an expected call of CreateOrder.
+func (mr *MockStoreMockRecorder) CreateOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockStore)(nil).CreateOrder), arg0, arg1)
+}
+
+// CreateOrderTx mocks base method.
+func (m *MockStore) CreateOrderTx(arg0 context.Context, arg1 db.CreateOrderTxParams) (db.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrderTx", arg0, arg1)
+	ret0, _ := ret[0].(db.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrderTx indicates an expected call of CreateOrderTx.
+func (mr *MockStoreMockRecorder) CreateOrderTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderTx", reflect.TypeOf((*MockStore)(nil).CreateOrderTx), arg0, arg1)
+}
+
+// CreatePosting mocks base method.
+func (m *MockStore) CreatePosting(arg0 context.Context, arg1 db.CreatePostingParams) (db.Posting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePosting", arg0, arg1)
+	ret0, _ := ret[0].(db.Posting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePosting indicates an expected call of CreatePosting.
+func (mr *MockStoreMockRecorder) CreatePosting(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePosting", reflect.TypeOf((*MockStore)(nil).CreatePosting), arg0, arg1)
+}
+
+// CreatePrice mocks base method.
+func (m *MockStore) CreatePrice(arg0 context.Context, arg1 db.CreatePriceParams) (db.Price, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrice", arg0, arg1)
+	ret0, _ := ret[0].(db.Price)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePrice indicates an expected call of CreatePrice.
+func (mr *MockStoreMockRecorder) CreatePrice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrice", reflect.TypeOf((*MockStore)(nil).CreatePrice), arg0, arg1)
+}
+
+// CreateRecord mocks base method.
+func (m *MockStore) CreateRecord(arg0 context.Context, arg1 db.CreateRecordParams) (db.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecord", arg0, arg1)
+	ret0, _ := ret[0].(db.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecord indicates an expected call of CreateRecord.
+func (mr *MockStoreMockRecorder) CreateRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecord", reflect.TypeOf((*MockStore)(nil).CreateRecord), arg0, arg1)
+}
+
+// CreateScheduledTransfer mocks base method.
+func (m *MockStore) CreateScheduledTransfer(arg0 context.Context, arg1 db.CreateScheduledTransferParams) (db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledTransfer indicates an expected call of CreateScheduledTransfer.
+func (mr *MockStoreMockRecorder) CreateScheduledTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledTransfer", reflect.TypeOf((*MockStore)(nil).CreateScheduledTransfer), arg0, arg1)
+}
+
+// CreateScheduledTransferRun mocks base method.
+func (m *MockStore) CreateScheduledTransferRun(arg0 context.Context, arg1 db.CreateScheduledTransferRunParams) (db.ScheduledTransferRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledTransferRun", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduledTransferRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledTransferRun indicates an expected call of CreateScheduledTransferRun.
+func (mr *MockStoreMockRecorder) CreateScheduledTransferRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledTransferRun", reflect.TypeOf((*MockStore)(nil).CreateScheduledTransferRun), arg0, arg1)
+}
+
+// CreateSession mocks base method.
+func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+}
+
+// CreateSymbol mocks base method.
+func (m *MockStore) CreateSymbol(arg0 context.Context, arg1 db.CreateSymbolParams) (db.Symbol, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSymbol", arg0, arg1)
+	ret0, _ := ret[0].(db.Symbol)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSymbol indicates an expected call of CreateSymbol.
+func (mr *MockStoreMockRecorder) CreateSymbol(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSymbol", reflect.TypeOf((*MockStore)(nil).CreateSymbol), arg0, arg1)
+}
+
+// CreateSymbolTx mocks base method.
+func (m *MockStore) CreateSymbolTx(arg0 context.Context, arg1 db.CreateSymbolParams) (db.CreateSymbolTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSymbolTx", arg0, arg1)
+	ret0, _ := ret[0].(db.CreateSymbolTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSymbolTx indicates an expected call of CreateSymbolTx.
+func (mr *MockStoreMockRecorder) CreateSymbolTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSymbolTx", reflect.TypeOf((*MockStore)(nil).CreateSymbolTx), arg0, arg1)
+}
+
+// CreateTrader mocks base method.
+func (m *MockStore) CreateTrader(arg0 context.Context, arg1 db.CreateTraderParams) (db.Trader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTrader", arg0, arg1)
+	ret0, _ := ret[0].(db.Trader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTrader indicates an expected call of CreateTrader.
+func (mr *MockStoreMockRecorder) CreateTrader(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrader", reflect.TypeOf((*MockStore)(nil).CreateTrader), arg0, arg1)
+}
+
+// CreateTraderStatusChange mocks base method.
+func (m *MockStore) CreateTraderStatusChange(arg0 context.Context, arg1 db.CreateTraderStatusChangeParams) (db.TraderStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTraderStatusChange", arg0, arg1)
+	ret0, _ := ret[0].(db.TraderStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTraderStatusChange indicates an expected call of CreateTraderStatusChange.
+func (mr *MockStoreMockRecorder) CreateTraderStatusChange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTraderStatusChange", reflect.TypeOf((*MockStore)(nil).CreateTraderStatusChange), arg0, arg1)
+}
+
+// CreateTransferReview mocks base method.
+func (m *MockStore) CreateTransferReview(arg0 context.Context, arg1 db.CreateTransferReviewParams) (db.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransferReview", arg0, arg1)
+	ret0, _ := ret[0].(db.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransferReview indicates an expected call of CreateTransferReview.
+func (mr *MockStoreMockRecorder) CreateTransferReview(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferReview", reflect.TypeOf((*MockStore)(nil).CreateTransferReview), arg0, arg1)
+}
+
+// CreateVerifyEmail mocks base method.
+func (m *MockStore) CreateVerifyEmail(arg0 context.Context, arg1 db.CreateVerifyEmailParams) (db.VerifyEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(db.VerifyEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVerifyEmail indicates an expected call of CreateVerifyEmail.
+func (mr *MockStoreMockRecorder) CreateVerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerifyEmail", reflect.TypeOf((*MockStore)(nil).CreateVerifyEmail), arg0, arg1)
+}
+
+// CreateWithdrawal mocks base method.
+func (m *MockStore) CreateWithdrawal(arg0 context.Context, arg1 db.CreateWithdrawalParams) (db.Withdrawal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithdrawal", arg0, arg1)
+	ret0, _ := ret[0].(db.Withdrawal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithdrawal indicates an expected call of CreateWithdrawal.
+func (mr *MockStoreMockRecorder) CreateWithdrawal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithdrawal", reflect.TypeOf((*MockStore)(nil).CreateWithdrawal), arg0, arg1)
+}
+
+// DeleteFeeRule mocks base method.
+func (m *MockStore) DeleteFeeRule(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeeRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeeRule indicates an expected call of DeleteFeeRule.
+func (mr *MockStoreMockRecorder) DeleteFeeRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeeRule", reflect.TypeOf((*MockStore)(nil).DeleteFeeRule), arg0, arg1)
+}
+
+// DeleteFeeTiers mocks base method.
+func (m *MockStore) DeleteFeeTiers(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeeTiers", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeeTiers indicates an expected call of DeleteFeeTiers.
+func (mr *MockStoreMockRecorder) DeleteFeeTiers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeeTiers", reflect.TypeOf((*MockStore)(nil).DeleteFeeTiers), arg0, arg1)
+}
+
+// DeleteScheduledTransfer mocks base method.
+func (m *MockStore) DeleteScheduledTransfer(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScheduledTransfer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScheduledTransfer indicates an expected call of DeleteScheduledTransfer.
+func (mr *MockStoreMockRecorder) DeleteScheduledTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduledTransfer", reflect.TypeOf((*MockStore)(nil).DeleteScheduledTransfer), arg0, arg1)
+}
+
+// DeleteTransferLimit mocks base method.
+func (m *MockStore) DeleteTransferLimit(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransferLimit", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransferLimit indicates an expected call of DeleteTransferLimit.
+func (mr *MockStoreMockRecorder) DeleteTransferLimit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransferLimit", reflect.TypeOf((*MockStore)(nil).DeleteTransferLimit), arg0, arg1)
+}
+
+// DepositTx mocks base method.
+func (m *MockStore) DepositTx(arg0 context.Context, arg1 db.DepositTxParams) (db.DepositTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DepositTx", arg0, arg1)
+	ret0, _ := ret[0].(db.DepositTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DepositTx indicates an expected call of DepositTx.
+func (mr *MockStoreMockRecorder) DepositTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DepositTx", reflect.TypeOf((*MockStore)(nil).DepositTx), arg0, arg1)
+}
+
+// DisputeEscrowTx mocks base method.
+func (m *MockStore) DisputeEscrowTx(arg0 context.Context, arg1 db.EscrowActionParams) (db.EscrowTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisputeEscrowTx", arg0, arg1)
+	ret0, _ := ret[0].(db.EscrowTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisputeEscrowTx indicates an expected call of DisputeEscrowTx.
+func (mr *MockStoreMockRecorder) DisputeEscrowTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisputeEscrowTx", reflect.TypeOf((*MockStore)(nil).DisputeEscrowTx), arg0, arg1)
+}
+
+// ExchangeTx mocks base method.
+func (m *MockStore) ExchangeTx(arg0 context.Context, arg1 db.ExchangeTxParams) (db.ExchangeTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExchangeTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ExchangeTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExchangeTx indicates an expected call of ExchangeTx.
+func (mr *MockStoreMockRecorder) ExchangeTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExchangeTx", reflect.TypeOf((*MockStore)(nil).ExchangeTx), arg0, arg1)
+}
+
+// ExpireEscrowTx mocks base method.
+func (m *MockStore) ExpireEscrowTx(arg0 context.Context, arg1 int64) (db.EscrowTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireEscrowTx", arg0, arg1)
+	ret0, _ := ret[0].(db.EscrowTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireEscrowTx indicates an expected call of ExpireEscrowTx.
+func (mr *MockStoreMockRecorder) ExpireEscrowTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireEscrowTx", reflect.TypeOf((*MockStore)(nil).ExpireEscrowTx), arg0, arg1)
+}
+
+// ExpireHoldTx mocks base method.
+func (m *MockStore) ExpireHoldTx(arg0 context.Context, arg1 int64) (db.HoldResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireHoldTx", arg0, arg1)
+	ret0, _ := ret[0].(db.HoldResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireHoldTx indicates an expected call of ExpireHoldTx.
+func (mr *MockStoreMockRecorder) ExpireHoldTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireHoldTx", reflect.TypeOf((*MockStore)(nil).ExpireHoldTx), arg0, arg1)
+}
+
+// FillTx mocks base method.
+func (m *MockStore) FillTx(arg0 context.Context, arg1 db.FillTxParams) (db.FillTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FillTx", arg0, arg1)
+	ret0, _ := ret[0].(db.FillTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FillTx indicates an expected call of FillTx.
+func (mr *MockStoreMockRecorder) FillTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FillTx", reflect.TypeOf((*MockStore)(nil).FillTx), arg0, arg1)
+}
+
+// GetApplicableFeeRule mocks base method.
+func (m *MockStore) GetApplicableFeeRule(arg0 context.Context, arg1 db.GetApplicableFeeRuleParams) (db.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicableFeeRule", arg0, arg1)
+	ret0, _ := ret[0].(db.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicableFeeRule indicates an expected call of GetApplicableFeeRule.
+func (mr *MockStoreMockRecorder) GetApplicableFeeRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicableFeeRule", reflect.TypeOf((*MockStore)(nil).GetApplicableFeeRule), arg0, arg1)
+}
+
+// GetApplicableTransferLimit mocks base method.
+func (m *MockStore) GetApplicableTransferLimit(arg0 context.Context, arg1 db.GetApplicableTransferLimitParams) (db.TransferLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicableTransferLimit", arg0, arg1)
+	ret0, _ := ret[0].(db.TransferLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicableTransferLimit indicates an expected call of GetApplicableTransferLimit.
+func (mr *MockStoreMockRecorder) GetApplicableTransferLimit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicableTransferLimit", reflect.TypeOf((*MockStore)(nil).GetApplicableTransferLimit), arg0, arg1)
+}
+
+// GetBalanceAt mocks base method.
+func (m *MockStore) GetBalanceAt(arg0 context.Context, arg1 db.GetBalanceAtParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalanceAt", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalanceAt indicates an expected call of GetBalanceAt.
+func (mr *MockStoreMockRecorder) GetBalanceAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalanceAt", reflect.TypeOf((*MockStore)(nil).GetBalanceAt), arg0, arg1)
+}
+
+// GetDeposit mocks base method.
+func (m *MockStore) GetDeposit(arg0 context.Context, arg1 int64) (db.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeposit", arg0, arg1)
+	ret0, _ := ret[0].(db.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeposit indicates an expected call of GetDeposit.
+func (mr *MockStoreMockRecorder) GetDeposit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeposit", reflect.TypeOf((*MockStore)(nil).GetDeposit), arg0, arg1)
+}
+
+// GetDetail mocks base method.
+func (m *MockStore) GetDetail(arg0 context.Context, arg1 int64) (db.Detail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDetail", arg0, arg1)
+	ret0, _ := ret[0].(db.Detail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDetail indicates an expected call of GetDetail.
+func (mr *MockStoreMockRecorder) GetDetail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetail", reflect.TypeOf((*MockStore)(nil).GetDetail), arg0, arg1)
+}
+
+// GetEscrow mocks base method.
+func (m *MockStore) GetEscrow(arg0 context.Context, arg1 int64) (db.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEscrow", arg0, arg1)
+	ret0, _ := ret[0].(db.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEscrow indicates an expected call of GetEscrow.
+func (mr *MockStoreMockRecorder) GetEscrow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscrow", reflect.TypeOf((*MockStore)(nil).GetEscrow), arg0, arg1)
+}
+
+// GetEscrowForUpdate mocks base method.
+func (m *MockStore) GetEscrowForUpdate(arg0 context.Context, arg1 int64) (db.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEscrowForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEscrowForUpdate indicates an expected call of GetEscrowForUpdate.
+func (mr *MockStoreMockRecorder) GetEscrowForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEscrowForUpdate", reflect.TypeOf((*MockStore)(nil).GetEscrowForUpdate), arg0, arg1)
+}
+
+// GetExchange mocks base method.
+func (m *MockStore) GetExchange(arg0 context.Context, arg1 int64) (db.Exchange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchange", arg0, arg1)
+	ret0, _ := ret[0].(db.Exchange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchange indicates an expected call of GetExchange.
+func (mr *MockStoreMockRecorder) GetExchange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchange", reflect.TypeOf((*MockStore)(nil).GetExchange), arg0, arg1)
+}
+
+// GetFeeRule mocks base method.
+func (m *MockStore) GetFeeRule(arg0 context.Context, arg1 int64) (db.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeeRule", arg0, arg1)
+	ret0, _ := ret[0].(db.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeeRule indicates an expected call of GetFeeRule.
+func (mr *MockStoreMockRecorder) GetFeeRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeeRule", reflect.TypeOf((*MockStore)(nil).GetFeeRule), arg0, arg1)
+}
+
+// GetFill mocks base method.
+func (m *MockStore) GetFill(arg0 context.Context, arg1 int64) (db.Fill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFill", arg0, arg1)
+	ret0, _ := ret[0].(db.Fill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFill indicates an expected call of GetFill.
+func (mr *MockStoreMockRecorder) GetFill(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFill", reflect.TypeOf((*MockStore)(nil).GetFill), arg0, arg1)
+}
+
+// GetHold mocks base method.
+func (m *MockStore) GetHold(arg0 context.Context, arg1 int64) (db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockStoreMockRecorder) GetHold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockStore)(nil).GetHold), arg0, arg1)
+}
+
+// GetHoldForUpdate mocks base method.
+func (m *MockStore) GetHoldForUpdate(arg0 context.Context, arg1 int64) (db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHoldForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHoldForUpdate indicates an expected call of GetHoldForUpdate.
+func (mr *MockStoreMockRecorder) GetHoldForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHoldForUpdate", reflect.TypeOf((*MockStore)(nil).GetHoldForUpdate), arg0, arg1)
+}
+
+// GetJournalEntry mocks base method.
+func (m *MockStore) GetJournalEntry(arg0 context.Context, arg1 int64) (db.JournalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJournalEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.JournalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJournalEntry indicates an expected call of GetJournalEntry.
+func (mr *MockStoreMockRecorder) GetJournalEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJournalEntry", reflect.TypeOf((*MockStore)(nil).GetJournalEntry), arg0, arg1)
+}
+
+// GetKYCSubmission mocks base method.
+func (m *MockStore) GetKYCSubmission(arg0 context.Context, arg1 int64) (db.KycSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKYCSubmission", arg0, arg1)
+	ret0, _ := ret[0].(db.KycSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKYCSubmission indicates an expected call of GetKYCSubmission.
+func (mr *MockStoreMockRecorder) GetKYCSubmission(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKYCSubmission", reflect.TypeOf((*MockStore)(nil).GetKYCSubmission), arg0, arg1)
+}
+
+// GetKYCSubmissionForUpdate mocks base method.
+func (m *MockStore) GetKYCSubmissionForUpdate(arg0 context.Context, arg1 int64) (db.KycSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKYCSubmissionForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.KycSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKYCSubmissionForUpdate indicates an expected call of GetKYCSubmissionForUpdate.
+func (mr *MockStoreMockRecorder) GetKYCSubmissionForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKYCSubmissionForUpdate", reflect.TypeOf((*MockStore)(nil).GetKYCSubmissionForUpdate), arg0, arg1)
+}
+
+// GetLatestPrice mocks base method.
+func (m *MockStore) GetLatestPrice(arg0 context.Context, arg1 db.GetLatestPriceParams) (db.Price, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestPrice", arg0, arg1)
+	ret0, _ := ret[0].(db.Price)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestPrice indicates an expected call of GetLatestPrice.
+func (mr *MockStoreMockRecorder) GetLatestPrice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestPrice", reflect.TypeOf((*MockStore)(nil).GetLatestPrice), arg0, arg1)
+}
+
+// GetMember mocks base method.
+func (m *MockStore) GetMember(arg0 context.Context, arg1 string) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", arg0, arg1)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockStoreMockRecorder) GetMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockStore)(nil).GetMember), arg0, arg1)
+}
+
+// GetMonthlyVolume mocks base method.
+func (m *MockStore) GetMonthlyVolume(arg0 context.Context, arg1 db.GetMonthlyVolumeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonthlyVolume", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonthlyVolume indicates an expected call of GetMonthlyVolume.
+func (mr *MockStoreMockRecorder) GetMonthlyVolume(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthlyVolume", reflect.TypeOf((*MockStore)(nil).GetMonthlyVolume), arg0, arg1)
+}
+
+// GetOrder mocks base method.
+func (m *MockStore) GetOrder(arg0 context.Context, arg1 int64) (db.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrder", arg0, arg1)
+	ret0, _ := ret[0].(db.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrder indicates an expected call of GetOrder.
+func (mr *MockStoreMockRecorder) GetOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockStore)(nil).GetOrder), arg0, arg1)
+}
+
+// GetOrderByHoldForUpdate mocks base method.
+func (m *MockStore) GetOrderByHoldForUpdate(arg0 context.Context, arg1 pgtype.Int8) (db.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderByHoldForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderByHoldForUpdate indicates an expected call of GetOrderByHoldForUpdate.
+func (mr *MockStoreMockRecorder) GetOrderByHoldForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderByHoldForUpdate", reflect.TypeOf((*MockStore)(nil).GetOrderByHoldForUpdate), arg0, arg1)
+}
+
+// GetOrderForUpdate mocks base method.
+func (m *MockStore) GetOrderForUpdate(arg0 context.Context, arg1 int64) (db.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderForUpdate indicates an expected call of GetOrderForUpdate.
+func (mr *MockStoreMockRecorder) GetOrderForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderForUpdate", reflect.TypeOf((*MockStore)(nil).GetOrderForUpdate), arg0, arg1)
+}
+
+// GetOutgoingTotals mocks base method.
+func (m *MockStore) GetOutgoingTotals(arg0 context.Context, arg1 db.GetOutgoingTotalsParams) (db.GetOutgoingTotalsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutgoingTotals", arg0, arg1)
+	ret0, _ := ret[0].(db.GetOutgoingTotalsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutgoingTotals indicates an expected call of GetOutgoingTotals.
+func (mr *MockStoreMockRecorder) GetOutgoingTotals(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoingTotals", reflect.TypeOf((*MockStore)(nil).GetOutgoingTotals), arg0, arg1)
+}
+
+// GetPriceAt mocks base method.
+func (m *MockStore) GetPriceAt(arg0 context.Context, arg1 db.GetPriceAtParams) (db.Price, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriceAt", arg0, arg1)
+	ret0, _ := ret[0].(db.Price)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPriceAt indicates an expected call of GetPriceAt.
+func (mr *MockStoreMockRecorder) GetPriceAt(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriceAt", reflect.TypeOf((*MockStore)(nil).GetPriceAt), arg0, arg1)
+}
+
+// GetRecord mocks base method.
+func (m *MockStore) GetRecord(arg0 context.Context, arg1 int64) (db.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecord", arg0, arg1)
+	ret0, _ := ret[0].(db.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecord indicates an expected call of GetRecord.
+func (mr *MockStoreMockRecorder) GetRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecord", reflect.TypeOf((*MockStore)(nil).GetRecord), arg0, arg1)
+}
+
+// GetRecordByIdempotencyKey mocks base method.
+func (m *MockStore) GetRecordByIdempotencyKey(arg0 context.Context, arg1 db.GetRecordByIdempotencyKeyParams) (db.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecordByIdempotencyKey", arg0, arg1)
+	ret0, _ := ret[0].(db.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecordByIdempotencyKey indicates an expected call of GetRecordByIdempotencyKey.
+func (mr *MockStoreMockRecorder) GetRecordByIdempotencyKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecordByIdempotencyKey", reflect.TypeOf((*MockStore)(nil).GetRecordByIdempotencyKey), arg0, arg1)
+}
+
+// GetRecordForUpdate mocks base method.
+func (m *MockStore) GetRecordForUpdate(arg0 context.Context, arg1 int64) (db.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecordForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecordForUpdate indicates an expected call of GetRecordForUpdate.
+func (mr *MockStoreMockRecorder) GetRecordForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecordForUpdate", reflect.TypeOf((*MockStore)(nil).GetRecordForUpdate), arg0, arg1)
+}
+
+// GetReversedNumber mocks base method.
+func (m *MockStore) GetReversedNumber(arg0 context.Context, arg1 int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReversedNumber", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReversedNumber indicates an expected call of GetReversedNumber.
+func (mr *MockStoreMockRecorder) GetReversedNumber(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReversedNumber", reflect.TypeOf((*MockStore)(nil).GetReversedNumber), arg0, arg1)
+}
+
+// GetScheduledTransfer mocks base method.
+func (m *MockStore) GetScheduledTransfer(arg0 context.Context, arg1 int64) (db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledTransfer indicates an expected call of GetScheduledTransfer.
+func (mr *MockStoreMockRecorder) GetScheduledTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledTransfer", reflect.TypeOf((*MockStore)(nil).GetScheduledTransfer), arg0, arg1)
+}
+
+// GetScheduledTransferForUpdate mocks base method.
+func (m *MockStore) GetScheduledTransferForUpdate(arg0 context.Context, arg1 int64) (db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledTransferForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledTransferForUpdate indicates an expected call of GetScheduledTransferForUpdate.
+func (mr *MockStoreMockRecorder) GetScheduledTransferForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledTransferForUpdate", reflect.TypeOf((*MockStore)(nil).GetScheduledTransferForUpdate), arg0, arg1)
+}
+
+// GetSession mocks base method.
+func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
+	ret0, _ := ret[0].(db.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+}
+
+// GetSymbol mocks base method.
+func (m *MockStore) GetSymbol(arg0 context.Context, arg1 string) (db.Symbol, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSymbol", arg0, arg1)
+	ret0, _ := ret[0].(db.Symbol)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSymbol indicates an expected call of GetSymbol.
+func (mr *MockStoreMockRecorder) GetSymbol(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSymbol", reflect.TypeOf((*MockStore)(nil).GetSymbol), arg0, arg1)
+}
+
+// GetTrader mocks base method.
+func (m *MockStore) GetTrader(arg0 context.Context, arg1 int64) (db.Trader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrader", arg0, arg1)
+	ret0, _ := ret[0].(db.Trader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrader indicates an expected call of GetTrader.
+func (mr *MockStoreMockRecorder) GetTrader(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrader", reflect.TypeOf((*MockStore)(nil).GetTrader), arg0, arg1)
+}
+
+// GetTraderByHolderSymbol mocks base method.
+func (m *MockStore) GetTraderByHolderSymbol(arg0 context.Context, arg1 db.GetTraderByHolderSymbolParams) (db.Trader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTraderByHolderSymbol", arg0, arg1)
+	ret0, _ := ret[0].(db.Trader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTraderByHolderSymbol indicates an expected call of GetTraderByHolderSymbol.
+func (mr *MockStoreMockRecorder) GetTraderByHolderSymbol(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTraderByHolderSymbol", reflect.TypeOf((*MockStore)(nil).GetTraderByHolderSymbol), arg0, arg1)
+}
+
+// GetTraderForUpdate mocks base method.
+func (m *MockStore) GetTraderForUpdate(arg0 context.Context, arg1 int64) (db.Trader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTraderForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Trader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTraderForUpdate indicates an expected call of GetTraderForUpdate.
+func (mr *MockStoreMockRecorder) GetTraderForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTraderForUpdate", reflect.TypeOf((*MockStore)(nil).GetTraderForUpdate), arg0, arg1)
+}
+
+// GetTransferReview mocks base method.
+func (m *MockStore) GetTransferReview(arg0 context.Context, arg1 int64) (db.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferReview", arg0, arg1)
+	ret0, _ := ret[0].(db.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferReview indicates an expected call of GetTransferReview.
+func (mr *MockStoreMockRecorder) GetTransferReview(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferReview", reflect.TypeOf((*MockStore)(nil).GetTransferReview), arg0, arg1)
+}
+
+// GetTransferReviewByIdempotencyKey mocks base method.
+func (m *MockStore) GetTransferReviewByIdempotencyKey(arg0 context.Context, arg1 db.GetTransferReviewByIdempotencyKeyParams) (db.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferReviewByIdempotencyKey", arg0, arg1)
+	ret0, _ := ret[0].(db.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferReviewByIdempotencyKey indicates an expected call of GetTransferReviewByIdempotencyKey.
+func (mr *MockStoreMockRecorder) GetTransferReviewByIdempotencyKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferReviewByIdempotencyKey", reflect.TypeOf((*MockStore)(nil).GetTransferReviewByIdempotencyKey), arg0, arg1)
+}
+
+// GetTransferReviewForUpdate mocks base method.
+func (m *MockStore) GetTransferReviewForUpdate(arg0 context.Context, arg1 int64) (db.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferReviewForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferReviewForUpdate indicates an expected call of GetTransferReviewForUpdate.
+func (mr *MockStoreMockRecorder) GetTransferReviewForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferReviewForUpdate", reflect.TypeOf((*MockStore)(nil).GetTransferReviewForUpdate), arg0, arg1)
+}
+
+// GetWithdrawal mocks base method.
+func (m *MockStore) GetWithdrawal(arg0 context.Context, arg1 int64) (db.Withdrawal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithdrawal", arg0, arg1)
+	ret0, _ := ret[0].(db.Withdrawal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithdrawal indicates an expected call of GetWithdrawal.
+func (mr *MockStoreMockRecorder) GetWithdrawal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawal", reflect.TypeOf((*MockStore)(nil).GetWithdrawal), arg0, arg1)
+}
+
+// GetWithdrawalByHoldForUpdate mocks base method.
+func (m *MockStore) GetWithdrawalByHoldForUpdate(arg0 context.Context, arg1 pgtype.Int8) (db.Withdrawal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithdrawalByHoldForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Withdrawal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithdrawalByHoldForUpdate indicates an expected call of GetWithdrawalByHoldForUpdate.
+func (mr *MockStoreMockRecorder) GetWithdrawalByHoldForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawalByHoldForUpdate", reflect.TypeOf((*MockStore)(nil).GetWithdrawalByHoldForUpdate), arg0, arg1)
+}
+
+// GetWithdrawalForUpdate mocks base method.
+func (m *MockStore) GetWithdrawalForUpdate(arg0 context.Context, arg1 int64) (db.Withdrawal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithdrawalForUpdate", arg0, arg1)
+	ret0, _ := ret[0].(db.Withdrawal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithdrawalForUpdate indicates an expected call of GetWithdrawalForUpdate.
+func (mr *MockStoreMockRecorder) GetWithdrawalForUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithdrawalForUpdate", reflect.TypeOf((*MockStore)(nil).GetWithdrawalForUpdate), arg0, arg1)
+}
+
+// HasPaid mocks base method.
+func (m *MockStore) HasPaid(arg0 context.Context, arg1 db.HasPaidParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPaid", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPaid indicates an expected call of HasPaid.
+func (mr *MockStoreMockRecorder) HasPaid(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPaid", reflect.TypeOf((*MockStore)(nil).HasPaid), arg0, arg1)
+}
+
+// ListDeposits mocks base method.
+func (m *MockStore) ListDeposits(arg0 context.Context, arg1 db.ListDepositsParams) ([]db.Deposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeposits", arg0, arg1)
+	ret0, _ := ret[0].([]db.Deposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeposits indicates an expected call of ListDeposits.
+func (mr *MockStoreMockRecorder) ListDeposits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeposits", reflect.TypeOf((*MockStore)(nil).ListDeposits), arg0, arg1)
+}
+
+// ListDetails mocks base method.
+func (m *MockStore) ListDetails(arg0 context.Context, arg1 db.ListDetailsParams) ([]db.Detail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDetails", arg0, arg1)
+	ret0, _ := ret[0].([]db.Detail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDetails indicates an expected call of ListDetails.
+func (mr *MockStoreMockRecorder) ListDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDetails", reflect.TypeOf((*MockStore)(nil).ListDetails), arg0, arg1)
+}
+
+// ListDetailsByRecord mocks base method.
+func (m *MockStore) ListDetailsByRecord(arg0 context.Context, arg1 int64) ([]db.Detail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDetailsByRecord", arg0, arg1)
+	ret0, _ := ret[0].([]db.Detail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDetailsByRecord indicates an expected call of ListDetailsByRecord.
+func (mr *MockStoreMockRecorder) ListDetailsByRecord(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDetailsByRecord", reflect.TypeOf((*MockStore)(nil).ListDetailsByRecord), arg0, arg1)
+}
+
+// ListDetailsInPeriod mocks base method.
+func (m *MockStore) ListDetailsInPeriod(arg0 context.Context, arg1 db.ListDetailsInPeriodParams) ([]db.Detail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDetailsInPeriod", arg0, arg1)
+	ret0, _ := ret[0].([]db.Detail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDetailsInPeriod indicates an expected call of ListDetailsInPeriod.
+func (mr *MockStoreMockRecorder) ListDetailsInPeriod(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDetailsInPeriod", reflect.TypeOf((*MockStore)(nil).ListDetailsInPeriod), arg0, arg1)
+}
+
+// ListDueScheduledTransfers mocks base method.
+func (m *MockStore) ListDueScheduledTransfers(arg0 context.Context, arg1 int32) ([]db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueScheduledTransfers", arg0, arg1)
+	ret0, _ := ret[0].([]db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueScheduledTransfers indicates an expected call of ListDueScheduledTransfers.
+func (mr *MockStoreMockRecorder) ListDueScheduledTransfers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueScheduledTransfers", reflect.TypeOf((*MockStore)(nil).ListDueScheduledTransfers), arg0, arg1)
+}
+
+// ListEscrows mocks base method.
+func (m *MockStore) ListEscrows(arg0 context.Context, arg1 db.ListEscrowsParams) ([]db.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEscrows", arg0, arg1)
+	ret0, _ := ret[0].([]db.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEscrows indicates an expected call of ListEscrows.
+func (mr *MockStoreMockRecorder) ListEscrows(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEscrows", reflect.TypeOf((*MockStore)(nil).ListEscrows), arg0, arg1)
+}
+
+// ListExchanges mocks base method.
+func (m *MockStore) ListExchanges(arg0 context.Context, arg1 db.ListExchangesParams) ([]db.Exchange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExchanges", arg0, arg1)
+	ret0, _ := ret[0].([]db.Exchange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExchanges indicates an expected call of ListExchanges.
+func (mr *MockStoreMockRecorder) ListExchanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExchanges", reflect.TypeOf((*MockStore)(nil).ListExchanges), arg0, arg1)
+}
+
+// ListExpiredEscrows mocks base method.
+func (m *MockStore) ListExpiredEscrows(arg0 context.Context, arg1 int32) ([]db.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredEscrows", arg0, arg1)
+	ret0, _ := ret[0].([]db.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredEscrows indicates an expected call of ListExpiredEscrows.
+func (mr *MockStoreMockRecorder) ListExpiredEscrows(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredEscrows", reflect.TypeOf((*MockStore)(nil).ListExpiredEscrows), arg0, arg1)
+}
+
+// ListExpiredHolds mocks base method.
+func (m *MockStore) ListExpiredHolds(arg0 context.Context, arg1 int32) ([]db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredHolds", arg0, arg1)
+	ret0, _ := ret[0].([]db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredHolds indicates an expected call of ListExpiredHolds.
+func (mr *MockStoreMockRecorder) ListExpiredHolds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredHolds", reflect.TypeOf((*MockStore)(nil).ListExpiredHolds), arg0, arg1)
+}
+
+// ListFeeRules mocks base method.
+func (m *MockStore) ListFeeRules(arg0 context.Context, arg1 db.ListFeeRulesParams) ([]db.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeeRules", arg0, arg1)
+	ret0, _ := ret[0].([]db.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeeRules indicates an expected call of ListFeeRules.
+func (mr *MockStoreMockRecorder) ListFeeRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeRules", reflect.TypeOf((*MockStore)(nil).ListFeeRules), arg0, arg1)
+}
+
+// ListFeeTiers mocks base method.
+func (m *MockStore) ListFeeTiers(arg0 context.Context, arg1 int64) ([]db.FeeTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeeTiers", arg0, arg1)
+	ret0, _ := ret[0].([]db.FeeTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeeTiers indicates an expected call of ListFeeTiers.
+func (mr *MockStoreMockRecorder) ListFeeTiers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeeTiers", reflect.TypeOf((*MockStore)(nil).ListFeeTiers), arg0, arg1)
+}
+
+// ListFillsByOrder mocks base method.
+func (m *MockStore) ListFillsByOrder(arg0 context.Context, arg1 int64) ([]db.Fill, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFillsByOrder", arg0, arg1)
+	ret0, _ := ret[0].([]db.Fill)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFillsByOrder indicates an expected call of ListFillsByOrder.
+func (mr *MockStoreMockRecorder) ListFillsByOrder(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFillsByOrder", reflect.TypeOf((*MockStore)(nil).ListFillsByOrder), arg0, arg1)
+}
+
+// ListHolds mocks base method.
+func (m *MockStore) ListHolds(arg0 context.Context, arg1 db.ListHoldsParams) ([]db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHolds", arg0, arg1)
+	ret0, _ := ret[0].([]db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHolds indicates an expected call of ListHolds.
+func (mr *MockStoreMockRecorder) ListHolds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHolds", reflect.TypeOf((*MockStore)(nil).ListHolds), arg0, arg1)
+}
+
+// ListKYCSubmissions mocks base method.
+func (m *MockStore) ListKYCSubmissions(arg0 context.Context, arg1 db.ListKYCSubmissionsParams) ([]db.KycSubmission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKYCSubmissions", arg0, arg1)
+	ret0, _ := ret[0].([]db.KycSubmission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKYCSubmissions indicates an expected call of ListKYCSubmissions.
+func (mr *MockStoreMockRecorder) ListKYCSubmissions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKYCSubmissions", reflect.TypeOf((*MockStore)(nil).ListKYCSubmissions), arg0, arg1)
+}
+
+// ListOpenOrders mocks base method.
+func (m *MockStore) ListOpenOrders(arg0 context.Context) ([]db.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenOrders", arg0)
+	ret0, _ := ret[0].([]db.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenOrders indicates an expected call of ListOpenOrders.
+func (mr *MockStoreMockRecorder) ListOpenOrders(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenOrders", reflect.TypeOf((*MockStore)(nil).ListOpenOrders), arg0)
+}
+
+// ListOrders mocks base method.
+func (m *MockStore) ListOrders(arg0 context.Context, arg1 db.ListOrdersParams) ([]db.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrders", arg0, arg1)
+	ret0, _ := ret[0].([]db.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrders indicates an expected call of ListOrders.
+func (mr *MockStoreMockRecorder) ListOrders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockStore)(nil).ListOrders), arg0, arg1)
+}
+
+// ListPostingsByEntry mocks base method.
+func (m *MockStore) ListPostingsByEntry(arg0 context.Context, arg1 int64) ([]db.Posting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPostingsByEntry", arg0, arg1)
+	ret0, _ := ret[0].([]db.Posting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPostingsByEntry indicates an expected call of ListPostingsByEntry.
+func (mr *MockStoreMockRecorder) ListPostingsByEntry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPostingsByEntry", reflect.TypeOf((*MockStore)(nil).ListPostingsByEntry), arg0, arg1)
+}
+
+// ListRecords mocks base method.
+func (m *MockStore) ListRecords(arg0 context.Context, arg1 db.ListRecordsParams) ([]db.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecords", arg0, arg1)
+	ret0, _ := ret[0].([]db.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecords indicates an expected call of ListRecords.
+func (mr *MockStoreMockRecorder) ListRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecords", reflect.TypeOf((*MockStore)(nil).ListRecords), arg0, arg1)
+}
+
+// ListScheduledTransferRuns mocks base method.
+func (m *MockStore) ListScheduledTransferRuns(arg0 context.Context, arg1 db.ListScheduledTransferRunsParams) ([]db.ScheduledTransferRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledTransferRuns", arg0, arg1)
+	ret0, _ := ret[0].([]db.ScheduledTransferRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledTransferRuns indicates an expected call of ListScheduledTransferRuns.
+func (mr *MockStoreMockRecorder) ListScheduledTransferRuns(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledTransferRuns", reflect.TypeOf((*MockStore)(nil).ListScheduledTransferRuns), arg0, arg1)
+}
+
+// ListScheduledTransfers mocks base method.
+func (m *MockStore) ListScheduledTransfers(arg0 context.Context, arg1 db.ListScheduledTransfersParams) ([]db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduledTransfers", arg0, arg1)
+	ret0, _ := ret[0].([]db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduledTransfers indicates an expected call of ListScheduledTransfers.
+func (mr *MockStoreMockRecorder) ListScheduledTransfers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduledTransfers", reflect.TypeOf((*MockStore)(nil).ListScheduledTransfers), arg0, arg1)
+}
+
+// ListSymbols mocks base method.
+func (m *MockStore) ListSymbols(arg0 context.Context) ([]db.Symbol, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSymbols", arg0)
+	ret0, _ := ret[0].([]db.Symbol)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSymbols indicates an expected call of ListSymbols.
+func (mr *MockStoreMockRecorder) ListSymbols(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSymbols", reflect.TypeOf((*MockStore)(nil).ListSymbols), arg0)
+}
+
+// ListTraderDrifts mocks base method.
+func (m *MockStore) ListTraderDrifts(arg0 context.Context) ([]db.ListTraderDriftsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTraderDrifts", arg0)
+	ret0, _ := ret[0].([]db.ListTraderDriftsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTraderDrifts indicates an expected call of ListTraderDrifts.
+func (mr *MockStoreMockRecorder) ListTraderDrifts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTraderDrifts", reflect.TypeOf((*MockStore)(nil).ListTraderDrifts), arg0)
+}
+
+// ListTraderStatusChanges mocks base method.
+func (m *MockStore) ListTraderStatusChanges(arg0 context.Context, arg1 int64) ([]db.TraderStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTraderStatusChanges", arg0, arg1)
+	ret0, _ := ret[0].([]db.TraderStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTraderStatusChanges indicates an expected call of ListTraderStatusChanges.
+func (mr *MockStoreMockRecorder) ListTraderStatusChanges(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTraderStatusChanges", reflect.TypeOf((*MockStore)(nil).ListTraderStatusChanges), arg0, arg1)
+}
+
+// ListTraders mocks base method.
+func (m *MockStore) ListTraders(arg0 context.Context, arg1 db.ListTradersParams) ([]db.Trader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTraders", arg0, arg1)
+	ret0, _ := ret[0].([]db.Trader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTraders indicates an expected call of ListTraders.
+func (mr *MockStoreMockRecorder) ListTraders(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTraders", reflect.TypeOf((*MockStore)(nil).ListTraders), arg0, arg1)
+}
+
+// ListTransactions mocks base method.
+func (m *MockStore) ListTransactions(arg0 context.Context, arg1 db.ListTransactionsParams) ([]db.ListTransactionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransactions", arg0, arg1)
+	ret0, _ := ret[0].([]db.ListTransactionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransactions indicates an expected call of ListTransactions.
+func (mr *MockStoreMockRecorder) ListTransactions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MockStore)(nil).ListTransactions), arg0, arg1)
+}
+
+// ListTransferLimits mocks base method.
+func (m *MockStore) ListTransferLimits(arg0 context.Context, arg1 db.ListTransferLimitsParams) ([]db.TransferLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransferLimits", arg0, arg1)
+	ret0, _ := ret[0].([]db.TransferLimit)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddTraderRest indicates an expected call of AddTraderRest.
-func (mr *MockStoreMockRecorder) AddTraderRest(arg0, arg1 interface{}) *gomock.Call {
+// ListTransferLimits indicates an expected call of ListTransferLimits.
+func (mr *MockStoreMockRecorder) ListTransferLimits(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTraderRest", reflect.TypeOf((*MockStore)(nil).AddTraderRest), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferLimits", reflect.TypeOf((*MockStore)(nil).ListTransferLimits), arg0, arg1)
 }
 
-// CreateDetail mocks base method.
-func (m *MockStore) CreateDetail(arg0 context.Context, arg1 db.CreateDetailParams) (db.Detail, error) {
+// ListTransferReviews mocks base method.
+func (m *MockStore) ListTransferReviews(arg0 context.Context, arg1 db.ListTransferReviewsParams) ([]db.TransferReview, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateDetail", arg0, arg1)
-	ret0, _ := ret[0].(db.Detail)
+	ret := m.ctrl.Call(m, "ListTransferReviews", arg0, arg1)
+	ret0, _ := ret[0].([]db.TransferReview)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateDetail indicates an expected call of CreateDetail.
-func (mr *MockStoreMockRecorder) CreateDetail(arg0, arg1 interface{}) *gomock.Call {
+// ListTransferReviews indicates an expected call of ListTransferReviews.
+func (mr *MockStoreMockRecorder) ListTransferReviews(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDetail", reflect.TypeOf((*MockStore)(nil).CreateDetail), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferReviews", reflect.TypeOf((*MockStore)(nil).ListTransferReviews), arg0, arg1)
 }
 
-// CreateMember mocks base method.
-func (m *MockStore) CreateMember(arg0 context.Context, arg1 db.CreateMemberParams) (db.Member, error) {
+// ListUnbalancedEntries mocks base method.
+func (m *MockStore) ListUnbalancedEntries(arg0 context.Context) ([]db.ListUnbalancedEntriesRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateMember", arg0, arg1)
-	ret0, _ := ret[0].(db.Member)
+	ret := m.ctrl.Call(m, "ListUnbalancedEntries", arg0)
+	ret0, _ := ret[0].([]db.ListUnbalancedEntriesRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateMember indicates an expected call of CreateMember.
-func (mr *MockStoreMockRecorder) CreateMember(arg0, arg1 interface{}) *gomock.Call {
+// ListUnbalancedEntries indicates an expected call of ListUnbalancedEntries.
+func (mr *MockStoreMockRecorder) ListUnbalancedEntries(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMember", reflect.TypeOf((*MockStore)(nil).CreateMember), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnbalancedEntries", reflect.TypeOf((*MockStore)(nil).ListUnbalancedEntries), arg0)
 }
 
-// CreateMemberTx mocks base method.
-func (m *MockStore) CreateMemberTx(arg0 context.Context, arg1 db.CreateMemberTxParams) (db.CreateMemberTxResult, error) {
+// ListUnpairedRecords mocks base method.
+func (m *MockStore) ListUnpairedRecords(arg0 context.Context) ([]db.ListUnpairedRecordsRow, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateMemberTx", arg0, arg1)
-	ret0, _ := ret[0].(db.CreateMemberTxResult)
+	ret := m.ctrl.Call(m, "ListUnpairedRecords", arg0)
+	ret0, _ := ret[0].([]db.ListUnpairedRecordsRow)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateMemberTx indicates an expected call of CreateMemberTx.
-func (mr *MockStoreMockRecorder) CreateMemberTx(arg0, arg1 interface{}) *gomock.Call {
+// ListUnpairedRecords indicates an expected call of ListUnpairedRecords.
+func (mr *MockStoreMockRecorder) ListUnpairedRecords(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMemberTx", reflect.TypeOf((*MockStore)(nil).CreateMemberTx), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnpairedRecords", reflect.TypeOf((*MockStore)(nil).ListUnpairedRecords), arg0)
 }
 
-// CreateRecord mocks base method.
-func (m *MockStore) CreateRecord(arg0 context.Context, arg1 db.CreateRecordParams) (db.Record, error) {
+// ListWithdrawals mocks base method.
+func (m *MockStore) ListWithdrawals(arg0 context.Context, arg1 db.ListWithdrawalsParams) ([]db.Withdrawal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRecord", arg0, arg1)
-	ret0, _ := ret[0].(db.Record)
+	ret := m.ctrl.Call(m, "ListWithdrawals", arg0, arg1)
+	ret0, _ := ret[0].([]db.Withdrawal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateRecord indicates an expected call of CreateRecord.
-func (mr *MockStoreMockRecorder) CreateRecord(arg0, arg1 interface{}) *gomock.Call {
+// ListWithdrawals indicates an expected call of ListWithdrawals.
+func (mr *MockStoreMockRecorder) ListWithdrawals(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecord", reflect.TypeOf((*MockStore)(nil).CreateRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithdrawals", reflect.TypeOf((*MockStore)(nil).ListWithdrawals), arg0, arg1)
 }
 
-// CreateSession mocks base method.
-func (m *MockStore) CreateSession(arg0 context.Context, arg1 db.CreateSessionParams) (db.Session, error) {
+// OpenEscrowTx mocks base method.
+func (m *MockStore) OpenEscrowTx(arg0 context.Context, arg1 db.OpenEscrowTxParams) (db.EscrowTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", arg0, arg1)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "OpenEscrowTx", arg0, arg1)
+	ret0, _ := ret[0].(db.EscrowTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession.
-func (mr *MockStoreMockRecorder) CreateSession(arg0, arg1 interface{}) *gomock.Call {
+// OpenEscrowTx indicates an expected call of OpenEscrowTx.
+func (mr *MockStoreMockRecorder) OpenEscrowTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockStore)(nil).CreateSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenEscrowTx", reflect.TypeOf((*MockStore)(nil).OpenEscrowTx), arg0, arg1)
 }
 
-// CreateTrader mocks base method.
-func (m *MockStore) CreateTrader(arg0 context.Context, arg1 db.CreateTraderParams) (db.Trader, error) {
+// PlaceHold mocks base method.
+func (m *MockStore) PlaceHold(arg0 context.Context, arg1 db.PlaceHoldParams) (db.HoldResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTrader", arg0, arg1)
-	ret0, _ := ret[0].(db.Trader)
+	ret := m.ctrl.Call(m, "PlaceHold", arg0, arg1)
+	ret0, _ := ret[0].(db.HoldResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateTrader indicates an expected call of CreateTrader.
-func (mr *MockStoreMockRecorder) CreateTrader(arg0, arg1 interface{}) *gomock.Call {
+// PlaceHold indicates an expected call of PlaceHold.
+func (mr *MockStoreMockRecorder) PlaceHold(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTrader", reflect.TypeOf((*MockStore)(nil).CreateTrader), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceHold", reflect.TypeOf((*MockStore)(nil).PlaceHold), arg0, arg1)
 }
 
-// CreateVerifyEmail mocks base method.
-func (m *MockStore) CreateVerifyEmail(arg0 context.Context, arg1 db.CreateVerifyEmailParams) (db.VerifyEmail, error) {
+// PostJournalEntry mocks base method.
+func (m *MockStore) PostJournalEntry(arg0 context.Context, arg1 db.PostJournalEntryParams) (db.PostJournalEntryResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVerifyEmail", arg0, arg1)
-	ret0, _ := ret[0].(db.VerifyEmail)
+	ret := m.ctrl.Call(m, "PostJournalEntry", arg0, arg1)
+	ret0, _ := ret[0].(db.PostJournalEntryResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateVerifyEmail indicates an expected call of CreateVerifyEmail.
-func (mr *MockStoreMockRecorder) CreateVerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+// PostJournalEntry indicates an expected call of PostJournalEntry.
+func (mr *MockStoreMockRecorder) PostJournalEntry(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVerifyEmail", reflect.TypeOf((*MockStore)(nil).CreateVerifyEmail), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostJournalEntry", reflect.TypeOf((*MockStore)(nil).PostJournalEntry), arg0, arg1)
 }
 
-// DeleteTrader mocks base method.
-func (m *MockStore) DeleteTrader(arg0 context.Context, arg1 int64) error {
+// Reconcile mocks base method.
+func (m *MockStore) Reconcile(arg0 context.Context) (db.ReconcileReport, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteTrader", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Reconcile", arg0)
+	ret0, _ := ret[0].(db.ReconcileReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteTrader indicates an expected call of DeleteTrader.
-func (mr *MockStoreMockRecorder) DeleteTrader(arg0, arg1 interface{}) *gomock.Call {
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockStoreMockRecorder) Reconcile(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrader", reflect.TypeOf((*MockStore)(nil).DeleteTrader), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockStore)(nil).Reconcile), arg0)
 }
 
-// GetDetail mocks base method.
-func (m *MockStore) GetDetail(arg0 context.Context, arg1 int64) (db.Detail, error) {
+// RecordTx mocks base method.
+func (m *MockStore) RecordTx(arg0 context.Context, arg1 db.RecordTxParams) (db.RecordTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDetail", arg0, arg1)
-	ret0, _ := ret[0].(db.Detail)
+	ret := m.ctrl.Call(m, "RecordTx", arg0, arg1)
+	ret0, _ := ret[0].(db.RecordTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDetail indicates an expected call of GetDetail.
-func (mr *MockStoreMockRecorder) GetDetail(arg0, arg1 interface{}) *gomock.Call {
+// RecordTx indicates an expected call of RecordTx.
+func (mr *MockStoreMockRecorder) RecordTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetail", reflect.TypeOf((*MockStore)(nil).GetDetail), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordTx", reflect.TypeOf((*MockStore)(nil).RecordTx), arg0, arg1)
 }
 
-// GetMember mocks base method.
-func (m *MockStore) GetMember(arg0 context.Context, arg1 string) (db.Member, error) {
+// ReleaseHold mocks base method.
+func (m *MockStore) ReleaseHold(arg0 context.Context, arg1 int64) (db.HoldResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMember", arg0, arg1)
-	ret0, _ := ret[0].(db.Member)
+	ret := m.ctrl.Call(m, "ReleaseHold", arg0, arg1)
+	ret0, _ := ret[0].(db.HoldResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMember indicates an expected call of GetMember.
-func (mr *MockStoreMockRecorder) GetMember(arg0, arg1 interface{}) *gomock.Call {
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockStoreMockRecorder) ReleaseHold(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockStore)(nil).GetMember), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockStore)(nil).ReleaseHold), arg0, arg1)
 }
 
-// GetRecord mocks base method.
-func (m *MockStore) GetRecord(arg0 context.Context, arg1 int64) (db.Record, error) {
+// ResolveEscrowTx mocks base method.
+func (m *MockStore) ResolveEscrowTx(arg0 context.Context, arg1 db.ResolveEscrowTxParams) (db.EscrowTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRecord", arg0, arg1)
-	ret0, _ := ret[0].(db.Record)
+	ret := m.ctrl.Call(m, "ResolveEscrowTx", arg0, arg1)
+	ret0, _ := ret[0].(db.EscrowTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRecord indicates an expected call of GetRecord.
-func (mr *MockStoreMockRecorder) GetRecord(arg0, arg1 interface{}) *gomock.Call {
+// ResolveEscrowTx indicates an expected call of ResolveEscrowTx.
+func (mr *MockStoreMockRecorder) ResolveEscrowTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecord", reflect.TypeOf((*MockStore)(nil).GetRecord), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveEscrowTx", reflect.TypeOf((*MockStore)(nil).ResolveEscrowTx), arg0, arg1)
 }
 
-// GetSession mocks base method.
-func (m *MockStore) GetSession(arg0 context.Context, arg1 uuid.UUID) (db.Session, error) {
+// ReverseRecordTx mocks base method.
+func (m *MockStore) ReverseRecordTx(arg0 context.Context, arg1 db.ReverseRecordTxParams) (db.ReverseRecordTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", arg0, arg1)
-	ret0, _ := ret[0].(db.Session)
+	ret := m.ctrl.Call(m, "ReverseRecordTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ReverseRecordTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockStoreMockRecorder) GetSession(arg0, arg1 interface{}) *gomock.Call {
+// ReverseRecordTx indicates an expected call of ReverseRecordTx.
+func (mr *MockStoreMockRecorder) ReverseRecordTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockStore)(nil).GetSession), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseRecordTx", reflect.TypeOf((*MockStore)(nil).ReverseRecordTx), arg0, arg1)
 }
 
-// GetTrader mocks base method.
-func (m *MockStore) GetTrader(arg0 context.Context, arg1 int64) (db.Trader, error) {
+// ReviewKYCSubmission mocks base method.
+func (m *MockStore) ReviewKYCSubmission(arg0 context.Context, arg1 db.ReviewKYCSubmissionParams) (db.KycSubmission, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTrader", arg0, arg1)
-	ret0, _ := ret[0].(db.Trader)
+	ret := m.ctrl.Call(m, "ReviewKYCSubmission", arg0, arg1)
+	ret0, _ := ret[0].(db.KycSubmission)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTrader indicates an expected call of GetTrader.
-func (mr *MockStoreMockRecorder) GetTrader(arg0, arg1 interface{}) *gomock.Call {
+// ReviewKYCSubmission indicates an expected call of ReviewKYCSubmission.
+func (mr *MockStoreMockRecorder) ReviewKYCSubmission(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrader", reflect.TypeOf((*MockStore)(nil).GetTrader), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewKYCSubmission", reflect.TypeOf((*MockStore)(nil).ReviewKYCSubmission), arg0, arg1)
 }
 
-// GetTraderForUpdate mocks base method.
-func (m *MockStore) GetTraderForUpdate(arg0 context.Context, arg1 int64) (db.Trader, error) {
+// ReviewKYCSubmissionTx mocks base method.
+func (m *MockStore) ReviewKYCSubmissionTx(arg0 context.Context, arg1 db.ReviewKYCSubmissionTxParams) (db.ReviewKYCSubmissionTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTraderForUpdate", arg0, arg1)
-	ret0, _ := ret[0].(db.Trader)
+	ret := m.ctrl.Call(m, "ReviewKYCSubmissionTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ReviewKYCSubmissionTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTraderForUpdate indicates an expected call of GetTraderForUpdate.
-func (mr *MockStoreMockRecorder) GetTraderForUpdate(arg0, arg1 interface{}) *gomock.Call {
+// ReviewKYCSubmissionTx indicates an expected call of ReviewKYCSubmissionTx.
+func (mr *MockStoreMockRecorder) ReviewKYCSubmissionTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTraderForUpdate", reflect.TypeOf((*MockStore)(nil).GetTraderForUpdate), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewKYCSubmissionTx", reflect.TypeOf((*MockStore)(nil).ReviewKYCSubmissionTx), arg0, arg1)
 }
 
-// ListDetails mocks base method.
-func (m *MockStore) ListDetails(arg0 context.Context, arg1 db.ListDetailsParams) ([]db.Detail, error) {
+// ReviewTransferTx mocks base method.
+func (m *MockStore) ReviewTransferTx(arg0 context.Context, arg1 db.ReviewTransferTxParams) (db.ReviewTransferTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDetails", arg0, arg1)
-	ret0, _ := ret[0].([]db.Detail)
+	ret := m.ctrl.Call(m, "ReviewTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ReviewTransferTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListDetails indicates an expected call of ListDetails.
-func (mr *MockStoreMockRecorder) ListDetails(arg0, arg1 interface{}) *gomock.Call {
+// ReviewTransferTx indicates an expected call of ReviewTransferTx.
+func (mr *MockStoreMockRecorder) ReviewTransferTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDetails", reflect.TypeOf((*MockStore)(nil).ListDetails), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewTransferTx", reflect.TypeOf((*MockStore)(nil).ReviewTransferTx), arg0, arg1)
 }
 
-// ListRecords mocks base method.
-func (m *MockStore) ListRecords(arg0 context.Context, arg1 db.ListRecordsParams) ([]db.Record, error) {
+// ReviewWithdrawal mocks base method.
+func (m *MockStore) ReviewWithdrawal(arg0 context.Context, arg1 db.ReviewWithdrawalParams) (db.Withdrawal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRecords", arg0, arg1)
-	ret0, _ := ret[0].([]db.Record)
+	ret := m.ctrl.Call(m, "ReviewWithdrawal", arg0, arg1)
+	ret0, _ := ret[0].(db.Withdrawal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRecords indicates an expected call of ListRecords.
-func (mr *MockStoreMockRecorder) ListRecords(arg0, arg1 interface{}) *gomock.Call {
+// ReviewWithdrawal indicates an expected call of ReviewWithdrawal.
+func (mr *MockStoreMockRecorder) ReviewWithdrawal(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecords", reflect.TypeOf((*MockStore)(nil).ListRecords), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewWithdrawal", reflect.TypeOf((*MockStore)(nil).ReviewWithdrawal), arg0, arg1)
 }
 
-// ListTraders mocks base method.
-func (m *MockStore) ListTraders(arg0 context.Context, arg1 db.ListTradersParams) ([]db.Trader, error) {
+// ReviewWithdrawalTx mocks base method.
+func (m *MockStore) ReviewWithdrawalTx(arg0 context.Context, arg1 db.ReviewWithdrawalTxParams) (db.ReviewWithdrawalTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTraders", arg0, arg1)
-	ret0, _ := ret[0].([]db.Trader)
+	ret := m.ctrl.Call(m, "ReviewWithdrawalTx", arg0, arg1)
+	ret0, _ := ret[0].(db.ReviewWithdrawalTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListTraders indicates an expected call of ListTraders.
-func (mr *MockStoreMockRecorder) ListTraders(arg0, arg1 interface{}) *gomock.Call {
+// ReviewWithdrawalTx indicates an expected call of ReviewWithdrawalTx.
+func (mr *MockStoreMockRecorder) ReviewWithdrawalTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTraders", reflect.TypeOf((*MockStore)(nil).ListTraders), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewWithdrawalTx", reflect.TypeOf((*MockStore)(nil).ReviewWithdrawalTx), arg0, arg1)
 }
 
-// RecordTx mocks base method.
-func (m *MockStore) RecordTx(arg0 context.Context, arg1 db.RecordTxParams) (db.RecordTxResult, error) {
+// RunScheduledTransferTx mocks base method.
+func (m *MockStore) RunScheduledTransferTx(arg0 context.Context, arg1 db.RunScheduledTransferTxParams) (db.RunScheduledTransferTxResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RecordTx", arg0, arg1)
-	ret0, _ := ret[0].(db.RecordTxResult)
+	ret := m.ctrl.Call(m, "RunScheduledTransferTx", arg0, arg1)
+	ret0, _ := ret[0].(db.RunScheduledTransferTxResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RecordTx indicates an expected call of RecordTx.
-func (mr *MockStoreMockRecorder) RecordTx(arg0, arg1 interface{}) *gomock.Call {
+// RunScheduledTransferTx indicates an expected call of RunScheduledTransferTx.
+func (mr *MockStoreMockRecorder) RunScheduledTransferTx(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordTx", reflect.TypeOf((*MockStore)(nil).RecordTx), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunScheduledTransferTx", reflect.TypeOf((*MockStore)(nil).RunScheduledTransferTx), arg0, arg1)
+}
+
+// SetFeeRuleTx mocks base method.
+func (m *MockStore) SetFeeRuleTx(arg0 context.Context, arg1 db.SetFeeRuleTxParams) (db.FeeRuleResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeeRuleTx", arg0, arg1)
+	ret0, _ := ret[0].(db.FeeRuleResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFeeRuleTx indicates an expected call of SetFeeRuleTx.
+func (mr *MockStoreMockRecorder) SetFeeRuleTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeeRuleTx", reflect.TypeOf((*MockStore)(nil).SetFeeRuleTx), arg0, arg1)
+}
+
+// SettleTransferReview mocks base method.
+func (m *MockStore) SettleTransferReview(arg0 context.Context, arg1 db.SettleTransferReviewParams) (db.TransferReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleTransferReview", arg0, arg1)
+	ret0, _ := ret[0].(db.TransferReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SettleTransferReview indicates an expected call of SettleTransferReview.
+func (mr *MockStoreMockRecorder) SettleTransferReview(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleTransferReview", reflect.TypeOf((*MockStore)(nil).SettleTransferReview), arg0, arg1)
+}
+
+// UpdateEscrow mocks base method.
+func (m *MockStore) UpdateEscrow(arg0 context.Context, arg1 db.UpdateEscrowParams) (db.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEscrow", arg0, arg1)
+	ret0, _ := ret[0].(db.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEscrow indicates an expected call of UpdateEscrow.
+func (mr *MockStoreMockRecorder) UpdateEscrow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEscrow", reflect.TypeOf((*MockStore)(nil).UpdateEscrow), arg0, arg1)
+}
+
+// UpdateHold mocks base method.
+func (m *MockStore) UpdateHold(arg0 context.Context, arg1 db.UpdateHoldParams) (db.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHold", arg0, arg1)
+	ret0, _ := ret[0].(db.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHold indicates an expected call of UpdateHold.
+func (mr *MockStoreMockRecorder) UpdateHold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHold", reflect.TypeOf((*MockStore)(nil).UpdateHold), arg0, arg1)
 }
 
 // UpdateMember mocks base method.
@@ -335,6 +2133,66 @@ func (mr *MockStoreMockRecorder) UpdateMember(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMember", reflect.TypeOf((*MockStore)(nil).UpdateMember), arg0, arg1)
 }
 
+// UpdateMemberKYCLevel mocks base method.
+func (m *MockStore) UpdateMemberKYCLevel(arg0 context.Context, arg1 db.UpdateMemberKYCLevelParams) (db.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberKYCLevel", arg0, arg1)
+	ret0, _ := ret[0].(db.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMemberKYCLevel indicates an expected call of UpdateMemberKYCLevel.
+func (mr *MockStoreMockRecorder) UpdateMemberKYCLevel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberKYCLevel", reflect.TypeOf((*MockStore)(nil).UpdateMemberKYCLevel), arg0, arg1)
+}
+
+// UpdateOrderStatus mocks base method.
+func (m *MockStore) UpdateOrderStatus(arg0 context.Context, arg1 db.UpdateOrderStatusParams) (db.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrderStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrderStatus indicates an expected call of UpdateOrderStatus.
+func (mr *MockStoreMockRecorder) UpdateOrderStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderStatus", reflect.TypeOf((*MockStore)(nil).UpdateOrderStatus), arg0, arg1)
+}
+
+// UpdateScheduledTransfer mocks base method.
+func (m *MockStore) UpdateScheduledTransfer(arg0 context.Context, arg1 db.UpdateScheduledTransferParams) (db.ScheduledTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledTransfer", arg0, arg1)
+	ret0, _ := ret[0].(db.ScheduledTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduledTransfer indicates an expected call of UpdateScheduledTransfer.
+func (mr *MockStoreMockRecorder) UpdateScheduledTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledTransfer", reflect.TypeOf((*MockStore)(nil).UpdateScheduledTransfer), arg0, arg1)
+}
+
+// UpdateSymbol mocks base method.
+func (m *MockStore) UpdateSymbol(arg0 context.Context, arg1 db.UpdateSymbolParams) (db.Symbol, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSymbol", arg0, arg1)
+	ret0, _ := ret[0].(db.Symbol)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSymbol indicates an expected call of UpdateSymbol.
+func (mr *MockStoreMockRecorder) UpdateSymbol(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSymbol", reflect.TypeOf((*MockStore)(nil).UpdateSymbol), arg0, arg1)
+}
+
 // UpdateTrader mocks base method.
 func (m *MockStore) UpdateTrader(arg0 context.Context, arg1 db.UpdateTraderParams) (db.Trader, error) {
 	m.ctrl.T.Helper()
@@ -350,6 +2208,51 @@ func (mr *MockStoreMockRecorder) UpdateTrader(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrader", reflect.TypeOf((*MockStore)(nil).UpdateTrader), arg0, arg1)
 }
 
+// UpdateTraderOverdraftLimit mocks base method.
+func (m *MockStore) UpdateTraderOverdraftLimit(arg0 context.Context, arg1 db.UpdateTraderOverdraftLimitParams) (db.Trader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTraderOverdraftLimit", arg0, arg1)
+	ret0, _ := ret[0].(db.Trader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTraderOverdraftLimit indicates an expected call of UpdateTraderOverdraftLimit.
+func (mr *MockStoreMockRecorder) UpdateTraderOverdraftLimit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTraderOverdraftLimit", reflect.TypeOf((*MockStore)(nil).UpdateTraderOverdraftLimit), arg0, arg1)
+}
+
+// UpdateTraderStatus mocks base method.
+func (m *MockStore) UpdateTraderStatus(arg0 context.Context, arg1 db.UpdateTraderStatusParams) (db.Trader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTraderStatus", arg0, arg1)
+	ret0, _ := ret[0].(db.Trader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTraderStatus indicates an expected call of UpdateTraderStatus.
+func (mr *MockStoreMockRecorder) UpdateTraderStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTraderStatus", reflect.TypeOf((*MockStore)(nil).UpdateTraderStatus), arg0, arg1)
+}
+
+// UpdateTraderStatusTx mocks base method.
+func (m *MockStore) UpdateTraderStatusTx(arg0 context.Context, arg1 db.UpdateTraderStatusTxParams) (db.UpdateTraderStatusTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTraderStatusTx", arg0, arg1)
+	ret0, _ := ret[0].(db.UpdateTraderStatusTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTraderStatusTx indicates an expected call of UpdateTraderStatusTx.
+func (mr *MockStoreMockRecorder) UpdateTraderStatusTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTraderStatusTx", reflect.TypeOf((*MockStore)(nil).UpdateTraderStatusTx), arg0, arg1)
+}
+
 // UpdateVerifyEmail mocks base method.
 func (m *MockStore) UpdateVerifyEmail(arg0 context.Context, arg1 db.UpdateVerifyEmailParams) (db.VerifyEmail, error) {
 	m.ctrl.T.Helper()
@@ -365,6 +2268,36 @@ func (mr *MockStoreMockRecorder) UpdateVerifyEmail(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVerifyEmail", reflect.TypeOf((*MockStore)(nil).UpdateVerifyEmail), arg0, arg1)
 }
 
+// UpsertFeeRule mocks base method.
+func (m *MockStore) UpsertFeeRule(arg0 context.Context, arg1 db.UpsertFeeRuleParams) (db.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFeeRule", arg0, arg1)
+	ret0, _ := ret[0].(db.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertFeeRule indicates an expected call of UpsertFeeRule.
+func (mr *MockStoreMockRecorder) UpsertFeeRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFeeRule", reflect.TypeOf((*MockStore)(nil).UpsertFeeRule), arg0, arg1)
+}
+
+// UpsertTransferLimit mocks base method.
+func (m *MockStore) UpsertTransferLimit(arg0 context.Context, arg1 db.UpsertTransferLimitParams) (db.TransferLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertTransferLimit", arg0, arg1)
+	ret0, _ := ret[0].(db.TransferLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertTransferLimit indicates an expected call of UpsertTransferLimit.
+func (mr *MockStoreMockRecorder) UpsertTransferLimit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertTransferLimit", reflect.TypeOf((*MockStore)(nil).UpsertTransferLimit), arg0, arg1)
+}
+
 // VerifyEmailTx mocks base method.
 func (m *MockStore) VerifyEmailTx(arg0 context.Context, arg1 db.VerifyEmailTxParams) (db.VerifyEmailTxResult, error) {
 	m.ctrl.T.Helper()
@@ -379,3 +2312,18 @@ func (mr *MockStoreMockRecorder) VerifyEmailTx(arg0, arg1 interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmailTx", reflect.TypeOf((*MockStore)(nil).VerifyEmailTx), arg0, arg1)
 }
+
+// WithdrawalTx mocks base method.
+func (m *MockStore) WithdrawalTx(arg0 context.Context, arg1 db.WithdrawalTxParams) (db.WithdrawalTxResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawalTx", arg0, arg1)
+	ret0, _ := ret[0].(db.WithdrawalTxResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawalTx indicates an expected call of WithdrawalTx.
+func (mr *MockStoreMockRecorder) WithdrawalTx(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawalTx", reflect.TypeOf((*MockStore)(nil).WithdrawalTx), arg0, arg1)
+}
@@ -0,0 +1,48 @@
+-- name: CreateBalanceSnapshots :many
+INSERT INTO balance_snapshots (trader_id, snapshot_time, balance)
+SELECT
+  t.id,
+  sqlc.arg(snapshot_time)::timestamptz,
+  COALESCE(s.balance, 0) + COALESCE((
+    SELECT SUM(d.number)
+    FROM details d
+    WHERE
+      d.trader_id = t.id AND
+      d.created_time >= COALESCE(s.snapshot_time, '-infinity'::timestamptz) AND
+      d.created_time < sqlc.arg(snapshot_time)::timestamptz
+  ), 0)
+FROM (
+  SELECT tr.id FROM traders tr
+  WHERE tr.id > sqlc.arg(after_id)::bigint AND tr.created_time < sqlc.arg(snapshot_time)::timestamptz
+  ORDER BY tr.id
+  LIMIT sqlc.arg('limit')::int
+) t
+LEFT JOIN LATERAL (
+  SELECT bs.snapshot_time, bs.balance
+  FROM balance_snapshots bs
+  WHERE bs.trader_id = t.id AND bs.snapshot_time < sqlc.arg(snapshot_time)::timestamptz
+  ORDER BY bs.snapshot_time DESC
+  LIMIT 1
+) s ON true
+ON CONFLICT (trader_id, snapshot_time) DO UPDATE SET balance = EXCLUDED.balance
+RETURNING *;
+
+-- name: GetBalanceAt :one
+-- the balance of a trader right before at_time, replayed from its latest snapshot
+WITH snapshot AS (
+  SELECT snapshot_time, balance
+  FROM balance_snapshots
+  WHERE trader_id = sqlc.arg(trader_id) AND snapshot_time <= sqlc.arg(at_time)::timestamptz
+  ORDER BY snapshot_time DESC
+  LIMIT 1
+)
+SELECT (
+  COALESCE((SELECT balance FROM snapshot), 0) + COALESCE((
+    SELECT SUM(d.number)
+    FROM details d
+    WHERE
+      d.trader_id = sqlc.arg(trader_id) AND
+      d.created_time >= COALESCE((SELECT snapshot_time FROM snapshot), '-infinity'::timestamptz) AND
+      d.created_time < sqlc.arg(at_time)::timestamptz
+  ), 0)
+)::bigint AS balance;
@@ -0,0 +1,20 @@
+-- name: CreateDeposit :one
+INSERT INTO deposits (
+  trader_id,
+  number,
+  reference,
+  entry_id
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING *;
+
+-- name: GetDeposit :one
+SELECT * FROM deposits
+WHERE id = $1 LIMIT 1;
+
+-- name: ListDeposits :many
+SELECT * FROM deposits
+WHERE trader_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
@@ -1,9 +1,10 @@
 -- name: CreateDetail :one
 INSERT INTO details (
   trader_id,
-  number
+  number,
+  record_id
 ) VALUES (
-  $1, $2
+  $1, $2, sqlc.narg(record_id)
 ) RETURNING *;
 
 -- name: GetDetail :one
@@ -12,7 +13,21 @@ WHERE id = $1 LIMIT 1;
 
 -- name: ListDetails :many
 SELECT * FROM details
-WHERE trader_id = $1
+WHERE trader_id = sqlc.arg(trader_id) AND id > sqlc.arg(after_id)
 ORDER BY id
-LIMIT $2
-OFFSET $3;
+LIMIT sqlc.arg('limit');
+
+-- name: ListDetailsByRecord :many
+SELECT * FROM details
+WHERE record_id = sqlc.arg(record_id)::bigint
+ORDER BY id;
+
+-- name: ListDetailsInPeriod :many
+SELECT * FROM details
+WHERE
+    trader_id = sqlc.arg(trader_id) AND
+    created_time >= sqlc.arg(from_time)::timestamptz AND
+    created_time < sqlc.arg(to_time)::timestamptz AND
+    id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg('limit');
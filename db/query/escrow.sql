@@ -0,0 +1,52 @@
+-- name: CreateEscrow :one
+INSERT INTO escrows (
+  buyer,
+  seller,
+  buyer_trader_id,
+  seller_trader_id,
+  escrow_trader_id,
+  number,
+  fund_entry_id,
+  expires_time
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetEscrow :one
+SELECT * FROM escrows
+WHERE id = $1 LIMIT 1;
+
+-- name: GetEscrowForUpdate :one
+SELECT * FROM escrows
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListEscrows :many
+SELECT * FROM escrows
+WHERE buyer = sqlc.arg(membername) OR seller = sqlc.arg(membername)
+ORDER BY id
+LIMIT $1
+OFFSET $2;
+
+-- name: ListExpiredEscrows :many
+SELECT * FROM escrows
+WHERE status = 'open' AND expires_time <= now()
+ORDER BY expires_time
+LIMIT $1;
+
+-- name: UpdateEscrow :one
+UPDATE escrows
+SET
+  status = sqlc.arg(status),
+  buyer_confirmed = sqlc.arg(buyer_confirmed),
+  seller_confirmed = sqlc.arg(seller_confirmed),
+  settle_entry_id = sqlc.narg(settle_entry_id),
+  resolver = sqlc.narg(resolver),
+  settled_time = CASE WHEN sqlc.narg(settle_entry_id)::bigint IS NULL THEN NULL ELSE now() END
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CountUnsettledEscrowsByTrader :one
+SELECT COUNT(*) FROM escrows
+WHERE (buyer_trader_id = $1 OR seller_trader_id = $1)
+  AND status IN ('open', 'disputed');
@@ -0,0 +1,28 @@
+-- name: CreateExchange :one
+INSERT INTO exchanges (
+  from_trader_id,
+  to_trader_id,
+  from_symbol,
+  to_symbol,
+  from_number,
+  to_number,
+  rate,
+  from_detail_id,
+  to_detail_id,
+  entry_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, sqlc.arg(entry_id)::bigint
+) RETURNING *;
+
+-- name: GetExchange :one
+SELECT * FROM exchanges
+WHERE id = $1 LIMIT 1;
+
+-- name: ListExchanges :many
+SELECT * FROM exchanges
+WHERE
+    from_trader_id = sqlc.arg(trader_id) OR
+    to_trader_id = sqlc.arg(trader_id)
+ORDER BY id
+LIMIT sqlc.arg('limit')
+OFFSET sqlc.arg('offset');
@@ -0,0 +1,67 @@
+-- name: UpsertFeeRule :one
+INSERT INTO fee_rules (
+  symbol,
+  role,
+  kind,
+  flat_fee,
+  rate_bps,
+  min_fee,
+  max_fee
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (symbol, role) DO UPDATE
+SET
+  kind = EXCLUDED.kind,
+  flat_fee = EXCLUDED.flat_fee,
+  rate_bps = EXCLUDED.rate_bps,
+  min_fee = EXCLUDED.min_fee,
+  max_fee = EXCLUDED.max_fee
+RETURNING *;
+
+-- name: GetFeeRule :one
+SELECT * FROM fee_rules
+WHERE id = $1 LIMIT 1;
+
+-- name: GetApplicableFeeRule :one
+SELECT * FROM fee_rules
+WHERE symbol = sqlc.arg(symbol) AND role IN (sqlc.arg(role)::varchar, '')
+ORDER BY role DESC
+LIMIT 1;
+
+-- name: ListFeeRules :many
+SELECT * FROM fee_rules
+ORDER BY symbol, role
+LIMIT $1
+OFFSET $2;
+
+-- name: DeleteFeeRule :exec
+DELETE FROM fee_rules
+WHERE id = $1;
+
+-- name: CreateFeeTier :one
+INSERT INTO fee_tiers (
+  rule_id,
+  min_volume,
+  rate_bps
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: ListFeeTiers :many
+SELECT * FROM fee_tiers
+WHERE rule_id = $1
+ORDER BY min_volume;
+
+-- name: DeleteFeeTiers :exec
+DELETE FROM fee_tiers
+WHERE rule_id = $1;
+
+-- name: GetMonthlyVolume :one
+SELECT COALESCE(SUM(r.number), 0)::bigint AS volume
+FROM records r
+JOIN traders t ON t.id = r.from_trader_id
+WHERE t.holder = sqlc.arg(holder)
+  AND t.symbol = sqlc.arg(symbol)
+  AND r.reversal_of IS NULL
+  AND r.created_time >= date_trunc('month', now());
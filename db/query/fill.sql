@@ -0,0 +1,20 @@
+-- name: CreateFill :one
+INSERT INTO fills (
+  maker_order_id,
+  taker_order_id,
+  price,
+  quantity,
+  base_record_id,
+  quote_record_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetFill :one
+SELECT * FROM fills
+WHERE id = $1 LIMIT 1;
+
+-- name: ListFillsByOrder :many
+SELECT * FROM fills
+WHERE maker_order_id = @order_id OR taker_order_id = @order_id
+ORDER BY id;
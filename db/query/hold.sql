@@ -0,0 +1,40 @@
+-- name: CreateHold :one
+INSERT INTO holds (
+  trader_id,
+  number,
+  remaining,
+  reason,
+  expires_time
+) VALUES (
+  sqlc.arg(trader_id), sqlc.arg(number), sqlc.arg(number), sqlc.arg(reason), sqlc.narg(expires_time)
+) RETURNING *;
+
+-- name: GetHold :one
+SELECT * FROM holds
+WHERE id = $1 LIMIT 1;
+
+-- name: GetHoldForUpdate :one
+SELECT * FROM holds
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListHolds :many
+SELECT * FROM holds
+WHERE trader_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: ListExpiredHolds :many
+SELECT * FROM holds
+WHERE status = 'active' AND expires_time <= now()
+ORDER BY expires_time
+LIMIT $1;
+
+-- name: UpdateHold :one
+UPDATE holds
+SET
+  remaining = sqlc.arg(remaining),
+  status = sqlc.arg(status)
+WHERE id = sqlc.arg(id)
+RETURNING *;
@@ -0,0 +1,27 @@
+-- name: CreateJournalEntry :one
+INSERT INTO journal_entries (
+  kind,
+  record_id
+) VALUES (
+  $1, sqlc.narg(record_id)
+) RETURNING *;
+
+-- name: GetJournalEntry :one
+SELECT * FROM journal_entries
+WHERE id = $1 LIMIT 1;
+
+-- name: CreatePosting :one
+INSERT INTO postings (
+  entry_id,
+  trader_id,
+  symbol,
+  number,
+  detail_id
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListPostingsByEntry :many
+SELECT * FROM postings
+WHERE entry_id = $1
+ORDER BY id;
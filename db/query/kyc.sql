@@ -0,0 +1,38 @@
+-- name: CreateKYCSubmission :one
+INSERT INTO kyc_submissions (
+  membername,
+  level,
+  legal_name,
+  date_of_birth,
+  country,
+  document_type,
+  document_number
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetKYCSubmission :one
+SELECT * FROM kyc_submissions
+WHERE id = $1 LIMIT 1;
+
+-- name: GetKYCSubmissionForUpdate :one
+SELECT * FROM kyc_submissions
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListKYCSubmissions :many
+SELECT * FROM kyc_submissions
+WHERE status = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: ReviewKYCSubmission :one
+UPDATE kyc_submissions
+SET
+  status = sqlc.arg(status),
+  reason = sqlc.arg(reason),
+  reviewer = sqlc.arg(reviewer)::varchar,
+  reviewed_time = now()
+WHERE id = sqlc.arg(id)
+RETURNING *;
@@ -23,3 +23,9 @@ SET
 WHERE
   membername = sqlc.arg(membername)
 RETURNING *;
+
+-- name: UpdateMemberKYCLevel :one
+UPDATE members
+SET kyc_level = sqlc.arg(kyc_level)
+WHERE membername = sqlc.arg(membername)
+RETURNING *;
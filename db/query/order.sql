@@ -0,0 +1,55 @@
+-- name: CreateOrder :one
+INSERT INTO orders (
+  holder,
+  base_trader_id,
+  quote_trader_id,
+  base_symbol,
+  quote_symbol,
+  side,
+  kind,
+  price,
+  quantity,
+  hold_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, sqlc.narg(hold_id)
+) RETURNING *;
+
+-- name: GetOrder :one
+SELECT * FROM orders
+WHERE id = $1 LIMIT 1;
+
+-- name: GetOrderForUpdate :one
+SELECT * FROM orders
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetOrderByHoldForUpdate :one
+SELECT * FROM orders
+WHERE hold_id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListOrders :many
+SELECT * FROM orders
+WHERE holder = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: ListOpenOrders :many
+SELECT * FROM orders
+WHERE status = 'open'
+ORDER BY id;
+
+-- name: AddOrderFilled :one
+UPDATE orders
+SET
+  filled = filled + sqlc.arg(quantity),
+  status = CASE WHEN filled + sqlc.arg(quantity) >= orders.quantity THEN 'filled' ELSE status END
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: UpdateOrderStatus :one
+UPDATE orders
+SET status = $2
+WHERE id = $1
+RETURNING *;
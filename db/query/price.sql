@@ -0,0 +1,25 @@
+-- name: CreatePrice :one
+INSERT INTO prices (
+  base,
+  quote,
+  rate,
+  source,
+  price_time
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (base, quote, price_time) DO UPDATE
+SET rate = EXCLUDED.rate, source = EXCLUDED.source
+RETURNING *;
+
+-- name: GetLatestPrice :one
+SELECT * FROM prices
+WHERE base = $1 AND quote = $2
+ORDER BY price_time DESC
+LIMIT 1;
+
+-- name: GetPriceAt :one
+SELECT * FROM prices
+WHERE base = sqlc.arg(base) AND quote = sqlc.arg(quote) AND price_time <= sqlc.arg(at_time)
+ORDER BY price_time DESC
+LIMIT 1;
@@ -0,0 +1,42 @@
+-- name: CountTraders :one
+SELECT COUNT(*) FROM traders;
+
+-- name: ListTraderDrifts :many
+SELECT
+  t.id,
+  t.holder,
+  t.symbol,
+  t.rest,
+  COALESCE(SUM(d.number), 0)::bigint AS details_sum,
+  (t.rest - COALESCE(SUM(d.number), 0))::bigint AS drift
+FROM traders t
+LEFT JOIN details d ON d.trader_id = t.id
+GROUP BY t.id
+HAVING t.rest <> COALESCE(SUM(d.number), 0)
+ORDER BY t.id;
+
+-- name: ListUnpairedRecords :many
+SELECT
+  r.id,
+  r.from_trader_id,
+  r.to_trader_id,
+  r.number,
+  COUNT(d.id) AS detail_count
+FROM records r
+LEFT JOIN details d ON d.record_id = r.id
+GROUP BY r.id
+HAVING COUNT(d.id) <> CASE WHEN r.fee > 0 THEN 4 ELSE 2 END
+  OR COUNT(*) FILTER (WHERE d.trader_id = r.from_trader_id AND d.number = -r.number) < 1
+  OR COUNT(*) FILTER (WHERE d.trader_id = r.to_trader_id AND d.number = r.number) < 1
+  OR (r.fee > 0 AND COUNT(*) FILTER (WHERE d.trader_id = r.from_trader_id AND d.number = -r.fee) < 1)
+ORDER BY r.id;
+
+-- name: ListUnbalancedEntries :many
+SELECT
+  entry_id,
+  symbol,
+  SUM(number)::bigint AS total
+FROM postings
+GROUP BY entry_id, symbol
+HAVING SUM(number) <> 0
+ORDER BY entry_id, symbol;
@@ -2,20 +2,39 @@
 INSERT INTO records (
   from_trader_id,
   to_trader_id,
-  number
+  number,
+  membername,
+  idempotency_key,
+  reversal_of,
+  fee,
+  fee_rule_id
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, sqlc.narg(membername), sqlc.narg(idempotency_key), sqlc.narg(reversal_of), sqlc.arg(fee), sqlc.narg(fee_rule_id)
 ) RETURNING *;
 
 -- name: GetRecord :one
 SELECT * FROM records
 WHERE id = $1 LIMIT 1;
 
+-- name: GetRecordForUpdate :one
+SELECT * FROM records
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
 -- name: ListRecords :many
 SELECT * FROM records
-WHERE 
-    from_trader_id = $1 OR
-    to_trader_id = $2
+WHERE
+    (from_trader_id = sqlc.arg(trader_id) OR to_trader_id = sqlc.arg(trader_id)) AND
+    id > sqlc.arg(after_id)
 ORDER BY id
-LIMIT $3
-OFFSET $4;
+LIMIT sqlc.arg('limit');
+
+-- name: GetRecordByIdempotencyKey :one
+SELECT * FROM records
+WHERE membername = $1 AND idempotency_key = $2
+LIMIT 1;
+
+-- name: GetReversedNumber :one
+SELECT COALESCE(SUM(number), 0)::bigint AS reversed
+FROM records
+WHERE reversal_of = sqlc.arg(record_id)::bigint;
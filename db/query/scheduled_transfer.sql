@@ -0,0 +1,78 @@
+-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers (
+  holder,
+  from_trader_id,
+  to_trader_id,
+  number,
+  cronspec,
+  on_insufficient_funds,
+  next_run_time
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetScheduledTransfer :one
+SELECT * FROM scheduled_transfers
+WHERE id = $1 LIMIT 1;
+
+-- name: GetScheduledTransferForUpdate :one
+SELECT * FROM scheduled_transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListScheduledTransfers :many
+SELECT * FROM scheduled_transfers
+WHERE holder = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: ListDueScheduledTransfers :many
+SELECT * FROM scheduled_transfers
+WHERE active AND next_run_time <= now()
+ORDER BY next_run_time
+LIMIT $1;
+
+-- name: UpdateScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+  number = COALESCE(sqlc.narg(number), number),
+  cronspec = COALESCE(sqlc.narg(cronspec), cronspec),
+  on_insufficient_funds = COALESCE(sqlc.narg(on_insufficient_funds), on_insufficient_funds),
+  active = COALESCE(sqlc.narg(active), active),
+  next_run_time = COALESCE(sqlc.narg(next_run_time), next_run_time)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: ClaimScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+  next_run_time = sqlc.arg(next_run_time),
+  last_run_time = sqlc.arg(scheduled_time)::timestamptz
+WHERE id = sqlc.arg(id) AND active AND next_run_time = sqlc.arg(scheduled_time)::timestamptz
+RETURNING *;
+
+-- name: DeleteScheduledTransfer :exec
+DELETE FROM scheduled_transfers
+WHERE id = $1;
+
+-- name: CreateScheduledTransferRun :one
+INSERT INTO scheduled_transfer_runs (
+  schedule_id,
+  scheduled_time,
+  status,
+  record_id,
+  error,
+  attempts
+) VALUES (
+  sqlc.arg(schedule_id), sqlc.arg(scheduled_time), sqlc.arg(status), sqlc.narg(record_id), sqlc.arg(error), sqlc.arg(attempts)
+)
+ON CONFLICT (schedule_id, scheduled_time) DO NOTHING
+RETURNING *;
+
+-- name: ListScheduledTransferRuns :many
+SELECT * FROM scheduled_transfer_runs
+WHERE schedule_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
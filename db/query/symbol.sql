@@ -0,0 +1,27 @@
+-- name: CreateSymbol :one
+INSERT INTO symbols (
+  code,
+  display_name,
+  decimals,
+  min_transfer,
+  enabled
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetSymbol :one
+SELECT * FROM symbols
+WHERE code = $1 LIMIT 1;
+
+-- name: ListSymbols :many
+SELECT * FROM symbols
+ORDER BY code;
+
+-- name: UpdateSymbol :one
+UPDATE symbols
+SET
+  display_name = COALESCE(sqlc.narg(display_name), display_name),
+  min_transfer = COALESCE(sqlc.narg(min_transfer), min_transfer),
+  enabled = COALESCE(sqlc.narg(enabled), enabled)
+WHERE code = sqlc.arg(code)
+RETURNING *;
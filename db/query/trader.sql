@@ -18,10 +18,9 @@ FOR NO KEY UPDATE;
 
 -- name: ListTraders :many
 SELECT * FROM traders
-WHERE holder = $1
+WHERE holder = sqlc.arg(holder) AND id > sqlc.arg(after_id)
 ORDER BY id
-LIMIT $2
-OFFSET $3;
+LIMIT sqlc.arg('limit');
 
 -- name: UpdateTrader :one
 UPDATE traders
@@ -35,6 +34,40 @@ SET rest = rest + sqlc.arg(number)
 WHERE id = sqlc.arg(id)
 RETURNING *;
 
--- name: DeleteTrader :exec
-DELETE FROM traders
-WHERE id = $1;
+-- name: GetTraderByHolderSymbol :one
+SELECT * FROM traders
+WHERE holder = $1 AND symbol = $2 LIMIT 1;
+
+-- name: UpdateTraderOverdraftLimit :one
+UPDATE traders
+SET overdraft_limit = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: AddTraderHeld :one
+UPDATE traders
+SET held = held + sqlc.arg(number)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: UpdateTraderStatus :one
+UPDATE traders
+SET status = sqlc.arg(status), status_reason = sqlc.arg(status_reason)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CreateTraderStatusChange :one
+INSERT INTO trader_status_changes (
+  trader_id,
+  from_status,
+  to_status,
+  reason,
+  membername
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListTraderStatusChanges :many
+SELECT * FROM trader_status_changes
+WHERE trader_id = $1
+ORDER BY id;
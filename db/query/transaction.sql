@@ -0,0 +1,97 @@
+-- name: ListTransactions :many
+-- the running balance is worked out for the rows of the page only, replayed from
+-- the latest snapshot up to the last detail of the record in (created_time, id) order
+WITH moves AS (
+  -- a sender has the fee leg of a record too, the balance is the one after both
+  SELECT d.trader_id, d.record_id, MAX(d.id)::bigint AS detail_id
+  FROM details d
+  JOIN traders t ON t.id = d.trader_id
+  WHERE
+    d.record_id IS NOT NULL AND
+    (sqlc.narg(holder)::varchar IS NULL OR t.holder = sqlc.narg(holder)) AND
+    (sqlc.narg(trader_id)::bigint IS NULL OR d.trader_id = sqlc.narg(trader_id)) AND
+    (
+      sqlc.arg(after_id)::bigint = 0 OR
+      sqlc.arg(sort_by)::varchar = 'number' OR
+      (sqlc.arg(descending)::bool AND d.record_id < sqlc.arg(after_id)::bigint) OR
+      (NOT sqlc.arg(descending)::bool AND d.record_id > sqlc.arg(after_id)::bigint)
+    )
+  GROUP BY d.trader_id, d.record_id
+), transactions AS (
+  SELECT
+    r.id AS record_id,
+    m.trader_id,
+    m.detail_id,
+    t.symbol,
+    (CASE WHEN r.from_trader_id = m.trader_id THEN 'out' ELSE 'in' END)::varchar AS direction,
+    (CASE WHEN r.from_trader_id = m.trader_id THEN r.to_trader_id ELSE r.from_trader_id END)::bigint AS counterparty_id,
+    r.number,
+    (CASE WHEN r.from_trader_id = m.trader_id THEN r.fee ELSE 0 END)::bigint AS fee,
+    r.reversal_of,
+    r.created_time
+  FROM moves m
+  JOIN records r ON r.id = m.record_id
+  JOIN traders t ON t.id = m.trader_id
+), page AS (
+  SELECT * FROM transactions
+  WHERE
+    (sqlc.narg(counterparty_id)::bigint IS NULL OR counterparty_id = sqlc.narg(counterparty_id)) AND
+    (sqlc.narg(direction)::varchar IS NULL OR direction = sqlc.narg(direction)) AND
+    (sqlc.narg(min_number)::bigint IS NULL OR number >= sqlc.narg(min_number)) AND
+    (sqlc.narg(max_number)::bigint IS NULL OR number <= sqlc.narg(max_number)) AND
+    (sqlc.narg(symbol)::varchar IS NULL OR symbol = sqlc.narg(symbol)) AND
+    (sqlc.narg(created_from)::timestamptz IS NULL OR created_time >= sqlc.narg(created_from)) AND
+    (sqlc.narg(created_to)::timestamptz IS NULL OR created_time < sqlc.narg(created_to)) AND
+    (
+      sqlc.arg(after_id)::bigint = 0 OR
+      CASE
+        WHEN sqlc.arg(sort_by)::varchar = 'number' AND sqlc.arg(descending)::bool
+          THEN (number, record_id) < (sqlc.arg(after_number)::bigint, sqlc.arg(after_id)::bigint)
+        WHEN sqlc.arg(sort_by)::varchar = 'number'
+          THEN (number, record_id) > (sqlc.arg(after_number)::bigint, sqlc.arg(after_id)::bigint)
+        WHEN sqlc.arg(descending)::bool
+          THEN record_id < sqlc.arg(after_id)::bigint
+        ELSE record_id > sqlc.arg(after_id)::bigint
+      END
+    )
+  ORDER BY
+    CASE WHEN sqlc.arg(sort_by)::varchar = 'number' AND NOT sqlc.arg(descending)::bool THEN number END ASC,
+    CASE WHEN sqlc.arg(sort_by)::varchar = 'number' AND sqlc.arg(descending)::bool THEN number END DESC,
+    CASE WHEN NOT sqlc.arg(descending)::bool THEN record_id END ASC,
+    CASE WHEN sqlc.arg(descending)::bool THEN record_id END DESC
+  LIMIT sqlc.arg('limit')
+)
+SELECT
+  p.record_id,
+  p.trader_id,
+  p.symbol,
+  p.direction,
+  p.counterparty_id,
+  p.number,
+  p.fee,
+  (
+    COALESCE(s.balance, 0) + COALESCE((
+      SELECT SUM(d.number)
+      FROM details d
+      WHERE
+        d.trader_id = p.trader_id AND
+        d.created_time >= COALESCE(s.snapshot_time, '-infinity'::timestamptz) AND
+        (d.created_time, d.id) <= (l.created_time, l.id)
+    ), 0)
+  )::bigint AS balance,
+  p.reversal_of,
+  p.created_time
+FROM page p
+JOIN details l ON l.id = p.detail_id
+LEFT JOIN LATERAL (
+  SELECT bs.snapshot_time, bs.balance
+  FROM balance_snapshots bs
+  WHERE bs.trader_id = p.trader_id AND bs.snapshot_time <= l.created_time
+  ORDER BY bs.snapshot_time DESC
+  LIMIT 1
+) s ON true
+ORDER BY
+  CASE WHEN sqlc.arg(sort_by)::varchar = 'number' AND NOT sqlc.arg(descending)::bool THEN p.number END ASC,
+  CASE WHEN sqlc.arg(sort_by)::varchar = 'number' AND sqlc.arg(descending)::bool THEN p.number END DESC,
+  CASE WHEN NOT sqlc.arg(descending)::bool THEN p.record_id END ASC,
+  CASE WHEN sqlc.arg(descending)::bool THEN p.record_id END DESC;
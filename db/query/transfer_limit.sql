@@ -0,0 +1,55 @@
+-- name: UpsertTransferLimit :one
+INSERT INTO transfer_limits (
+  symbol,
+  role,
+  kyc_level,
+  daily_limit,
+  monthly_limit
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (symbol, role, kyc_level) DO UPDATE
+SET
+  daily_limit = EXCLUDED.daily_limit,
+  monthly_limit = EXCLUDED.monthly_limit
+RETURNING *;
+
+-- name: GetApplicableTransferLimit :one
+SELECT * FROM transfer_limits
+WHERE symbol = sqlc.arg(symbol)
+  AND role IN (sqlc.arg(role)::varchar, '')
+  AND kyc_level IN (sqlc.arg(kyc_level)::varchar, '')
+ORDER BY role DESC, kyc_level DESC
+LIMIT 1;
+
+-- name: ListTransferLimits :many
+SELECT * FROM transfer_limits
+ORDER BY symbol, role, kyc_level
+LIMIT $1
+OFFSET $2;
+
+-- name: DeleteTransferLimit :exec
+DELETE FROM transfer_limits
+WHERE id = $1;
+
+-- name: GetOutgoingTotals :one
+WITH outgoing AS (
+  SELECT from_trader_id AS trader_id, number, created_time
+  FROM records
+  WHERE reversal_of IS NULL
+  UNION ALL
+  SELECT buyer_trader_id, number, created_time
+  FROM escrows
+  UNION ALL
+  SELECT trader_id, number, created_time
+  FROM withdrawals
+  WHERE status <> 'rejected'
+)
+SELECT
+  COALESCE(SUM(outgoing.number) FILTER (WHERE outgoing.created_time >= sqlc.arg(daily_since)::timestamptz), 0)::bigint AS daily,
+  COALESCE(SUM(outgoing.number), 0)::bigint AS monthly
+FROM outgoing
+JOIN traders t ON t.id = outgoing.trader_id
+WHERE t.holder = sqlc.arg(holder)
+  AND t.symbol = sqlc.arg(symbol)
+  AND outgoing.created_time >= sqlc.arg(monthly_since)::timestamptz;
@@ -0,0 +1,61 @@
+-- name: CreateTransferReview :one
+INSERT INTO transfer_reviews (
+  from_trader_id,
+  to_trader_id,
+  number,
+  membername,
+  idempotency_key,
+  reasons,
+  hold_id,
+  escrow_expires_time
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetTransferReview :one
+SELECT * FROM transfer_reviews
+WHERE id = $1 LIMIT 1;
+
+-- name: GetTransferReviewForUpdate :one
+SELECT * FROM transfer_reviews
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetTransferReviewByIdempotencyKey :one
+SELECT * FROM transfer_reviews
+WHERE membername = $1 AND idempotency_key = $2 LIMIT 1;
+
+-- name: ListTransferReviews :many
+SELECT * FROM transfer_reviews
+WHERE status = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: SettleTransferReview :one
+UPDATE transfer_reviews
+SET
+  status = sqlc.arg(status),
+  reviewer = sqlc.arg(reviewer)::varchar,
+  reviewed_time = now(),
+  record_id = sqlc.narg(record_id),
+  escrow_id = sqlc.narg(escrow_id)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CountTransfersSince :one
+SELECT COUNT(*) FROM records
+WHERE from_trader_id = $1
+  AND reversal_of IS NULL
+  AND created_time >= sqlc.arg(since);
+
+-- name: HasPaid :one
+SELECT EXISTS (
+  SELECT 1 FROM records
+  WHERE from_trader_id = $1 AND to_trader_id = $2 AND reversal_of IS NULL
+);
+
+-- name: CountPendingTransferReviewsByTrader :one
+SELECT COUNT(*) FROM transfer_reviews
+WHERE (from_trader_id = $1 OR to_trader_id = $1)
+  AND status = 'pending';
@@ -0,0 +1,41 @@
+-- name: CreateWithdrawal :one
+INSERT INTO withdrawals (
+  trader_id,
+  number,
+  destination,
+  hold_id
+) VALUES (
+  $1, $2, $3, sqlc.arg(hold_id)::bigint
+) RETURNING *;
+
+-- name: GetWithdrawal :one
+SELECT * FROM withdrawals
+WHERE id = $1 LIMIT 1;
+
+-- name: GetWithdrawalForUpdate :one
+SELECT * FROM withdrawals
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetWithdrawalByHoldForUpdate :one
+SELECT * FROM withdrawals
+WHERE hold_id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListWithdrawals :many
+SELECT * FROM withdrawals
+WHERE status = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3;
+
+-- name: ReviewWithdrawal :one
+UPDATE withdrawals
+SET
+  status = sqlc.arg(status),
+  reviewer = sqlc.arg(reviewer)::varchar,
+  reviewed_time = now(),
+  entry_id = COALESCE(sqlc.narg(entry_id), entry_id),
+  refund_entry_id = sqlc.narg(refund_entry_id)
+WHERE id = sqlc.arg(id)
+RETURNING *;
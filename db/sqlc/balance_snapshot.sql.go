@@ -0,0 +1,105 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: balance_snapshot.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const createBalanceSnapshots = `-- name: CreateBalanceSnapshots :many
+INSERT INTO balance_snapshots (trader_id, snapshot_time, balance)
+SELECT
+  t.id,
+  $1::timestamptz,
+  COALESCE(s.balance, 0) + COALESCE((
+    SELECT SUM(d.number)
+    FROM details d
+    WHERE
+      d.trader_id = t.id AND
+      d.created_time >= COALESCE(s.snapshot_time, '-infinity'::timestamptz) AND
+      d.created_time < $1::timestamptz
+  ), 0)
+FROM (
+  SELECT tr.id FROM traders tr
+  WHERE tr.id > $2::bigint AND tr.created_time < $1::timestamptz
+  ORDER BY tr.id
+  LIMIT $3::int
+) t
+LEFT JOIN LATERAL (
+  SELECT bs.snapshot_time, bs.balance
+  FROM balance_snapshots bs
+  WHERE bs.trader_id = t.id AND bs.snapshot_time < $1::timestamptz
+  ORDER BY bs.snapshot_time DESC
+  LIMIT 1
+) s ON true
+ON CONFLICT (trader_id, snapshot_time) DO UPDATE SET balance = EXCLUDED.balance
+RETURNING trader_id, snapshot_time, balance, created_time
+`
+
+type CreateBalanceSnapshotsParams struct {
+	SnapshotTime time.Time `json:"snapshot_time"`
+	AfterID      int64     `json:"after_id"`
+	Limit        int32     `json:"limit"`
+}
+
+func (q *Queries) CreateBalanceSnapshots(ctx context.Context, arg CreateBalanceSnapshotsParams) ([]BalanceSnapshot, error) {
+	rows, err := q.db.Query(ctx, createBalanceSnapshots, arg.SnapshotTime, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []BalanceSnapshot{}
+	for rows.Next() {
+		var i BalanceSnapshot
+		if err := rows.Scan(
+			&i.TraderID,
+			&i.SnapshotTime,
+			&i.Balance,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBalanceAt = `-- name: GetBalanceAt :one
+WITH snapshot AS (
+  SELECT snapshot_time, balance
+  FROM balance_snapshots
+  WHERE trader_id = $1 AND snapshot_time <= $2::timestamptz
+  ORDER BY snapshot_time DESC
+  LIMIT 1
+)
+SELECT (
+  COALESCE((SELECT balance FROM snapshot), 0) + COALESCE((
+    SELECT SUM(d.number)
+    FROM details d
+    WHERE
+      d.trader_id = $1 AND
+      d.created_time >= COALESCE((SELECT snapshot_time FROM snapshot), '-infinity'::timestamptz) AND
+      d.created_time < $2::timestamptz
+  ), 0)
+)::bigint AS balance
+`
+
+type GetBalanceAtParams struct {
+	TraderID int64     `json:"trader_id"`
+	AtTime   time.Time `json:"at_time"`
+}
+
+// the balance of a trader right before at_time, replayed from its latest snapshot
+func (q *Queries) GetBalanceAt(ctx context.Context, arg GetBalanceAtParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getBalanceAt, arg.TraderID, arg.AtTime)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceSnapshots(t *testing.T) {
+	trader := createRandomTrader(t)
+	detail1 := createRandomDetail(t, trader)
+	detail2 := createRandomDetail(t, trader)
+
+	snapshotTime := time.Now()
+	detail3 := createRandomDetail(t, trader)
+
+	arg := CreateBalanceSnapshotsParams{
+		SnapshotTime: snapshotTime,
+		AfterID:      trader.ID - 1,
+		Limit:        1,
+	}
+
+	snapshots, err := testStore.CreateBalanceSnapshots(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, trader.ID, snapshots[0].TraderID)
+	require.Equal(t, detail1.Number+detail2.Number, snapshots[0].Balance)
+
+	// taking the same snapshot again leaves it as it was
+	snapshots, err = testStore.CreateBalanceSnapshots(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, detail1.Number+detail2.Number, snapshots[0].Balance)
+
+	balance, err := testStore.GetBalanceAt(context.Background(), GetBalanceAtParams{
+		TraderID: trader.ID,
+		AtTime:   snapshotTime,
+	})
+	require.NoError(t, err)
+	require.Equal(t, detail1.Number+detail2.Number, balance)
+
+	balance, err = testStore.GetBalanceAt(context.Background(), GetBalanceAtParams{
+		TraderID: trader.ID,
+		AtTime:   time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, detail1.Number+detail2.Number+detail3.Number, balance)
+
+	balance, err = testStore.GetBalanceAt(context.Background(), GetBalanceAtParams{
+		TraderID: trader.ID,
+		AtTime:   trader.CreatedTime.Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	require.Zero(t, balance)
+}
@@ -0,0 +1,107 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: deposit.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createDeposit = `-- name: CreateDeposit :one
+INSERT INTO deposits (
+  trader_id,
+  number,
+  reference,
+  entry_id
+) VALUES (
+  $1, $2, $3, $4
+) RETURNING id, trader_id, number, reference, entry_id, created_time
+`
+
+type CreateDepositParams struct {
+	TraderID  int64  `json:"trader_id"`
+	Number    int64  `json:"number"`
+	Reference string `json:"reference"`
+	EntryID   int64  `json:"entry_id"`
+}
+
+func (q *Queries) CreateDeposit(ctx context.Context, arg CreateDepositParams) (Deposit, error) {
+	row := q.db.QueryRow(ctx, createDeposit,
+		arg.TraderID,
+		arg.Number,
+		arg.Reference,
+		arg.EntryID,
+	)
+	var i Deposit
+	err := row.Scan(
+		&i.ID,
+		&i.TraderID,
+		&i.Number,
+		&i.Reference,
+		&i.EntryID,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getDeposit = `-- name: GetDeposit :one
+SELECT id, trader_id, number, reference, entry_id, created_time FROM deposits
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetDeposit(ctx context.Context, id int64) (Deposit, error) {
+	row := q.db.QueryRow(ctx, getDeposit, id)
+	var i Deposit
+	err := row.Scan(
+		&i.ID,
+		&i.TraderID,
+		&i.Number,
+		&i.Reference,
+		&i.EntryID,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const listDeposits = `-- name: ListDeposits :many
+SELECT id, trader_id, number, reference, entry_id, created_time FROM deposits
+WHERE trader_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3
+`
+
+type ListDepositsParams struct {
+	TraderID int64 `json:"trader_id"`
+	Limit    int32 `json:"limit"`
+	Offset   int32 `json:"offset"`
+}
+
+func (q *Queries) ListDeposits(ctx context.Context, arg ListDepositsParams) ([]Deposit, error) {
+	rows, err := q.db.Query(ctx, listDeposits, arg.TraderID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Deposit{}
+	for rows.Next() {
+		var i Deposit
+		if err := rows.Scan(
+			&i.ID,
+			&i.TraderID,
+			&i.Number,
+			&i.Reference,
+			&i.EntryID,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
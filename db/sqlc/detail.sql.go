@@ -7,36 +7,42 @@ package db
 
 import (
 	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createDetail = `-- name: CreateDetail :one
 INSERT INTO details (
   trader_id,
-  number
+  number,
+  record_id
 ) VALUES (
-  $1, $2
-) RETURNING id, trader_id, number, created_time
+  $1, $2, $3
+) RETURNING id, trader_id, number, created_time, record_id
 `
 
 type CreateDetailParams struct {
-	TraderID int64 `json:"trader_id"`
-	Number   int64 `json:"number"`
+	TraderID int64       `json:"trader_id"`
+	Number   int64       `json:"number"`
+	RecordID pgtype.Int8 `json:"record_id"`
 }
 
 func (q *Queries) CreateDetail(ctx context.Context, arg CreateDetailParams) (Detail, error) {
-	row := q.db.QueryRow(ctx, createDetail, arg.TraderID, arg.Number)
+	row := q.db.QueryRow(ctx, createDetail, arg.TraderID, arg.Number, arg.RecordID)
 	var i Detail
 	err := row.Scan(
 		&i.ID,
 		&i.TraderID,
 		&i.Number,
 		&i.CreatedTime,
+		&i.RecordID,
 	)
 	return i, err
 }
 
 const getDetail = `-- name: GetDetail :one
-SELECT id, trader_id, number, created_time FROM details
+SELECT id, trader_id, number, created_time, record_id FROM details
 WHERE id = $1 LIMIT 1
 `
 
@@ -48,26 +54,109 @@ func (q *Queries) GetDetail(ctx context.Context, id int64) (Detail, error) {
 		&i.TraderID,
 		&i.Number,
 		&i.CreatedTime,
+		&i.RecordID,
 	)
 	return i, err
 }
 
 const listDetails = `-- name: ListDetails :many
-SELECT id, trader_id, number, created_time FROM details
-WHERE trader_id = $1
+SELECT id, trader_id, number, created_time, record_id FROM details
+WHERE trader_id = $1 AND id > $2
 ORDER BY id
-LIMIT $2
-OFFSET $3
+LIMIT $3
 `
 
 type ListDetailsParams struct {
 	TraderID int64 `json:"trader_id"`
+	AfterID  int64 `json:"after_id"`
 	Limit    int32 `json:"limit"`
-	Offset   int32 `json:"offset"`
 }
 
 func (q *Queries) ListDetails(ctx context.Context, arg ListDetailsParams) ([]Detail, error) {
-	rows, err := q.db.Query(ctx, listDetails, arg.TraderID, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listDetails, arg.TraderID, arg.AfterID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Detail{}
+	for rows.Next() {
+		var i Detail
+		if err := rows.Scan(
+			&i.ID,
+			&i.TraderID,
+			&i.Number,
+			&i.CreatedTime,
+			&i.RecordID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDetailsByRecord = `-- name: ListDetailsByRecord :many
+SELECT id, trader_id, number, created_time, record_id FROM details
+WHERE record_id = $1::bigint
+ORDER BY id
+`
+
+func (q *Queries) ListDetailsByRecord(ctx context.Context, recordID int64) ([]Detail, error) {
+	rows, err := q.db.Query(ctx, listDetailsByRecord, recordID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Detail{}
+	for rows.Next() {
+		var i Detail
+		if err := rows.Scan(
+			&i.ID,
+			&i.TraderID,
+			&i.Number,
+			&i.CreatedTime,
+			&i.RecordID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listDetailsInPeriod = `-- name: ListDetailsInPeriod :many
+SELECT id, trader_id, number, created_time, record_id FROM details
+WHERE
+    trader_id = $1 AND
+    created_time >= $2::timestamptz AND
+    created_time < $3::timestamptz AND
+    id > $4
+ORDER BY id
+LIMIT $5
+`
+
+type ListDetailsInPeriodParams struct {
+	TraderID int64     `json:"trader_id"`
+	FromTime time.Time `json:"from_time"`
+	ToTime   time.Time `json:"to_time"`
+	AfterID  int64     `json:"after_id"`
+	Limit    int32     `json:"limit"`
+}
+
+func (q *Queries) ListDetailsInPeriod(ctx context.Context, arg ListDetailsInPeriodParams) ([]Detail, error) {
+	rows, err := q.db.Query(ctx, listDetailsInPeriod,
+		arg.TraderID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.AfterID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +169,7 @@ func (q *Queries) ListDetails(ctx context.Context, arg ListDetailsParams) ([]Det
 			&i.TraderID,
 			&i.Number,
 			&i.CreatedTime,
+			&i.RecordID,
 		); err != nil {
 			return nil, err
 		}
@@ -54,16 +54,54 @@ func TestListDetails(t *testing.T) {
 
 	arg := ListDetailsParams{
 		TraderID: trader.ID,
-		Limit:     2,
-		Offset:    2,
+		Limit:    2,
 	}
 
 	details, err := testStore.ListDetails(context.Background(), arg)
 	require.NoError(t, err)
 	require.Len(t, details, 2)
 
+	arg.AfterID = details[1].ID
+	details, err = testStore.ListDetails(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, details, 2)
+	require.Greater(t, details[0].ID, arg.AfterID)
+
 	for _, detail := range details {
 		require.NotEmpty(t, detail)
 		require.Equal(t, arg.TraderID, detail.TraderID)
 	}
 }
+
+func TestDetailsInPeriod(t *testing.T) {
+	trader := createRandomTrader(t)
+	before := createRandomDetail(t, trader)
+
+	from := time.Now()
+	var sum int64
+	for i := 0; i < 3; i++ {
+		sum += createRandomDetail(t, trader).Number
+	}
+	to := time.Now()
+
+	opening, err := testStore.GetBalanceAt(context.Background(), GetBalanceAtParams{
+		TraderID: trader.ID,
+		AtTime:   from,
+	})
+	require.NoError(t, err)
+	require.Equal(t, before.Number, opening)
+
+	details, err := testStore.ListDetailsInPeriod(context.Background(), ListDetailsInPeriodParams{
+		TraderID: trader.ID,
+		FromTime: from,
+		ToTime:   to,
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, details, 3)
+
+	for _, detail := range details {
+		sum -= detail.Number
+	}
+	require.Zero(t, sum)
+}
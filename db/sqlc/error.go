@@ -14,6 +14,8 @@ const (
 
 var ErrRecordNotFound = pgx.ErrNoRows
 
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
 var ErrUniqueViolation = &pgconn.PgError{
 	Code: UniqueViolation,
 }
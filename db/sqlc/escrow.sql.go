@@ -0,0 +1,292 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: escrow.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countUnsettledEscrowsByTrader = `-- name: CountUnsettledEscrowsByTrader :one
+SELECT COUNT(*) FROM escrows
+WHERE (buyer_trader_id = $1 OR seller_trader_id = $1)
+  AND status IN ('open', 'disputed')
+`
+
+func (q *Queries) CountUnsettledEscrowsByTrader(ctx context.Context, buyerTraderID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnsettledEscrowsByTrader, buyerTraderID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createEscrow = `-- name: CreateEscrow :one
+INSERT INTO escrows (
+  buyer,
+  seller,
+  buyer_trader_id,
+  seller_trader_id,
+  escrow_trader_id,
+  number,
+  fund_entry_id,
+  expires_time
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING id, buyer, seller, buyer_trader_id, seller_trader_id, escrow_trader_id, number, status, buyer_confirmed, seller_confirmed, fund_entry_id, settle_entry_id, resolver, expires_time, settled_time, created_time
+`
+
+type CreateEscrowParams struct {
+	Buyer          string    `json:"buyer"`
+	Seller         string    `json:"seller"`
+	BuyerTraderID  int64     `json:"buyer_trader_id"`
+	SellerTraderID int64     `json:"seller_trader_id"`
+	EscrowTraderID int64     `json:"escrow_trader_id"`
+	Number         int64     `json:"number"`
+	FundEntryID    int64     `json:"fund_entry_id"`
+	ExpiresTime    time.Time `json:"expires_time"`
+}
+
+func (q *Queries) CreateEscrow(ctx context.Context, arg CreateEscrowParams) (Escrow, error) {
+	row := q.db.QueryRow(ctx, createEscrow,
+		arg.Buyer,
+		arg.Seller,
+		arg.BuyerTraderID,
+		arg.SellerTraderID,
+		arg.EscrowTraderID,
+		arg.Number,
+		arg.FundEntryID,
+		arg.ExpiresTime,
+	)
+	var i Escrow
+	err := row.Scan(
+		&i.ID,
+		&i.Buyer,
+		&i.Seller,
+		&i.BuyerTraderID,
+		&i.SellerTraderID,
+		&i.EscrowTraderID,
+		&i.Number,
+		&i.Status,
+		&i.BuyerConfirmed,
+		&i.SellerConfirmed,
+		&i.FundEntryID,
+		&i.SettleEntryID,
+		&i.Resolver,
+		&i.ExpiresTime,
+		&i.SettledTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getEscrow = `-- name: GetEscrow :one
+SELECT id, buyer, seller, buyer_trader_id, seller_trader_id, escrow_trader_id, number, status, buyer_confirmed, seller_confirmed, fund_entry_id, settle_entry_id, resolver, expires_time, settled_time, created_time FROM escrows
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetEscrow(ctx context.Context, id int64) (Escrow, error) {
+	row := q.db.QueryRow(ctx, getEscrow, id)
+	var i Escrow
+	err := row.Scan(
+		&i.ID,
+		&i.Buyer,
+		&i.Seller,
+		&i.BuyerTraderID,
+		&i.SellerTraderID,
+		&i.EscrowTraderID,
+		&i.Number,
+		&i.Status,
+		&i.BuyerConfirmed,
+		&i.SellerConfirmed,
+		&i.FundEntryID,
+		&i.SettleEntryID,
+		&i.Resolver,
+		&i.ExpiresTime,
+		&i.SettledTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getEscrowForUpdate = `-- name: GetEscrowForUpdate :one
+SELECT id, buyer, seller, buyer_trader_id, seller_trader_id, escrow_trader_id, number, status, buyer_confirmed, seller_confirmed, fund_entry_id, settle_entry_id, resolver, expires_time, settled_time, created_time FROM escrows
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetEscrowForUpdate(ctx context.Context, id int64) (Escrow, error) {
+	row := q.db.QueryRow(ctx, getEscrowForUpdate, id)
+	var i Escrow
+	err := row.Scan(
+		&i.ID,
+		&i.Buyer,
+		&i.Seller,
+		&i.BuyerTraderID,
+		&i.SellerTraderID,
+		&i.EscrowTraderID,
+		&i.Number,
+		&i.Status,
+		&i.BuyerConfirmed,
+		&i.SellerConfirmed,
+		&i.FundEntryID,
+		&i.SettleEntryID,
+		&i.Resolver,
+		&i.ExpiresTime,
+		&i.SettledTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const listEscrows = `-- name: ListEscrows :many
+SELECT id, buyer, seller, buyer_trader_id, seller_trader_id, escrow_trader_id, number, status, buyer_confirmed, seller_confirmed, fund_entry_id, settle_entry_id, resolver, expires_time, settled_time, created_time FROM escrows
+WHERE buyer = $3 OR seller = $3
+ORDER BY id
+LIMIT $1
+OFFSET $2
+`
+
+type ListEscrowsParams struct {
+	Limit      int32  `json:"limit"`
+	Offset     int32  `json:"offset"`
+	Membername string `json:"membername"`
+}
+
+func (q *Queries) ListEscrows(ctx context.Context, arg ListEscrowsParams) ([]Escrow, error) {
+	rows, err := q.db.Query(ctx, listEscrows, arg.Limit, arg.Offset, arg.Membername)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Escrow{}
+	for rows.Next() {
+		var i Escrow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Buyer,
+			&i.Seller,
+			&i.BuyerTraderID,
+			&i.SellerTraderID,
+			&i.EscrowTraderID,
+			&i.Number,
+			&i.Status,
+			&i.BuyerConfirmed,
+			&i.SellerConfirmed,
+			&i.FundEntryID,
+			&i.SettleEntryID,
+			&i.Resolver,
+			&i.ExpiresTime,
+			&i.SettledTime,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listExpiredEscrows = `-- name: ListExpiredEscrows :many
+SELECT id, buyer, seller, buyer_trader_id, seller_trader_id, escrow_trader_id, number, status, buyer_confirmed, seller_confirmed, fund_entry_id, settle_entry_id, resolver, expires_time, settled_time, created_time FROM escrows
+WHERE status = 'open' AND expires_time <= now()
+ORDER BY expires_time
+LIMIT $1
+`
+
+func (q *Queries) ListExpiredEscrows(ctx context.Context, limit int32) ([]Escrow, error) {
+	rows, err := q.db.Query(ctx, listExpiredEscrows, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Escrow{}
+	for rows.Next() {
+		var i Escrow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Buyer,
+			&i.Seller,
+			&i.BuyerTraderID,
+			&i.SellerTraderID,
+			&i.EscrowTraderID,
+			&i.Number,
+			&i.Status,
+			&i.BuyerConfirmed,
+			&i.SellerConfirmed,
+			&i.FundEntryID,
+			&i.SettleEntryID,
+			&i.Resolver,
+			&i.ExpiresTime,
+			&i.SettledTime,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateEscrow = `-- name: UpdateEscrow :one
+UPDATE escrows
+SET
+  status = $1,
+  buyer_confirmed = $2,
+  seller_confirmed = $3,
+  settle_entry_id = $4,
+  resolver = $5,
+  settled_time = CASE WHEN $4::bigint IS NULL THEN NULL ELSE now() END
+WHERE id = $6
+RETURNING id, buyer, seller, buyer_trader_id, seller_trader_id, escrow_trader_id, number, status, buyer_confirmed, seller_confirmed, fund_entry_id, settle_entry_id, resolver, expires_time, settled_time, created_time
+`
+
+type UpdateEscrowParams struct {
+	Status          string      `json:"status"`
+	BuyerConfirmed  bool        `json:"buyer_confirmed"`
+	SellerConfirmed bool        `json:"seller_confirmed"`
+	SettleEntryID   pgtype.Int8 `json:"settle_entry_id"`
+	Resolver        pgtype.Text `json:"resolver"`
+	ID              int64       `json:"id"`
+}
+
+func (q *Queries) UpdateEscrow(ctx context.Context, arg UpdateEscrowParams) (Escrow, error) {
+	row := q.db.QueryRow(ctx, updateEscrow,
+		arg.Status,
+		arg.BuyerConfirmed,
+		arg.SellerConfirmed,
+		arg.SettleEntryID,
+		arg.Resolver,
+		arg.ID,
+	)
+	var i Escrow
+	err := row.Scan(
+		&i.ID,
+		&i.Buyer,
+		&i.Seller,
+		&i.BuyerTraderID,
+		&i.SellerTraderID,
+		&i.EscrowTraderID,
+		&i.Number,
+		&i.Status,
+		&i.BuyerConfirmed,
+		&i.SellerConfirmed,
+		&i.FundEntryID,
+		&i.SettleEntryID,
+		&i.Resolver,
+		&i.ExpiresTime,
+		&i.SettledTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
@@ -0,0 +1,145 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: exchange.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createExchange = `-- name: CreateExchange :one
+INSERT INTO exchanges (
+  from_trader_id,
+  to_trader_id,
+  from_symbol,
+  to_symbol,
+  from_number,
+  to_number,
+  rate,
+  from_detail_id,
+  to_detail_id,
+  entry_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10::bigint
+) RETURNING id, from_trader_id, to_trader_id, from_symbol, to_symbol, from_number, to_number, rate, from_detail_id, to_detail_id, created_time, entry_id
+`
+
+type CreateExchangeParams struct {
+	FromTraderID int64  `json:"from_trader_id"`
+	ToTraderID   int64  `json:"to_trader_id"`
+	FromSymbol   string `json:"from_symbol"`
+	ToSymbol     string `json:"to_symbol"`
+	FromNumber   int64  `json:"from_number"`
+	ToNumber     int64  `json:"to_number"`
+	Rate         int64  `json:"rate"`
+	FromDetailID int64  `json:"from_detail_id"`
+	ToDetailID   int64  `json:"to_detail_id"`
+	EntryID      int64  `json:"entry_id"`
+}
+
+func (q *Queries) CreateExchange(ctx context.Context, arg CreateExchangeParams) (Exchange, error) {
+	row := q.db.QueryRow(ctx, createExchange,
+		arg.FromTraderID,
+		arg.ToTraderID,
+		arg.FromSymbol,
+		arg.ToSymbol,
+		arg.FromNumber,
+		arg.ToNumber,
+		arg.Rate,
+		arg.FromDetailID,
+		arg.ToDetailID,
+		arg.EntryID,
+	)
+	var i Exchange
+	err := row.Scan(
+		&i.ID,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.FromSymbol,
+		&i.ToSymbol,
+		&i.FromNumber,
+		&i.ToNumber,
+		&i.Rate,
+		&i.FromDetailID,
+		&i.ToDetailID,
+		&i.CreatedTime,
+		&i.EntryID,
+	)
+	return i, err
+}
+
+const getExchange = `-- name: GetExchange :one
+SELECT id, from_trader_id, to_trader_id, from_symbol, to_symbol, from_number, to_number, rate, from_detail_id, to_detail_id, created_time, entry_id FROM exchanges
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetExchange(ctx context.Context, id int64) (Exchange, error) {
+	row := q.db.QueryRow(ctx, getExchange, id)
+	var i Exchange
+	err := row.Scan(
+		&i.ID,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.FromSymbol,
+		&i.ToSymbol,
+		&i.FromNumber,
+		&i.ToNumber,
+		&i.Rate,
+		&i.FromDetailID,
+		&i.ToDetailID,
+		&i.CreatedTime,
+		&i.EntryID,
+	)
+	return i, err
+}
+
+const listExchanges = `-- name: ListExchanges :many
+SELECT id, from_trader_id, to_trader_id, from_symbol, to_symbol, from_number, to_number, rate, from_detail_id, to_detail_id, created_time, entry_id FROM exchanges
+WHERE
+    from_trader_id = $1 OR
+    to_trader_id = $1
+ORDER BY id
+LIMIT $3
+OFFSET $2
+`
+
+type ListExchangesParams struct {
+	TraderID int64 `json:"trader_id"`
+	Offset   int32 `json:"offset"`
+	Limit    int32 `json:"limit"`
+}
+
+func (q *Queries) ListExchanges(ctx context.Context, arg ListExchangesParams) ([]Exchange, error) {
+	rows, err := q.db.Query(ctx, listExchanges, arg.TraderID, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Exchange{}
+	for rows.Next() {
+		var i Exchange
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromTraderID,
+			&i.ToTraderID,
+			&i.FromSymbol,
+			&i.ToSymbol,
+			&i.FromNumber,
+			&i.ToNumber,
+			&i.Rate,
+			&i.FromDetailID,
+			&i.ToDetailID,
+			&i.CreatedTime,
+			&i.EntryID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,261 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: fee.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createFeeTier = `-- name: CreateFeeTier :one
+INSERT INTO fee_tiers (
+  rule_id,
+  min_volume,
+  rate_bps
+) VALUES (
+  $1, $2, $3
+) RETURNING id, rule_id, min_volume, rate_bps
+`
+
+type CreateFeeTierParams struct {
+	RuleID    int64 `json:"rule_id"`
+	MinVolume int64 `json:"min_volume"`
+	RateBps   int32 `json:"rate_bps"`
+}
+
+func (q *Queries) CreateFeeTier(ctx context.Context, arg CreateFeeTierParams) (FeeTier, error) {
+	row := q.db.QueryRow(ctx, createFeeTier, arg.RuleID, arg.MinVolume, arg.RateBps)
+	var i FeeTier
+	err := row.Scan(
+		&i.ID,
+		&i.RuleID,
+		&i.MinVolume,
+		&i.RateBps,
+	)
+	return i, err
+}
+
+const deleteFeeRule = `-- name: DeleteFeeRule :exec
+DELETE FROM fee_rules
+WHERE id = $1
+`
+
+func (q *Queries) DeleteFeeRule(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteFeeRule, id)
+	return err
+}
+
+const deleteFeeTiers = `-- name: DeleteFeeTiers :exec
+DELETE FROM fee_tiers
+WHERE rule_id = $1
+`
+
+func (q *Queries) DeleteFeeTiers(ctx context.Context, ruleID int64) error {
+	_, err := q.db.Exec(ctx, deleteFeeTiers, ruleID)
+	return err
+}
+
+const getApplicableFeeRule = `-- name: GetApplicableFeeRule :one
+SELECT id, symbol, role, kind, flat_fee, rate_bps, min_fee, max_fee, created_time FROM fee_rules
+WHERE symbol = $1 AND role IN ($2::varchar, '')
+ORDER BY role DESC
+LIMIT 1
+`
+
+type GetApplicableFeeRuleParams struct {
+	Symbol string `json:"symbol"`
+	Role   string `json:"role"`
+}
+
+func (q *Queries) GetApplicableFeeRule(ctx context.Context, arg GetApplicableFeeRuleParams) (FeeRule, error) {
+	row := q.db.QueryRow(ctx, getApplicableFeeRule, arg.Symbol, arg.Role)
+	var i FeeRule
+	err := row.Scan(
+		&i.ID,
+		&i.Symbol,
+		&i.Role,
+		&i.Kind,
+		&i.FlatFee,
+		&i.RateBps,
+		&i.MinFee,
+		&i.MaxFee,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getFeeRule = `-- name: GetFeeRule :one
+SELECT id, symbol, role, kind, flat_fee, rate_bps, min_fee, max_fee, created_time FROM fee_rules
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetFeeRule(ctx context.Context, id int64) (FeeRule, error) {
+	row := q.db.QueryRow(ctx, getFeeRule, id)
+	var i FeeRule
+	err := row.Scan(
+		&i.ID,
+		&i.Symbol,
+		&i.Role,
+		&i.Kind,
+		&i.FlatFee,
+		&i.RateBps,
+		&i.MinFee,
+		&i.MaxFee,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getMonthlyVolume = `-- name: GetMonthlyVolume :one
+SELECT COALESCE(SUM(r.number), 0)::bigint AS volume
+FROM records r
+JOIN traders t ON t.id = r.from_trader_id
+WHERE t.holder = $1
+  AND t.symbol = $2
+  AND r.reversal_of IS NULL
+  AND r.created_time >= date_trunc('month', now())
+`
+
+type GetMonthlyVolumeParams struct {
+	Holder string `json:"holder"`
+	Symbol string `json:"symbol"`
+}
+
+func (q *Queries) GetMonthlyVolume(ctx context.Context, arg GetMonthlyVolumeParams) (int64, error) {
+	row := q.db.QueryRow(ctx, getMonthlyVolume, arg.Holder, arg.Symbol)
+	var volume int64
+	err := row.Scan(&volume)
+	return volume, err
+}
+
+const listFeeRules = `-- name: ListFeeRules :many
+SELECT id, symbol, role, kind, flat_fee, rate_bps, min_fee, max_fee, created_time FROM fee_rules
+ORDER BY symbol, role
+LIMIT $1
+OFFSET $2
+`
+
+type ListFeeRulesParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+func (q *Queries) ListFeeRules(ctx context.Context, arg ListFeeRulesParams) ([]FeeRule, error) {
+	rows, err := q.db.Query(ctx, listFeeRules, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FeeRule{}
+	for rows.Next() {
+		var i FeeRule
+		if err := rows.Scan(
+			&i.ID,
+			&i.Symbol,
+			&i.Role,
+			&i.Kind,
+			&i.FlatFee,
+			&i.RateBps,
+			&i.MinFee,
+			&i.MaxFee,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFeeTiers = `-- name: ListFeeTiers :many
+SELECT id, rule_id, min_volume, rate_bps FROM fee_tiers
+WHERE rule_id = $1
+ORDER BY min_volume
+`
+
+func (q *Queries) ListFeeTiers(ctx context.Context, ruleID int64) ([]FeeTier, error) {
+	rows, err := q.db.Query(ctx, listFeeTiers, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []FeeTier{}
+	for rows.Next() {
+		var i FeeTier
+		if err := rows.Scan(
+			&i.ID,
+			&i.RuleID,
+			&i.MinVolume,
+			&i.RateBps,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertFeeRule = `-- name: UpsertFeeRule :one
+INSERT INTO fee_rules (
+  symbol,
+  role,
+  kind,
+  flat_fee,
+  rate_bps,
+  min_fee,
+  max_fee
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+)
+ON CONFLICT (symbol, role) DO UPDATE
+SET
+  kind = EXCLUDED.kind,
+  flat_fee = EXCLUDED.flat_fee,
+  rate_bps = EXCLUDED.rate_bps,
+  min_fee = EXCLUDED.min_fee,
+  max_fee = EXCLUDED.max_fee
+RETURNING id, symbol, role, kind, flat_fee, rate_bps, min_fee, max_fee, created_time
+`
+
+type UpsertFeeRuleParams struct {
+	Symbol  string `json:"symbol"`
+	Role    string `json:"role"`
+	Kind    string `json:"kind"`
+	FlatFee int64  `json:"flat_fee"`
+	RateBps int32  `json:"rate_bps"`
+	MinFee  int64  `json:"min_fee"`
+	MaxFee  int64  `json:"max_fee"`
+}
+
+func (q *Queries) UpsertFeeRule(ctx context.Context, arg UpsertFeeRuleParams) (FeeRule, error) {
+	row := q.db.QueryRow(ctx, upsertFeeRule,
+		arg.Symbol,
+		arg.Role,
+		arg.Kind,
+		arg.FlatFee,
+		arg.RateBps,
+		arg.MinFee,
+		arg.MaxFee,
+	)
+	var i FeeRule
+	err := row.Scan(
+		&i.ID,
+		&i.Symbol,
+		&i.Role,
+		&i.Kind,
+		&i.FlatFee,
+		&i.RateBps,
+		&i.MinFee,
+		&i.MaxFee,
+		&i.CreatedTime,
+	)
+	return i, err
+}
@@ -0,0 +1,111 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: fill.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createFill = `-- name: CreateFill :one
+INSERT INTO fills (
+  maker_order_id,
+  taker_order_id,
+  price,
+  quantity,
+  base_record_id,
+  quote_record_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING id, maker_order_id, taker_order_id, price, quantity, base_record_id, quote_record_id, created_time
+`
+
+type CreateFillParams struct {
+	MakerOrderID  int64 `json:"maker_order_id"`
+	TakerOrderID  int64 `json:"taker_order_id"`
+	Price         int64 `json:"price"`
+	Quantity      int64 `json:"quantity"`
+	BaseRecordID  int64 `json:"base_record_id"`
+	QuoteRecordID int64 `json:"quote_record_id"`
+}
+
+func (q *Queries) CreateFill(ctx context.Context, arg CreateFillParams) (Fill, error) {
+	row := q.db.QueryRow(ctx, createFill,
+		arg.MakerOrderID,
+		arg.TakerOrderID,
+		arg.Price,
+		arg.Quantity,
+		arg.BaseRecordID,
+		arg.QuoteRecordID,
+	)
+	var i Fill
+	err := row.Scan(
+		&i.ID,
+		&i.MakerOrderID,
+		&i.TakerOrderID,
+		&i.Price,
+		&i.Quantity,
+		&i.BaseRecordID,
+		&i.QuoteRecordID,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getFill = `-- name: GetFill :one
+SELECT id, maker_order_id, taker_order_id, price, quantity, base_record_id, quote_record_id, created_time FROM fills
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetFill(ctx context.Context, id int64) (Fill, error) {
+	row := q.db.QueryRow(ctx, getFill, id)
+	var i Fill
+	err := row.Scan(
+		&i.ID,
+		&i.MakerOrderID,
+		&i.TakerOrderID,
+		&i.Price,
+		&i.Quantity,
+		&i.BaseRecordID,
+		&i.QuoteRecordID,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const listFillsByOrder = `-- name: ListFillsByOrder :many
+SELECT id, maker_order_id, taker_order_id, price, quantity, base_record_id, quote_record_id, created_time FROM fills
+WHERE maker_order_id = $1 OR taker_order_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListFillsByOrder(ctx context.Context, orderID int64) ([]Fill, error) {
+	rows, err := q.db.Query(ctx, listFillsByOrder, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Fill{}
+	for rows.Next() {
+		var i Fill
+		if err := rows.Scan(
+			&i.ID,
+			&i.MakerOrderID,
+			&i.TakerOrderID,
+			&i.Price,
+			&i.Quantity,
+			&i.BaseRecordID,
+			&i.QuoteRecordID,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
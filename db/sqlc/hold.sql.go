@@ -0,0 +1,205 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: hold.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createHold = `-- name: CreateHold :one
+INSERT INTO holds (
+  trader_id,
+  number,
+  remaining,
+  reason,
+  expires_time
+) VALUES (
+  $1, $2, $2, $3, $4
+) RETURNING id, trader_id, number, remaining, status, reason, expires_time, created_time
+`
+
+type CreateHoldParams struct {
+	TraderID    int64              `json:"trader_id"`
+	Number      int64              `json:"number"`
+	Reason      string             `json:"reason"`
+	ExpiresTime pgtype.Timestamptz `json:"expires_time"`
+}
+
+func (q *Queries) CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error) {
+	row := q.db.QueryRow(ctx, createHold,
+		arg.TraderID,
+		arg.Number,
+		arg.Reason,
+		arg.ExpiresTime,
+	)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.TraderID,
+		&i.Number,
+		&i.Remaining,
+		&i.Status,
+		&i.Reason,
+		&i.ExpiresTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getHold = `-- name: GetHold :one
+SELECT id, trader_id, number, remaining, status, reason, expires_time, created_time FROM holds
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetHold(ctx context.Context, id int64) (Hold, error) {
+	row := q.db.QueryRow(ctx, getHold, id)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.TraderID,
+		&i.Number,
+		&i.Remaining,
+		&i.Status,
+		&i.Reason,
+		&i.ExpiresTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getHoldForUpdate = `-- name: GetHoldForUpdate :one
+SELECT id, trader_id, number, remaining, status, reason, expires_time, created_time FROM holds
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetHoldForUpdate(ctx context.Context, id int64) (Hold, error) {
+	row := q.db.QueryRow(ctx, getHoldForUpdate, id)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.TraderID,
+		&i.Number,
+		&i.Remaining,
+		&i.Status,
+		&i.Reason,
+		&i.ExpiresTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const listExpiredHolds = `-- name: ListExpiredHolds :many
+SELECT id, trader_id, number, remaining, status, reason, expires_time, created_time FROM holds
+WHERE status = 'active' AND expires_time <= now()
+ORDER BY expires_time
+LIMIT $1
+`
+
+func (q *Queries) ListExpiredHolds(ctx context.Context, limit int32) ([]Hold, error) {
+	rows, err := q.db.Query(ctx, listExpiredHolds, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Hold{}
+	for rows.Next() {
+		var i Hold
+		if err := rows.Scan(
+			&i.ID,
+			&i.TraderID,
+			&i.Number,
+			&i.Remaining,
+			&i.Status,
+			&i.Reason,
+			&i.ExpiresTime,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listHolds = `-- name: ListHolds :many
+SELECT id, trader_id, number, remaining, status, reason, expires_time, created_time FROM holds
+WHERE trader_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3
+`
+
+type ListHoldsParams struct {
+	TraderID int64 `json:"trader_id"`
+	Limit    int32 `json:"limit"`
+	Offset   int32 `json:"offset"`
+}
+
+func (q *Queries) ListHolds(ctx context.Context, arg ListHoldsParams) ([]Hold, error) {
+	rows, err := q.db.Query(ctx, listHolds, arg.TraderID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Hold{}
+	for rows.Next() {
+		var i Hold
+		if err := rows.Scan(
+			&i.ID,
+			&i.TraderID,
+			&i.Number,
+			&i.Remaining,
+			&i.Status,
+			&i.Reason,
+			&i.ExpiresTime,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateHold = `-- name: UpdateHold :one
+UPDATE holds
+SET
+  remaining = $1,
+  status = $2
+WHERE id = $3
+RETURNING id, trader_id, number, remaining, status, reason, expires_time, created_time
+`
+
+type UpdateHoldParams struct {
+	Remaining int64  `json:"remaining"`
+	Status    string `json:"status"`
+	ID        int64  `json:"id"`
+}
+
+func (q *Queries) UpdateHold(ctx context.Context, arg UpdateHoldParams) (Hold, error) {
+	row := q.db.QueryRow(ctx, updateHold, arg.Remaining, arg.Status, arg.ID)
+	var i Hold
+	err := row.Scan(
+		&i.ID,
+		&i.TraderID,
+		&i.Number,
+		&i.Remaining,
+		&i.Status,
+		&i.Reason,
+		&i.ExpiresTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
@@ -0,0 +1,130 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: journal.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createJournalEntry = `-- name: CreateJournalEntry :one
+INSERT INTO journal_entries (
+  kind,
+  record_id
+) VALUES (
+  $1, $2
+) RETURNING id, kind, record_id, created_time
+`
+
+type CreateJournalEntryParams struct {
+	Kind     string      `json:"kind"`
+	RecordID pgtype.Int8 `json:"record_id"`
+}
+
+func (q *Queries) CreateJournalEntry(ctx context.Context, arg CreateJournalEntryParams) (JournalEntry, error) {
+	row := q.db.QueryRow(ctx, createJournalEntry, arg.Kind, arg.RecordID)
+	var i JournalEntry
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.RecordID,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const createPosting = `-- name: CreatePosting :one
+INSERT INTO postings (
+  entry_id,
+  trader_id,
+  symbol,
+  number,
+  detail_id
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING id, entry_id, trader_id, symbol, number, detail_id, created_time
+`
+
+type CreatePostingParams struct {
+	EntryID  int64  `json:"entry_id"`
+	TraderID int64  `json:"trader_id"`
+	Symbol   string `json:"symbol"`
+	Number   int64  `json:"number"`
+	DetailID int64  `json:"detail_id"`
+}
+
+func (q *Queries) CreatePosting(ctx context.Context, arg CreatePostingParams) (Posting, error) {
+	row := q.db.QueryRow(ctx, createPosting,
+		arg.EntryID,
+		arg.TraderID,
+		arg.Symbol,
+		arg.Number,
+		arg.DetailID,
+	)
+	var i Posting
+	err := row.Scan(
+		&i.ID,
+		&i.EntryID,
+		&i.TraderID,
+		&i.Symbol,
+		&i.Number,
+		&i.DetailID,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getJournalEntry = `-- name: GetJournalEntry :one
+SELECT id, kind, record_id, created_time FROM journal_entries
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetJournalEntry(ctx context.Context, id int64) (JournalEntry, error) {
+	row := q.db.QueryRow(ctx, getJournalEntry, id)
+	var i JournalEntry
+	err := row.Scan(
+		&i.ID,
+		&i.Kind,
+		&i.RecordID,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const listPostingsByEntry = `-- name: ListPostingsByEntry :many
+SELECT id, entry_id, trader_id, symbol, number, detail_id, created_time FROM postings
+WHERE entry_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListPostingsByEntry(ctx context.Context, entryID int64) ([]Posting, error) {
+	rows, err := q.db.Query(ctx, listPostingsByEntry, entryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Posting{}
+	for rows.Next() {
+		var i Posting
+		if err := rows.Scan(
+			&i.ID,
+			&i.EntryID,
+			&i.TraderID,
+			&i.Symbol,
+			&i.Number,
+			&i.DetailID,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
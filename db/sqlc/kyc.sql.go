@@ -0,0 +1,210 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: kyc.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createKYCSubmission = `-- name: CreateKYCSubmission :one
+INSERT INTO kyc_submissions (
+  membername,
+  level,
+  legal_name,
+  date_of_birth,
+  country,
+  document_type,
+  document_number
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING id, membername, level, legal_name, date_of_birth, country, document_type, document_number, status, reason, reviewer, reviewed_time, created_time
+`
+
+type CreateKYCSubmissionParams struct {
+	Membername     string      `json:"membername"`
+	Level          string      `json:"level"`
+	LegalName      string      `json:"legal_name"`
+	DateOfBirth    pgtype.Date `json:"date_of_birth"`
+	Country        string      `json:"country"`
+	DocumentType   string      `json:"document_type"`
+	DocumentNumber string      `json:"document_number"`
+}
+
+func (q *Queries) CreateKYCSubmission(ctx context.Context, arg CreateKYCSubmissionParams) (KycSubmission, error) {
+	row := q.db.QueryRow(ctx, createKYCSubmission,
+		arg.Membername,
+		arg.Level,
+		arg.LegalName,
+		arg.DateOfBirth,
+		arg.Country,
+		arg.DocumentType,
+		arg.DocumentNumber,
+	)
+	var i KycSubmission
+	err := row.Scan(
+		&i.ID,
+		&i.Membername,
+		&i.Level,
+		&i.LegalName,
+		&i.DateOfBirth,
+		&i.Country,
+		&i.DocumentType,
+		&i.DocumentNumber,
+		&i.Status,
+		&i.Reason,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getKYCSubmission = `-- name: GetKYCSubmission :one
+SELECT id, membername, level, legal_name, date_of_birth, country, document_type, document_number, status, reason, reviewer, reviewed_time, created_time FROM kyc_submissions
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetKYCSubmission(ctx context.Context, id int64) (KycSubmission, error) {
+	row := q.db.QueryRow(ctx, getKYCSubmission, id)
+	var i KycSubmission
+	err := row.Scan(
+		&i.ID,
+		&i.Membername,
+		&i.Level,
+		&i.LegalName,
+		&i.DateOfBirth,
+		&i.Country,
+		&i.DocumentType,
+		&i.DocumentNumber,
+		&i.Status,
+		&i.Reason,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getKYCSubmissionForUpdate = `-- name: GetKYCSubmissionForUpdate :one
+SELECT id, membername, level, legal_name, date_of_birth, country, document_type, document_number, status, reason, reviewer, reviewed_time, created_time FROM kyc_submissions
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetKYCSubmissionForUpdate(ctx context.Context, id int64) (KycSubmission, error) {
+	row := q.db.QueryRow(ctx, getKYCSubmissionForUpdate, id)
+	var i KycSubmission
+	err := row.Scan(
+		&i.ID,
+		&i.Membername,
+		&i.Level,
+		&i.LegalName,
+		&i.DateOfBirth,
+		&i.Country,
+		&i.DocumentType,
+		&i.DocumentNumber,
+		&i.Status,
+		&i.Reason,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const listKYCSubmissions = `-- name: ListKYCSubmissions :many
+SELECT id, membername, level, legal_name, date_of_birth, country, document_type, document_number, status, reason, reviewer, reviewed_time, created_time FROM kyc_submissions
+WHERE status = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3
+`
+
+type ListKYCSubmissionsParams struct {
+	Status string `json:"status"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListKYCSubmissions(ctx context.Context, arg ListKYCSubmissionsParams) ([]KycSubmission, error) {
+	rows, err := q.db.Query(ctx, listKYCSubmissions, arg.Status, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []KycSubmission{}
+	for rows.Next() {
+		var i KycSubmission
+		if err := rows.Scan(
+			&i.ID,
+			&i.Membername,
+			&i.Level,
+			&i.LegalName,
+			&i.DateOfBirth,
+			&i.Country,
+			&i.DocumentType,
+			&i.DocumentNumber,
+			&i.Status,
+			&i.Reason,
+			&i.Reviewer,
+			&i.ReviewedTime,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reviewKYCSubmission = `-- name: ReviewKYCSubmission :one
+UPDATE kyc_submissions
+SET
+  status = $1,
+  reason = $2,
+  reviewer = $3::varchar,
+  reviewed_time = now()
+WHERE id = $4
+RETURNING id, membername, level, legal_name, date_of_birth, country, document_type, document_number, status, reason, reviewer, reviewed_time, created_time
+`
+
+type ReviewKYCSubmissionParams struct {
+	Status   string `json:"status"`
+	Reason   string `json:"reason"`
+	Reviewer string `json:"reviewer"`
+	ID       int64  `json:"id"`
+}
+
+func (q *Queries) ReviewKYCSubmission(ctx context.Context, arg ReviewKYCSubmissionParams) (KycSubmission, error) {
+	row := q.db.QueryRow(ctx, reviewKYCSubmission,
+		arg.Status,
+		arg.Reason,
+		arg.Reviewer,
+		arg.ID,
+	)
+	var i KycSubmission
+	err := row.Scan(
+		&i.ID,
+		&i.Membername,
+		&i.Level,
+		&i.LegalName,
+		&i.DateOfBirth,
+		&i.Country,
+		&i.DocumentType,
+		&i.DocumentNumber,
+		&i.Status,
+		&i.Reason,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
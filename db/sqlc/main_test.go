@@ -23,6 +23,6 @@ func TestMain(m *testing.M) {
 		log.Fatal("sql open err:", err)
 	}
 
-	testStore = NewStore(connPool)
+	testStore = NewStore(connPool, nil)
 	os.Exit(m.Run())
 }
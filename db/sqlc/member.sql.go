@@ -19,7 +19,7 @@ INSERT INTO members (
   email
 ) VALUES (
   $1, $2, $3, $4
-) RETURNING membername, password_hash, name_entire, email, password_changed_time, created_time, is_email_verified, role
+) RETURNING membername, password_hash, name_entire, email, password_changed_time, created_time, is_email_verified, role, kyc_level
 `
 
 type CreateMemberParams struct {
@@ -46,12 +46,13 @@ func (q *Queries) CreateMember(ctx context.Context, arg CreateMemberParams) (Mem
 		&i.CreatedTime,
 		&i.IsEmailVerified,
 		&i.Role,
+		&i.KycLevel,
 	)
 	return i, err
 }
 
 const getMember = `-- name: GetMember :one
-SELECT membername, password_hash, name_entire, email, password_changed_time, created_time, is_email_verified, role FROM members
+SELECT membername, password_hash, name_entire, email, password_changed_time, created_time, is_email_verified, role, kyc_level FROM members
 WHERE membername = $1 LIMIT 1
 `
 
@@ -67,6 +68,7 @@ func (q *Queries) GetMember(ctx context.Context, membername string) (Member, err
 		&i.CreatedTime,
 		&i.IsEmailVerified,
 		&i.Role,
+		&i.KycLevel,
 	)
 	return i, err
 }
@@ -81,7 +83,7 @@ SET
   is_email_verified = COALESCE($5, is_email_verified)
 WHERE
   membername = $6
-RETURNING membername, password_hash, name_entire, email, password_changed_time, created_time, is_email_verified, role
+RETURNING membername, password_hash, name_entire, email, password_changed_time, created_time, is_email_verified, role, kyc_level
 `
 
 type UpdateMemberParams struct {
@@ -112,6 +114,36 @@ func (q *Queries) UpdateMember(ctx context.Context, arg UpdateMemberParams) (Mem
 		&i.CreatedTime,
 		&i.IsEmailVerified,
 		&i.Role,
+		&i.KycLevel,
+	)
+	return i, err
+}
+
+const updateMemberKYCLevel = `-- name: UpdateMemberKYCLevel :one
+UPDATE members
+SET kyc_level = $1
+WHERE membername = $2
+RETURNING membername, password_hash, name_entire, email, password_changed_time, created_time, is_email_verified, role, kyc_level
+`
+
+type UpdateMemberKYCLevelParams struct {
+	KycLevel   string `json:"kyc_level"`
+	Membername string `json:"membername"`
+}
+
+func (q *Queries) UpdateMemberKYCLevel(ctx context.Context, arg UpdateMemberKYCLevelParams) (Member, error) {
+	row := q.db.QueryRow(ctx, updateMemberKYCLevel, arg.KycLevel, arg.Membername)
+	var i Member
+	err := row.Scan(
+		&i.Membername,
+		&i.PasswordHash,
+		&i.NameEntire,
+		&i.Email,
+		&i.PasswordChangedTime,
+		&i.CreatedTime,
+		&i.IsEmailVerified,
+		&i.Role,
+		&i.KycLevel,
 	)
 	return i, err
 }
@@ -8,16 +8,146 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type BalanceSnapshot struct {
+	TraderID     int64     `json:"trader_id"`
+	SnapshotTime time.Time `json:"snapshot_time"`
+	// sum of the details of the trader created before snapshot_time
+	Balance     int64     `json:"balance"`
+	CreatedTime time.Time `json:"created_time"`
+}
+
+type Deposit struct {
+	ID       int64 `json:"id"`
+	TraderID int64 `json:"trader_id"`
+	Number   int64 `json:"number"`
+	// id of the transfer on the external rail
+	Reference   string    `json:"reference"`
+	EntryID     int64     `json:"entry_id"`
+	CreatedTime time.Time `json:"created_time"`
+}
+
 type Detail struct {
 	ID       int64 `json:"id"`
 	TraderID int64 `json:"trader_id"`
 	// can be negative or positive
-	Number      int64     `json:"number"`
+	Number      int64       `json:"number"`
+	CreatedTime time.Time   `json:"created_time"`
+	RecordID    pgtype.Int8 `json:"record_id"`
+}
+
+type Escrow struct {
+	ID             int64  `json:"id"`
+	Buyer          string `json:"buyer"`
+	Seller         string `json:"seller"`
+	BuyerTraderID  int64  `json:"buyer_trader_id"`
+	SellerTraderID int64  `json:"seller_trader_id"`
+	EscrowTraderID int64  `json:"escrow_trader_id"`
+	Number         int64  `json:"number"`
+	// open, disputed, released, cancelled or expired
+	Status          string `json:"status"`
+	BuyerConfirmed  bool   `json:"buyer_confirmed"`
+	SellerConfirmed bool   `json:"seller_confirmed"`
+	FundEntryID     int64  `json:"fund_entry_id"`
+	// entry that paid the seller or refunded the buyer
+	SettleEntryID pgtype.Int8 `json:"settle_entry_id"`
+	// priest who settled the escrow after a dispute
+	Resolver    pgtype.Text        `json:"resolver"`
+	ExpiresTime time.Time          `json:"expires_time"`
+	SettledTime pgtype.Timestamptz `json:"settled_time"`
+	CreatedTime time.Time          `json:"created_time"`
+}
+
+type Exchange struct {
+	ID           int64  `json:"id"`
+	FromTraderID int64  `json:"from_trader_id"`
+	ToTraderID   int64  `json:"to_trader_id"`
+	FromSymbol   string `json:"from_symbol"`
+	ToSymbol     string `json:"to_symbol"`
+	FromNumber   int64  `json:"from_number"`
+	ToNumber     int64  `json:"to_number"`
+	// to_symbol units per from_symbol unit, scaled by 10^8
+	Rate         int64       `json:"rate"`
+	FromDetailID int64       `json:"from_detail_id"`
+	ToDetailID   int64       `json:"to_detail_id"`
+	CreatedTime  time.Time   `json:"created_time"`
+	EntryID      pgtype.Int8 `json:"entry_id"`
+}
+
+type FeeRule struct {
+	ID     int64  `json:"id"`
+	Symbol string `json:"symbol"`
+	// member role the rule applies to, empty for every role without a rule of its own
+	Role string `json:"role"`
+	// flat, percentage or tiered
+	Kind    string `json:"kind"`
+	FlatFee int64  `json:"flat_fee"`
+	RateBps int32  `json:"rate_bps"`
+	MinFee  int64  `json:"min_fee"`
+	// zero for no maximum
+	MaxFee      int64     `json:"max_fee"`
 	CreatedTime time.Time `json:"created_time"`
 }
 
+type FeeTier struct {
+	ID     int64 `json:"id"`
+	RuleID int64 `json:"rule_id"`
+	// monthly volume sent from which the tier rate applies
+	MinVolume int64 `json:"min_volume"`
+	RateBps   int32 `json:"rate_bps"`
+}
+
+type Fill struct {
+	ID           int64 `json:"id"`
+	MakerOrderID int64 `json:"maker_order_id"`
+	TakerOrderID int64 `json:"taker_order_id"`
+	// quote minor units per whole base unit
+	Price         int64     `json:"price"`
+	Quantity      int64     `json:"quantity"`
+	BaseRecordID  int64     `json:"base_record_id"`
+	QuoteRecordID int64     `json:"quote_record_id"`
+	CreatedTime   time.Time `json:"created_time"`
+}
+
+type Hold struct {
+	ID       int64 `json:"id"`
+	TraderID int64 `json:"trader_id"`
+	Number   int64 `json:"number"`
+	// part of number not yet released or captured
+	Remaining   int64              `json:"remaining"`
+	Status      string             `json:"status"`
+	Reason      string             `json:"reason"`
+	ExpiresTime pgtype.Timestamptz `json:"expires_time"`
+	CreatedTime time.Time          `json:"created_time"`
+}
+
+type JournalEntry struct {
+	ID          int64       `json:"id"`
+	Kind        string      `json:"kind"`
+	RecordID    pgtype.Int8 `json:"record_id"`
+	CreatedTime time.Time   `json:"created_time"`
+}
+
+type KycSubmission struct {
+	ID         int64  `json:"id"`
+	Membername string `json:"membername"`
+	// level the member asks for
+	Level          string      `json:"level"`
+	LegalName      string      `json:"legal_name"`
+	DateOfBirth    pgtype.Date `json:"date_of_birth"`
+	Country        string      `json:"country"`
+	DocumentType   string      `json:"document_type"`
+	DocumentNumber string      `json:"document_number"`
+	Status         string      `json:"status"`
+	// given by the reviewer, shown to the member
+	Reason       string             `json:"reason"`
+	Reviewer     pgtype.Text        `json:"reviewer"`
+	ReviewedTime pgtype.Timestamptz `json:"reviewed_time"`
+	CreatedTime  time.Time          `json:"created_time"`
+}
+
 type Member struct {
 	Membername          string    `json:"membername"`
 	PasswordHash        string    `json:"password_hash"`
@@ -27,6 +157,50 @@ type Member struct {
 	CreatedTime         time.Time `json:"created_time"`
 	IsEmailVerified     bool      `json:"is_email_verified"`
 	Role                string    `json:"role"`
+	// none, basic or full, raised when a priest approves a kyc submission
+	KycLevel string `json:"kyc_level"`
+}
+
+type Order struct {
+	ID            int64  `json:"id"`
+	Holder        string `json:"holder"`
+	BaseTraderID  int64  `json:"base_trader_id"`
+	QuoteTraderID int64  `json:"quote_trader_id"`
+	BaseSymbol    string `json:"base_symbol"`
+	QuoteSymbol   string `json:"quote_symbol"`
+	Side          string `json:"side"`
+	Kind          string `json:"kind"`
+	// quote minor units per whole base unit, 0 for market orders
+	Price int64 `json:"price"`
+	// in base units, must be positive
+	Quantity    int64       `json:"quantity"`
+	Filled      int64       `json:"filled"`
+	Status      string      `json:"status"`
+	CreatedTime time.Time   `json:"created_time"`
+	HoldID      pgtype.Int8 `json:"hold_id"`
+}
+
+type Posting struct {
+	ID       int64  `json:"id"`
+	EntryID  int64  `json:"entry_id"`
+	TraderID int64  `json:"trader_id"`
+	Symbol   string `json:"symbol"`
+	// postings of one entry sum to zero per symbol
+	Number      int64     `json:"number"`
+	DetailID    int64     `json:"detail_id"`
+	CreatedTime time.Time `json:"created_time"`
+}
+
+type Price struct {
+	ID    int64  `json:"id"`
+	Base  string `json:"base"`
+	Quote string `json:"quote"`
+	// quote per whole base unit, with 8 decimals
+	Rate int64 `json:"rate"`
+	// the feed the price came from
+	Source      string    `json:"source"`
+	PriceTime   time.Time `json:"price_time"`
+	CreatedTime time.Time `json:"created_time"`
 }
 
 type Record struct {
@@ -36,6 +210,43 @@ type Record struct {
 	// must be positive
 	Number      int64     `json:"number"`
 	CreatedTime time.Time `json:"created_time"`
+	// member who requested the transfer
+	Membername pgtype.Text `json:"membername"`
+	// client supplied, unique per member
+	IdempotencyKey pgtype.Text `json:"idempotency_key"`
+	// record this one compensates, in full or in part
+	ReversalOf pgtype.Int8 `json:"reversal_of"`
+	// charged to the sender on top of number and credited to the house trader
+	Fee       int64       `json:"fee"`
+	FeeRuleID pgtype.Int8 `json:"fee_rule_id"`
+}
+
+type ScheduledTransfer struct {
+	ID           int64  `json:"id"`
+	Holder       string `json:"holder"`
+	FromTraderID int64  `json:"from_trader_id"`
+	ToTraderID   int64  `json:"to_trader_id"`
+	Number       int64  `json:"number"`
+	// cron expression, descriptor such as @weekly, or interval such as @every 24h
+	Cronspec string `json:"cronspec"`
+	// skip the run, or retry it with backoff
+	OnInsufficientFunds string             `json:"on_insufficient_funds"`
+	Active              bool               `json:"active"`
+	NextRunTime         time.Time          `json:"next_run_time"`
+	LastRunTime         pgtype.Timestamptz `json:"last_run_time"`
+	CreatedTime         time.Time          `json:"created_time"`
+}
+
+type ScheduledTransferRun struct {
+	ID            int64     `json:"id"`
+	ScheduleID    int64     `json:"schedule_id"`
+	ScheduledTime time.Time `json:"scheduled_time"`
+	// succeeded, held, skipped or failed
+	Status      string      `json:"status"`
+	RecordID    pgtype.Int8 `json:"record_id"`
+	Error       string      `json:"error"`
+	Attempts    int32       `json:"attempts"`
+	CreatedTime time.Time   `json:"created_time"`
 }
 
 type Session struct {
@@ -49,12 +260,79 @@ type Session struct {
 	CreatedTime  time.Time `json:"created_time"`
 }
 
+type Symbol struct {
+	Code        string `json:"code"`
+	DisplayName string `json:"display_name"`
+	// number of decimal places of one unit, amounts are stored in the smallest unit
+	Decimals    int32 `json:"decimals"`
+	MinTransfer int64 `json:"min_transfer"`
+	// disabled symbols keep their traders but accept no new traders, transfers or orders
+	Enabled     bool      `json:"enabled"`
+	CreatedTime time.Time `json:"created_time"`
+}
+
 type Trader struct {
 	ID          int64     `json:"id"`
 	Holder      string    `json:"holder"`
 	Rest        int64     `json:"rest"`
 	Symbol      string    `json:"symbol"`
 	CreatedTime time.Time `json:"created_time"`
+	// how far below zero rest may go
+	OverdraftLimit int64 `json:"overdraft_limit"`
+	// part of rest reserved by active holds
+	Held int64 `json:"held"`
+	// active, frozen or closed; only active traders send or receive transfers
+	Status       string `json:"status"`
+	StatusReason string `json:"status_reason"`
+}
+
+type TraderStatusChange struct {
+	ID         int64  `json:"id"`
+	TraderID   int64  `json:"trader_id"`
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	Reason     string `json:"reason"`
+	// priest who changed the status
+	Membername  string    `json:"membername"`
+	CreatedTime time.Time `json:"created_time"`
+}
+
+type TransferLimit struct {
+	ID     int64  `json:"id"`
+	Symbol string `json:"symbol"`
+	// member role the limit applies to, empty for every role without a limit of its own
+	Role string `json:"role"`
+	// most a member may send in any 24 hours, zero for no limit
+	DailyLimit int64 `json:"daily_limit"`
+	// most a member may send in any 30 days, zero for no limit
+	MonthlyLimit int64     `json:"monthly_limit"`
+	CreatedTime  time.Time `json:"created_time"`
+	// kyc level the limit applies to, empty for every level without a limit of its own
+	KycLevel string `json:"kyc_level"`
+}
+
+// transfers held by screening until a priest approves or rejects them
+type TransferReview struct {
+	ID             int64       `json:"id"`
+	FromTraderID   int64       `json:"from_trader_id"`
+	ToTraderID     int64       `json:"to_trader_id"`
+	Number         int64       `json:"number"`
+	Membername     pgtype.Text `json:"membername"`
+	IdempotencyKey pgtype.Text `json:"idempotency_key"`
+	// screening rules the transfer tripped
+	Reasons []string `json:"reasons"`
+	// reserves the number and fee on the sender while the review is pending
+	HoldID int64  `json:"hold_id"`
+	Status string `json:"status"`
+	// set when the transfer is approved and recorded
+	RecordID     pgtype.Int8        `json:"record_id"`
+	Reviewer     pgtype.Text        `json:"reviewer"`
+	ReviewedTime pgtype.Timestamptz `json:"reviewed_time"`
+	CreatedTime  time.Time          `json:"created_time"`
+	// set when the review holds the funding of an escrow, which approving opens instead of recording a transfer
+	EscrowExpiresTime pgtype.Timestamptz `json:"escrow_expires_time"`
+	// set when the escrow funding is approved and the escrow opened
+	EscrowID pgtype.Int8 `json:"escrow_id"`
 }
 
 type VerifyEmail struct {
@@ -66,3 +344,20 @@ type VerifyEmail struct {
 	CreatedTime time.Time `json:"created_time"`
 	ExpiredTime time.Time `json:"expired_time"`
 }
+
+type Withdrawal struct {
+	ID          int64  `json:"id"`
+	TraderID    int64  `json:"trader_id"`
+	Number      int64  `json:"number"`
+	Destination string `json:"destination"`
+	// pending, approved or rejected
+	Status string `json:"status"`
+	// set when the withdrawal is paid out, or at request time before holds existed
+	EntryID pgtype.Int8 `json:"entry_id"`
+	// set when a rejected withdrawal was credited back
+	RefundEntryID pgtype.Int8        `json:"refund_entry_id"`
+	Reviewer      pgtype.Text        `json:"reviewer"`
+	ReviewedTime  pgtype.Timestamptz `json:"reviewed_time"`
+	CreatedTime   time.Time          `json:"created_time"`
+	HoldID        pgtype.Int8        `json:"hold_id"`
+}
@@ -0,0 +1,318 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: order.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addOrderFilled = `-- name: AddOrderFilled :one
+UPDATE orders
+SET
+  filled = filled + $1,
+  status = CASE WHEN filled + $1 >= orders.quantity THEN 'filled' ELSE status END
+WHERE id = $2
+RETURNING id, holder, base_trader_id, quote_trader_id, base_symbol, quote_symbol, side, kind, price, quantity, filled, status, created_time, hold_id
+`
+
+type AddOrderFilledParams struct {
+	Quantity int64 `json:"quantity"`
+	ID       int64 `json:"id"`
+}
+
+func (q *Queries) AddOrderFilled(ctx context.Context, arg AddOrderFilledParams) (Order, error) {
+	row := q.db.QueryRow(ctx, addOrderFilled, arg.Quantity, arg.ID)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.BaseTraderID,
+		&i.QuoteTraderID,
+		&i.BaseSymbol,
+		&i.QuoteSymbol,
+		&i.Side,
+		&i.Kind,
+		&i.Price,
+		&i.Quantity,
+		&i.Filled,
+		&i.Status,
+		&i.CreatedTime,
+		&i.HoldID,
+	)
+	return i, err
+}
+
+const createOrder = `-- name: CreateOrder :one
+INSERT INTO orders (
+  holder,
+  base_trader_id,
+  quote_trader_id,
+  base_symbol,
+  quote_symbol,
+  side,
+  kind,
+  price,
+  quantity,
+  hold_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING id, holder, base_trader_id, quote_trader_id, base_symbol, quote_symbol, side, kind, price, quantity, filled, status, created_time, hold_id
+`
+
+type CreateOrderParams struct {
+	Holder        string      `json:"holder"`
+	BaseTraderID  int64       `json:"base_trader_id"`
+	QuoteTraderID int64       `json:"quote_trader_id"`
+	BaseSymbol    string      `json:"base_symbol"`
+	QuoteSymbol   string      `json:"quote_symbol"`
+	Side          string      `json:"side"`
+	Kind          string      `json:"kind"`
+	Price         int64       `json:"price"`
+	Quantity      int64       `json:"quantity"`
+	HoldID        pgtype.Int8 `json:"hold_id"`
+}
+
+func (q *Queries) CreateOrder(ctx context.Context, arg CreateOrderParams) (Order, error) {
+	row := q.db.QueryRow(ctx, createOrder,
+		arg.Holder,
+		arg.BaseTraderID,
+		arg.QuoteTraderID,
+		arg.BaseSymbol,
+		arg.QuoteSymbol,
+		arg.Side,
+		arg.Kind,
+		arg.Price,
+		arg.Quantity,
+		arg.HoldID,
+	)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.BaseTraderID,
+		&i.QuoteTraderID,
+		&i.BaseSymbol,
+		&i.QuoteSymbol,
+		&i.Side,
+		&i.Kind,
+		&i.Price,
+		&i.Quantity,
+		&i.Filled,
+		&i.Status,
+		&i.CreatedTime,
+		&i.HoldID,
+	)
+	return i, err
+}
+
+const getOrder = `-- name: GetOrder :one
+SELECT id, holder, base_trader_id, quote_trader_id, base_symbol, quote_symbol, side, kind, price, quantity, filled, status, created_time, hold_id FROM orders
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetOrder(ctx context.Context, id int64) (Order, error) {
+	row := q.db.QueryRow(ctx, getOrder, id)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.BaseTraderID,
+		&i.QuoteTraderID,
+		&i.BaseSymbol,
+		&i.QuoteSymbol,
+		&i.Side,
+		&i.Kind,
+		&i.Price,
+		&i.Quantity,
+		&i.Filled,
+		&i.Status,
+		&i.CreatedTime,
+		&i.HoldID,
+	)
+	return i, err
+}
+
+const getOrderByHoldForUpdate = `-- name: GetOrderByHoldForUpdate :one
+SELECT id, holder, base_trader_id, quote_trader_id, base_symbol, quote_symbol, side, kind, price, quantity, filled, status, created_time, hold_id FROM orders
+WHERE hold_id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetOrderByHoldForUpdate(ctx context.Context, holdID pgtype.Int8) (Order, error) {
+	row := q.db.QueryRow(ctx, getOrderByHoldForUpdate, holdID)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.BaseTraderID,
+		&i.QuoteTraderID,
+		&i.BaseSymbol,
+		&i.QuoteSymbol,
+		&i.Side,
+		&i.Kind,
+		&i.Price,
+		&i.Quantity,
+		&i.Filled,
+		&i.Status,
+		&i.CreatedTime,
+		&i.HoldID,
+	)
+	return i, err
+}
+
+const getOrderForUpdate = `-- name: GetOrderForUpdate :one
+SELECT id, holder, base_trader_id, quote_trader_id, base_symbol, quote_symbol, side, kind, price, quantity, filled, status, created_time, hold_id FROM orders
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetOrderForUpdate(ctx context.Context, id int64) (Order, error) {
+	row := q.db.QueryRow(ctx, getOrderForUpdate, id)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.BaseTraderID,
+		&i.QuoteTraderID,
+		&i.BaseSymbol,
+		&i.QuoteSymbol,
+		&i.Side,
+		&i.Kind,
+		&i.Price,
+		&i.Quantity,
+		&i.Filled,
+		&i.Status,
+		&i.CreatedTime,
+		&i.HoldID,
+	)
+	return i, err
+}
+
+const listOpenOrders = `-- name: ListOpenOrders :many
+SELECT id, holder, base_trader_id, quote_trader_id, base_symbol, quote_symbol, side, kind, price, quantity, filled, status, created_time, hold_id FROM orders
+WHERE status = 'open'
+ORDER BY id
+`
+
+func (q *Queries) ListOpenOrders(ctx context.Context) ([]Order, error) {
+	rows, err := q.db.Query(ctx, listOpenOrders)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Order{}
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.Holder,
+			&i.BaseTraderID,
+			&i.QuoteTraderID,
+			&i.BaseSymbol,
+			&i.QuoteSymbol,
+			&i.Side,
+			&i.Kind,
+			&i.Price,
+			&i.Quantity,
+			&i.Filled,
+			&i.Status,
+			&i.CreatedTime,
+			&i.HoldID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrders = `-- name: ListOrders :many
+SELECT id, holder, base_trader_id, quote_trader_id, base_symbol, quote_symbol, side, kind, price, quantity, filled, status, created_time, hold_id FROM orders
+WHERE holder = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3
+`
+
+type ListOrdersParams struct {
+	Holder string `json:"holder"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListOrders(ctx context.Context, arg ListOrdersParams) ([]Order, error) {
+	rows, err := q.db.Query(ctx, listOrders, arg.Holder, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Order{}
+	for rows.Next() {
+		var i Order
+		if err := rows.Scan(
+			&i.ID,
+			&i.Holder,
+			&i.BaseTraderID,
+			&i.QuoteTraderID,
+			&i.BaseSymbol,
+			&i.QuoteSymbol,
+			&i.Side,
+			&i.Kind,
+			&i.Price,
+			&i.Quantity,
+			&i.Filled,
+			&i.Status,
+			&i.CreatedTime,
+			&i.HoldID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateOrderStatus = `-- name: UpdateOrderStatus :one
+UPDATE orders
+SET status = $2
+WHERE id = $1
+RETURNING id, holder, base_trader_id, quote_trader_id, base_symbol, quote_symbol, side, kind, price, quantity, filled, status, created_time, hold_id
+`
+
+type UpdateOrderStatusParams struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+func (q *Queries) UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusParams) (Order, error) {
+	row := q.db.QueryRow(ctx, updateOrderStatus, arg.ID, arg.Status)
+	var i Order
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.BaseTraderID,
+		&i.QuoteTraderID,
+		&i.BaseSymbol,
+		&i.QuoteSymbol,
+		&i.Side,
+		&i.Kind,
+		&i.Price,
+		&i.Quantity,
+		&i.Filled,
+		&i.Status,
+		&i.CreatedTime,
+		&i.HoldID,
+	)
+	return i, err
+}
@@ -0,0 +1,181 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+// btcUnit is one whole BTC in minor units. Order prices are per whole base unit,
+// so a BTC/ETH price of 3*btcUnit costs 3 ETH minor units per BTC minor unit.
+const btcUnit = 100_000_000
+
+func createRandomTraderPair(t *testing.T) (baseTrader Trader, quoteTrader Trader) {
+	member := createRandomMember(t)
+
+	baseTrader, err := testStore.CreateTrader(context.Background(), CreateTraderParams{
+		Holder: member.Membername,
+		Rest:   util.RandomInt(1000, 5000),
+		Symbol: util.BTC,
+	})
+	require.NoError(t, err)
+
+	quoteTrader, err = testStore.CreateTrader(context.Background(), CreateTraderParams{
+		Holder: member.Membername,
+		Rest:   util.RandomInt(1000, 5000),
+		Symbol: util.ETH,
+	})
+	require.NoError(t, err)
+
+	return baseTrader, quoteTrader
+}
+
+func createRandomOrder(t *testing.T, side string, price int64, quantity int64) Order {
+	baseTrader, quoteTrader := createRandomTraderPair(t)
+
+	arg := CreateOrderParams{
+		Holder:        baseTrader.Holder,
+		BaseTraderID:  baseTrader.ID,
+		QuoteTraderID: quoteTrader.ID,
+		BaseSymbol:    util.BTC,
+		QuoteSymbol:   util.ETH,
+		Side:          side,
+		Kind:          util.LimitKind,
+		Price:         price,
+		Quantity:      quantity,
+	}
+
+	order, err := testStore.CreateOrder(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, order)
+
+	require.Equal(t, arg.Holder, order.Holder)
+	require.Equal(t, arg.BaseTraderID, order.BaseTraderID)
+	require.Equal(t, arg.QuoteTraderID, order.QuoteTraderID)
+	require.Equal(t, arg.Side, order.Side)
+	require.Equal(t, arg.Kind, order.Kind)
+	require.Equal(t, arg.Price, order.Price)
+	require.Equal(t, arg.Quantity, order.Quantity)
+	require.Zero(t, order.Filled)
+	require.Equal(t, util.OpenOrderStatus, order.Status)
+
+	require.NotZero(t, order.ID)
+	require.NotZero(t, order.CreatedTime)
+
+	return order
+}
+
+func TestCreateOrder(t *testing.T) {
+	createRandomOrder(t, util.BuySide, 10, 5)
+}
+
+func TestGetOrder(t *testing.T) {
+	order1 := createRandomOrder(t, util.SellSide, 10, 5)
+
+	order2, err := testStore.GetOrder(context.Background(), order1.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, order2)
+
+	require.Equal(t, order1.ID, order2.ID)
+	require.Equal(t, order1.Holder, order2.Holder)
+	require.Equal(t, order1.Side, order2.Side)
+	require.Equal(t, order1.Price, order2.Price)
+	require.Equal(t, order1.Quantity, order2.Quantity)
+	require.WithinDuration(t, order1.CreatedTime, order2.CreatedTime, time.Second)
+}
+
+func TestAddOrderFilled(t *testing.T) {
+	order1 := createRandomOrder(t, util.BuySide, 10, 5)
+
+	order2, err := testStore.AddOrderFilled(context.Background(), AddOrderFilledParams{
+		ID:       order1.ID,
+		Quantity: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), order2.Filled)
+	require.Equal(t, util.OpenOrderStatus, order2.Status)
+
+	order3, err := testStore.AddOrderFilled(context.Background(), AddOrderFilledParams{
+		ID:       order1.ID,
+		Quantity: 3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, order1.Quantity, order3.Filled)
+	require.Equal(t, util.FilledOrderStatus, order3.Status)
+}
+
+func TestListOpenOrders(t *testing.T) {
+	order1 := createRandomOrder(t, util.BuySide, 10, 5)
+	order2 := createRandomOrder(t, util.SellSide, 12, 5)
+
+	_, err := testStore.UpdateOrderStatus(context.Background(), UpdateOrderStatusParams{
+		ID:     order2.ID,
+		Status: util.CancelledOrderStatus,
+	})
+	require.NoError(t, err)
+
+	orders, err := testStore.ListOpenOrders(context.Background())
+	require.NoError(t, err)
+
+	ids := make(map[int64]bool)
+	for _, order := range orders {
+		require.Equal(t, util.OpenOrderStatus, order.Status)
+		ids[order.ID] = true
+	}
+	require.True(t, ids[order1.ID])
+	require.False(t, ids[order2.ID])
+}
+
+func TestCreateOrderTx(t *testing.T) {
+	baseTrader, quoteTrader := createRandomTraderPair(t)
+
+	arg := CreateOrderTxParams{
+		CreateOrderParams: CreateOrderParams{
+			Holder:        baseTrader.Holder,
+			BaseTraderID:  baseTrader.ID,
+			QuoteTraderID: quoteTrader.ID,
+			BaseSymbol:    util.BTC,
+			QuoteSymbol:   util.ETH,
+			Side:          util.BuySide,
+			Kind:          util.LimitKind,
+			Price:         2 * btcUnit,
+			Quantity:      10,
+		},
+	}
+
+	order, err := testStore.CreateOrderTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, order.HoldID.Valid)
+
+	hold, err := testStore.GetHold(context.Background(), order.HoldID.Int64)
+	require.NoError(t, err)
+	require.Equal(t, quoteTrader.ID, hold.TraderID)
+	require.Equal(t, int64(20), hold.Remaining)
+
+	cancelled, err := testStore.CancelOrderTx(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.CancelledOrderStatus, cancelled.Status)
+
+	hold, err = testStore.GetHold(context.Background(), order.HoldID.Int64)
+	require.NoError(t, err)
+	require.Equal(t, util.ReleasedHoldStatus, hold.Status)
+
+	updatedQuote, err := testStore.GetTrader(context.Background(), quoteTrader.ID)
+	require.NoError(t, err)
+	require.Zero(t, updatedQuote.Held)
+
+	_, err = testStore.CancelOrderTx(context.Background(), order.ID)
+	require.ErrorIs(t, err, ErrOrderNotOpen)
+
+	arg.Quantity = quoteTrader.Rest
+	_, err = testStore.CreateOrderTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// 10 satoshi at 1 ETH minor unit per whole BTC is worth nothing
+	arg.Price, arg.Quantity = 1, 10
+	_, err = testStore.CreateOrderTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrOrderTooSmall)
+}
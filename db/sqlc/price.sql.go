@@ -0,0 +1,110 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: price.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const createPrice = `-- name: CreatePrice :one
+INSERT INTO prices (
+  base,
+  quote,
+  rate,
+  source,
+  price_time
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (base, quote, price_time) DO UPDATE
+SET rate = EXCLUDED.rate, source = EXCLUDED.source
+RETURNING id, base, quote, rate, source, price_time, created_time
+`
+
+type CreatePriceParams struct {
+	Base      string    `json:"base"`
+	Quote     string    `json:"quote"`
+	Rate      int64     `json:"rate"`
+	Source    string    `json:"source"`
+	PriceTime time.Time `json:"price_time"`
+}
+
+func (q *Queries) CreatePrice(ctx context.Context, arg CreatePriceParams) (Price, error) {
+	row := q.db.QueryRow(ctx, createPrice,
+		arg.Base,
+		arg.Quote,
+		arg.Rate,
+		arg.Source,
+		arg.PriceTime,
+	)
+	var i Price
+	err := row.Scan(
+		&i.ID,
+		&i.Base,
+		&i.Quote,
+		&i.Rate,
+		&i.Source,
+		&i.PriceTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getLatestPrice = `-- name: GetLatestPrice :one
+SELECT id, base, quote, rate, source, price_time, created_time FROM prices
+WHERE base = $1 AND quote = $2
+ORDER BY price_time DESC
+LIMIT 1
+`
+
+type GetLatestPriceParams struct {
+	Base  string `json:"base"`
+	Quote string `json:"quote"`
+}
+
+func (q *Queries) GetLatestPrice(ctx context.Context, arg GetLatestPriceParams) (Price, error) {
+	row := q.db.QueryRow(ctx, getLatestPrice, arg.Base, arg.Quote)
+	var i Price
+	err := row.Scan(
+		&i.ID,
+		&i.Base,
+		&i.Quote,
+		&i.Rate,
+		&i.Source,
+		&i.PriceTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getPriceAt = `-- name: GetPriceAt :one
+SELECT id, base, quote, rate, source, price_time, created_time FROM prices
+WHERE base = $1 AND quote = $2 AND price_time <= $3
+ORDER BY price_time DESC
+LIMIT 1
+`
+
+type GetPriceAtParams struct {
+	Base   string    `json:"base"`
+	Quote  string    `json:"quote"`
+	AtTime time.Time `json:"at_time"`
+}
+
+func (q *Queries) GetPriceAt(ctx context.Context, arg GetPriceAtParams) (Price, error) {
+	row := q.db.QueryRow(ctx, getPriceAt, arg.Base, arg.Quote, arg.AtTime)
+	var i Price
+	err := row.Scan(
+		&i.ID,
+		&i.Base,
+		&i.Quote,
+		&i.Rate,
+		&i.Source,
+		&i.PriceTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func TestPriceHistory(t *testing.T) {
+	// a fresh base keeps prices of other tests out of the way
+	base := createRandomSymbol(t).Symbol.Code
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, rate := range []int64{185000000000, 190050000000} {
+		price, err := testStore.CreatePrice(context.Background(), CreatePriceParams{
+			Base:      base,
+			Quote:     util.ETH,
+			Rate:      rate,
+			Source:    "test",
+			PriceTime: day.AddDate(0, 0, i),
+		})
+		require.NoError(t, err)
+		require.Equal(t, rate, price.Rate)
+	}
+
+	latest, err := testStore.GetLatestPrice(context.Background(), GetLatestPriceParams{
+		Base:  base,
+		Quote: util.ETH,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(190050000000), latest.Rate)
+
+	earlier, err := testStore.GetPriceAt(context.Background(), GetPriceAtParams{
+		Base:   base,
+		Quote:  util.ETH,
+		AtTime: day.Add(12 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(185000000000), earlier.Rate)
+
+	_, err = testStore.GetPriceAt(context.Background(), GetPriceAtParams{
+		Base:   base,
+		Quote:  util.ETH,
+		AtTime: day.Add(-time.Second),
+	})
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	// a feed correcting a price overwrites it
+	corrected, err := testStore.CreatePrice(context.Background(), CreatePriceParams{
+		Base:      base,
+		Quote:     util.ETH,
+		Rate:      185100000000,
+		Source:    "test",
+		PriceTime: day,
+	})
+	require.NoError(t, err)
+	require.Equal(t, earlier.ID, corrected.ID)
+	require.Equal(t, int64(185100000000), corrected.Rate)
+}
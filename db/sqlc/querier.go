@@ -8,29 +8,136 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	AddOrderFilled(ctx context.Context, arg AddOrderFilledParams) (Order, error)
+	AddTraderHeld(ctx context.Context, arg AddTraderHeldParams) (Trader, error)
 	AddTraderRest(ctx context.Context, arg AddTraderRestParams) (Trader, error)
+	ClaimScheduledTransfer(ctx context.Context, arg ClaimScheduledTransferParams) (ScheduledTransfer, error)
+	CountPendingTransferReviewsByTrader(ctx context.Context, fromTraderID int64) (int64, error)
+	CountTraders(ctx context.Context) (int64, error)
+	CountTransfersSince(ctx context.Context, arg CountTransfersSinceParams) (int64, error)
+	CountUnsettledEscrowsByTrader(ctx context.Context, buyerTraderID int64) (int64, error)
+	CreateBalanceSnapshots(ctx context.Context, arg CreateBalanceSnapshotsParams) ([]BalanceSnapshot, error)
+	CreateDeposit(ctx context.Context, arg CreateDepositParams) (Deposit, error)
 	CreateDetail(ctx context.Context, arg CreateDetailParams) (Detail, error)
+	CreateEscrow(ctx context.Context, arg CreateEscrowParams) (Escrow, error)
+	CreateExchange(ctx context.Context, arg CreateExchangeParams) (Exchange, error)
+	CreateFeeTier(ctx context.Context, arg CreateFeeTierParams) (FeeTier, error)
+	CreateFill(ctx context.Context, arg CreateFillParams) (Fill, error)
+	CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error)
+	CreateJournalEntry(ctx context.Context, arg CreateJournalEntryParams) (JournalEntry, error)
+	CreateKYCSubmission(ctx context.Context, arg CreateKYCSubmissionParams) (KycSubmission, error)
 	CreateMember(ctx context.Context, arg CreateMemberParams) (Member, error)
+	CreateOrder(ctx context.Context, arg CreateOrderParams) (Order, error)
+	CreatePosting(ctx context.Context, arg CreatePostingParams) (Posting, error)
+	CreatePrice(ctx context.Context, arg CreatePriceParams) (Price, error)
 	CreateRecord(ctx context.Context, arg CreateRecordParams) (Record, error)
+	CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error)
+	CreateScheduledTransferRun(ctx context.Context, arg CreateScheduledTransferRunParams) (ScheduledTransferRun, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
+	CreateSymbol(ctx context.Context, arg CreateSymbolParams) (Symbol, error)
 	CreateTrader(ctx context.Context, arg CreateTraderParams) (Trader, error)
+	CreateTraderStatusChange(ctx context.Context, arg CreateTraderStatusChangeParams) (TraderStatusChange, error)
+	CreateTransferReview(ctx context.Context, arg CreateTransferReviewParams) (TransferReview, error)
 	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
-	DeleteTrader(ctx context.Context, id int64) error
+	CreateWithdrawal(ctx context.Context, arg CreateWithdrawalParams) (Withdrawal, error)
+	DeleteFeeRule(ctx context.Context, id int64) error
+	DeleteFeeTiers(ctx context.Context, ruleID int64) error
+	DeleteScheduledTransfer(ctx context.Context, id int64) error
+	DeleteTransferLimit(ctx context.Context, id int64) error
+	GetApplicableFeeRule(ctx context.Context, arg GetApplicableFeeRuleParams) (FeeRule, error)
+	GetApplicableTransferLimit(ctx context.Context, arg GetApplicableTransferLimitParams) (TransferLimit, error)
+	// the balance of a trader right before at_time, replayed from its latest snapshot
+	GetBalanceAt(ctx context.Context, arg GetBalanceAtParams) (int64, error)
+	GetDeposit(ctx context.Context, id int64) (Deposit, error)
 	GetDetail(ctx context.Context, id int64) (Detail, error)
+	GetEscrow(ctx context.Context, id int64) (Escrow, error)
+	GetEscrowForUpdate(ctx context.Context, id int64) (Escrow, error)
+	GetExchange(ctx context.Context, id int64) (Exchange, error)
+	GetFeeRule(ctx context.Context, id int64) (FeeRule, error)
+	GetFill(ctx context.Context, id int64) (Fill, error)
+	GetHold(ctx context.Context, id int64) (Hold, error)
+	GetHoldForUpdate(ctx context.Context, id int64) (Hold, error)
+	GetJournalEntry(ctx context.Context, id int64) (JournalEntry, error)
+	GetKYCSubmission(ctx context.Context, id int64) (KycSubmission, error)
+	GetKYCSubmissionForUpdate(ctx context.Context, id int64) (KycSubmission, error)
+	GetLatestPrice(ctx context.Context, arg GetLatestPriceParams) (Price, error)
 	GetMember(ctx context.Context, membername string) (Member, error)
+	GetMonthlyVolume(ctx context.Context, arg GetMonthlyVolumeParams) (int64, error)
+	GetOrder(ctx context.Context, id int64) (Order, error)
+	GetOrderByHoldForUpdate(ctx context.Context, holdID pgtype.Int8) (Order, error)
+	GetOrderForUpdate(ctx context.Context, id int64) (Order, error)
+	GetOutgoingTotals(ctx context.Context, arg GetOutgoingTotalsParams) (GetOutgoingTotalsRow, error)
+	GetPriceAt(ctx context.Context, arg GetPriceAtParams) (Price, error)
 	GetRecord(ctx context.Context, id int64) (Record, error)
+	GetRecordByIdempotencyKey(ctx context.Context, arg GetRecordByIdempotencyKeyParams) (Record, error)
+	GetRecordForUpdate(ctx context.Context, id int64) (Record, error)
+	GetReversedNumber(ctx context.Context, recordID int64) (int64, error)
+	GetScheduledTransfer(ctx context.Context, id int64) (ScheduledTransfer, error)
+	GetScheduledTransferForUpdate(ctx context.Context, id int64) (ScheduledTransfer, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
+	GetSymbol(ctx context.Context, code string) (Symbol, error)
 	GetTrader(ctx context.Context, id int64) (Trader, error)
+	GetTraderByHolderSymbol(ctx context.Context, arg GetTraderByHolderSymbolParams) (Trader, error)
 	GetTraderForUpdate(ctx context.Context, id int64) (Trader, error)
+	GetTransferReview(ctx context.Context, id int64) (TransferReview, error)
+	GetTransferReviewByIdempotencyKey(ctx context.Context, arg GetTransferReviewByIdempotencyKeyParams) (TransferReview, error)
+	GetTransferReviewForUpdate(ctx context.Context, id int64) (TransferReview, error)
+	GetWithdrawal(ctx context.Context, id int64) (Withdrawal, error)
+	GetWithdrawalByHoldForUpdate(ctx context.Context, holdID pgtype.Int8) (Withdrawal, error)
+	GetWithdrawalForUpdate(ctx context.Context, id int64) (Withdrawal, error)
+	HasPaid(ctx context.Context, arg HasPaidParams) (bool, error)
+	ListDeposits(ctx context.Context, arg ListDepositsParams) ([]Deposit, error)
 	ListDetails(ctx context.Context, arg ListDetailsParams) ([]Detail, error)
+	ListDetailsByRecord(ctx context.Context, recordID int64) ([]Detail, error)
+	ListDetailsInPeriod(ctx context.Context, arg ListDetailsInPeriodParams) ([]Detail, error)
+	ListDueScheduledTransfers(ctx context.Context, limit int32) ([]ScheduledTransfer, error)
+	ListEscrows(ctx context.Context, arg ListEscrowsParams) ([]Escrow, error)
+	ListExchanges(ctx context.Context, arg ListExchangesParams) ([]Exchange, error)
+	ListExpiredEscrows(ctx context.Context, limit int32) ([]Escrow, error)
+	ListExpiredHolds(ctx context.Context, limit int32) ([]Hold, error)
+	ListFeeRules(ctx context.Context, arg ListFeeRulesParams) ([]FeeRule, error)
+	ListFeeTiers(ctx context.Context, ruleID int64) ([]FeeTier, error)
+	ListFillsByOrder(ctx context.Context, orderID int64) ([]Fill, error)
+	ListHolds(ctx context.Context, arg ListHoldsParams) ([]Hold, error)
+	ListKYCSubmissions(ctx context.Context, arg ListKYCSubmissionsParams) ([]KycSubmission, error)
+	ListOpenOrders(ctx context.Context) ([]Order, error)
+	ListOrders(ctx context.Context, arg ListOrdersParams) ([]Order, error)
+	ListPostingsByEntry(ctx context.Context, entryID int64) ([]Posting, error)
 	ListRecords(ctx context.Context, arg ListRecordsParams) ([]Record, error)
+	ListScheduledTransferRuns(ctx context.Context, arg ListScheduledTransferRunsParams) ([]ScheduledTransferRun, error)
+	ListScheduledTransfers(ctx context.Context, arg ListScheduledTransfersParams) ([]ScheduledTransfer, error)
+	ListSymbols(ctx context.Context) ([]Symbol, error)
+	ListTraderDrifts(ctx context.Context) ([]ListTraderDriftsRow, error)
+	ListTraderStatusChanges(ctx context.Context, traderID int64) ([]TraderStatusChange, error)
 	ListTraders(ctx context.Context, arg ListTradersParams) ([]Trader, error)
+	// the running balance is worked out for the rows of the page only, replayed from
+	// the latest snapshot up to the last detail of the record in (created_time, id) order
+	ListTransactions(ctx context.Context, arg ListTransactionsParams) ([]ListTransactionsRow, error)
+	ListTransferLimits(ctx context.Context, arg ListTransferLimitsParams) ([]TransferLimit, error)
+	ListTransferReviews(ctx context.Context, arg ListTransferReviewsParams) ([]TransferReview, error)
+	ListUnbalancedEntries(ctx context.Context) ([]ListUnbalancedEntriesRow, error)
+	ListUnpairedRecords(ctx context.Context) ([]ListUnpairedRecordsRow, error)
+	ListWithdrawals(ctx context.Context, arg ListWithdrawalsParams) ([]Withdrawal, error)
+	ReviewKYCSubmission(ctx context.Context, arg ReviewKYCSubmissionParams) (KycSubmission, error)
+	ReviewWithdrawal(ctx context.Context, arg ReviewWithdrawalParams) (Withdrawal, error)
+	SettleTransferReview(ctx context.Context, arg SettleTransferReviewParams) (TransferReview, error)
+	UpdateEscrow(ctx context.Context, arg UpdateEscrowParams) (Escrow, error)
+	UpdateHold(ctx context.Context, arg UpdateHoldParams) (Hold, error)
 	UpdateMember(ctx context.Context, arg UpdateMemberParams) (Member, error)
+	UpdateMemberKYCLevel(ctx context.Context, arg UpdateMemberKYCLevelParams) (Member, error)
+	UpdateOrderStatus(ctx context.Context, arg UpdateOrderStatusParams) (Order, error)
+	UpdateScheduledTransfer(ctx context.Context, arg UpdateScheduledTransferParams) (ScheduledTransfer, error)
+	UpdateSymbol(ctx context.Context, arg UpdateSymbolParams) (Symbol, error)
 	UpdateTrader(ctx context.Context, arg UpdateTraderParams) (Trader, error)
+	UpdateTraderOverdraftLimit(ctx context.Context, arg UpdateTraderOverdraftLimitParams) (Trader, error)
+	UpdateTraderStatus(ctx context.Context, arg UpdateTraderStatusParams) (Trader, error)
 	UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error)
+	UpsertFeeRule(ctx context.Context, arg UpsertFeeRuleParams) (FeeRule, error)
+	UpsertTransferLimit(ctx context.Context, arg UpsertTransferLimitParams) (TransferLimit, error)
 }
 
 var _ Querier = (*Queries)(nil)
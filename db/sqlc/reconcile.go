@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type SymbolDrift struct {
+	Symbol  string `json:"symbol"`
+	Traders int64  `json:"traders"`
+	Drift   int64  `json:"drift"`
+}
+
+// ReconcileReport lists every ledger invariant that does not hold. Details written
+// before they carried a record_id were paired with their records when the column
+// was added, so only records that really lack a detail show up in UnpairedRecords.
+type ReconcileReport struct {
+	CheckedTraders    int64                      `json:"checked_traders"`
+	TraderDrifts      []ListTraderDriftsRow      `json:"trader_drifts"`
+	SymbolDrifts      []SymbolDrift              `json:"symbol_drifts"`
+	UnpairedRecords   []ListUnpairedRecordsRow   `json:"unpaired_records"`
+	UnbalancedEntries []ListUnbalancedEntriesRow `json:"unbalanced_entries"`
+}
+
+func (report ReconcileReport) OK() bool {
+	return len(report.TraderDrifts) == 0 &&
+		len(report.UnpairedRecords) == 0 &&
+		len(report.UnbalancedEntries) == 0
+}
+
+// Reconcile checks that the rest of every trader equals the sum of its details,
+// that every record has its two matching details, and that every journal entry
+// balances per symbol. All checks read the same snapshot of the database.
+func (store *SQLStore) Reconcile(ctx context.Context) (ReconcileReport, error) {
+	var report ReconcileReport
+
+	tx, err := store.connPool.BeginTx(ctx, pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return report, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := New(tx)
+
+	report.CheckedTraders, err = q.CountTraders(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	report.TraderDrifts, err = q.ListTraderDrifts(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	report.UnpairedRecords, err = q.ListUnpairedRecords(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	report.UnbalancedEntries, err = q.ListUnbalancedEntries(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	report.SymbolDrifts = symbolDrifts(report.TraderDrifts)
+	return report, nil
+}
+
+func symbolDrifts(traderDrifts []ListTraderDriftsRow) []SymbolDrift {
+	drifts := []SymbolDrift{}
+	index := make(map[string]int)
+
+	for _, traderDrift := range traderDrifts {
+		i, ok := index[traderDrift.Symbol]
+		if !ok {
+			i = len(drifts)
+			index[traderDrift.Symbol] = i
+			drifts = append(drifts, SymbolDrift{Symbol: traderDrift.Symbol})
+		}
+		drifts[i].Traders++
+		drifts[i].Drift += traderDrift.Drift
+	}
+	return drifts
+}
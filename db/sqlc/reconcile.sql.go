@@ -0,0 +1,160 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: reconcile.sql
+
+package db
+
+import (
+	"context"
+)
+
+const countTraders = `-- name: CountTraders :one
+SELECT COUNT(*) FROM traders
+`
+
+func (q *Queries) CountTraders(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countTraders)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const listTraderDrifts = `-- name: ListTraderDrifts :many
+SELECT
+  t.id,
+  t.holder,
+  t.symbol,
+  t.rest,
+  COALESCE(SUM(d.number), 0)::bigint AS details_sum,
+  (t.rest - COALESCE(SUM(d.number), 0))::bigint AS drift
+FROM traders t
+LEFT JOIN details d ON d.trader_id = t.id
+GROUP BY t.id
+HAVING t.rest <> COALESCE(SUM(d.number), 0)
+ORDER BY t.id
+`
+
+type ListTraderDriftsRow struct {
+	ID         int64  `json:"id"`
+	Holder     string `json:"holder"`
+	Symbol     string `json:"symbol"`
+	Rest       int64  `json:"rest"`
+	DetailsSum int64  `json:"details_sum"`
+	Drift      int64  `json:"drift"`
+}
+
+func (q *Queries) ListTraderDrifts(ctx context.Context) ([]ListTraderDriftsRow, error) {
+	rows, err := q.db.Query(ctx, listTraderDrifts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTraderDriftsRow{}
+	for rows.Next() {
+		var i ListTraderDriftsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Holder,
+			&i.Symbol,
+			&i.Rest,
+			&i.DetailsSum,
+			&i.Drift,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnbalancedEntries = `-- name: ListUnbalancedEntries :many
+SELECT
+  entry_id,
+  symbol,
+  SUM(number)::bigint AS total
+FROM postings
+GROUP BY entry_id, symbol
+HAVING SUM(number) <> 0
+ORDER BY entry_id, symbol
+`
+
+type ListUnbalancedEntriesRow struct {
+	EntryID int64  `json:"entry_id"`
+	Symbol  string `json:"symbol"`
+	Total   int64  `json:"total"`
+}
+
+func (q *Queries) ListUnbalancedEntries(ctx context.Context) ([]ListUnbalancedEntriesRow, error) {
+	rows, err := q.db.Query(ctx, listUnbalancedEntries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUnbalancedEntriesRow{}
+	for rows.Next() {
+		var i ListUnbalancedEntriesRow
+		if err := rows.Scan(&i.EntryID, &i.Symbol, &i.Total); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnpairedRecords = `-- name: ListUnpairedRecords :many
+SELECT
+  r.id,
+  r.from_trader_id,
+  r.to_trader_id,
+  r.number,
+  COUNT(d.id) AS detail_count
+FROM records r
+LEFT JOIN details d ON d.record_id = r.id
+GROUP BY r.id
+HAVING COUNT(d.id) <> CASE WHEN r.fee > 0 THEN 4 ELSE 2 END
+  OR COUNT(*) FILTER (WHERE d.trader_id = r.from_trader_id AND d.number = -r.number) < 1
+  OR COUNT(*) FILTER (WHERE d.trader_id = r.to_trader_id AND d.number = r.number) < 1
+  OR (r.fee > 0 AND COUNT(*) FILTER (WHERE d.trader_id = r.from_trader_id AND d.number = -r.fee) < 1)
+ORDER BY r.id
+`
+
+type ListUnpairedRecordsRow struct {
+	ID           int64 `json:"id"`
+	FromTraderID int64 `json:"from_trader_id"`
+	ToTraderID   int64 `json:"to_trader_id"`
+	Number       int64 `json:"number"`
+	DetailCount  int64 `json:"detail_count"`
+}
+
+func (q *Queries) ListUnpairedRecords(ctx context.Context) ([]ListUnpairedRecordsRow, error) {
+	rows, err := q.db.Query(ctx, listUnpairedRecords)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUnpairedRecordsRow{}
+	for rows.Next() {
+		var i ListUnpairedRecordsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromTraderID,
+			&i.ToTraderID,
+			&i.Number,
+			&i.DetailCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func TestReconcile(t *testing.T) {
+	member := createRandomMember(t)
+
+	balanced, err := testStore.CreateTrader(context.Background(), CreateTraderParams{
+		Holder: member.Membername,
+		Rest:   0,
+		Symbol: util.ETH,
+	})
+	require.NoError(t, err)
+
+	system, err := testStore.GetTraderByHolderSymbol(context.Background(), GetTraderByHolderSymbolParams{
+		Holder: util.SystemMembername,
+		Symbol: util.ETH,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.PostJournalEntry(context.Background(), PostJournalEntryParams{
+		Kind: util.TransferEntry,
+		Postings: []PostingParams{
+			{TraderID: system.ID, Number: -500},
+			{TraderID: balanced.ID, Number: 500},
+		},
+	})
+	require.NoError(t, err)
+
+	drifted := createRandomTrader(t)
+
+	report, err := testStore.Reconcile(context.Background())
+	require.NoError(t, err)
+	require.False(t, report.OK())
+	require.NotZero(t, report.CheckedTraders)
+
+	drifts := make(map[int64]ListTraderDriftsRow)
+	for _, drift := range report.TraderDrifts {
+		drifts[drift.ID] = drift
+	}
+
+	require.NotContains(t, drifts, balanced.ID)
+	require.NotContains(t, drifts, system.ID)
+	require.Contains(t, drifts, drifted.ID)
+	require.Equal(t, drifted.Rest, drifts[drifted.ID].Drift)
+	require.Zero(t, drifts[drifted.ID].DetailsSum)
+	require.Empty(t, report.UnbalancedEntries)
+
+	var symbolTraders int64
+	for _, drift := range report.SymbolDrifts {
+		if drift.Symbol == drifted.Symbol {
+			symbolTraders = drift.Traders
+		}
+	}
+	require.NotZero(t, symbolTraders)
+}
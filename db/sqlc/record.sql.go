@@ -7,26 +7,47 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createRecord = `-- name: CreateRecord :one
 INSERT INTO records (
   from_trader_id,
   to_trader_id,
-  number
+  number,
+  membername,
+  idempotency_key,
+  reversal_of,
+  fee,
+  fee_rule_id
 ) VALUES (
-  $1, $2, $3
-) RETURNING id, from_trader_id, to_trader_id, number, created_time
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING id, from_trader_id, to_trader_id, number, created_time, membername, idempotency_key, reversal_of, fee, fee_rule_id
 `
 
 type CreateRecordParams struct {
-	FromTraderID int64 `json:"from_trader_id"`
-	ToTraderID   int64 `json:"to_trader_id"`
-	Number       int64 `json:"number"`
+	FromTraderID   int64       `json:"from_trader_id"`
+	ToTraderID     int64       `json:"to_trader_id"`
+	Number         int64       `json:"number"`
+	Membername     pgtype.Text `json:"membername"`
+	IdempotencyKey pgtype.Text `json:"idempotency_key"`
+	ReversalOf     pgtype.Int8 `json:"reversal_of"`
+	Fee            int64       `json:"fee"`
+	FeeRuleID      pgtype.Int8 `json:"fee_rule_id"`
 }
 
 func (q *Queries) CreateRecord(ctx context.Context, arg CreateRecordParams) (Record, error) {
-	row := q.db.QueryRow(ctx, createRecord, arg.FromTraderID, arg.ToTraderID, arg.Number)
+	row := q.db.QueryRow(ctx, createRecord,
+		arg.FromTraderID,
+		arg.ToTraderID,
+		arg.Number,
+		arg.Membername,
+		arg.IdempotencyKey,
+		arg.ReversalOf,
+		arg.Fee,
+		arg.FeeRuleID,
+	)
 	var i Record
 	err := row.Scan(
 		&i.ID,
@@ -34,12 +55,17 @@ func (q *Queries) CreateRecord(ctx context.Context, arg CreateRecordParams) (Rec
 		&i.ToTraderID,
 		&i.Number,
 		&i.CreatedTime,
+		&i.Membername,
+		&i.IdempotencyKey,
+		&i.ReversalOf,
+		&i.Fee,
+		&i.FeeRuleID,
 	)
 	return i, err
 }
 
 const getRecord = `-- name: GetRecord :one
-SELECT id, from_trader_id, to_trader_id, number, created_time FROM records
+SELECT id, from_trader_id, to_trader_id, number, created_time, membername, idempotency_key, reversal_of, fee, fee_rule_id FROM records
 WHERE id = $1 LIMIT 1
 `
 
@@ -52,34 +78,98 @@ func (q *Queries) GetRecord(ctx context.Context, id int64) (Record, error) {
 		&i.ToTraderID,
 		&i.Number,
 		&i.CreatedTime,
+		&i.Membername,
+		&i.IdempotencyKey,
+		&i.ReversalOf,
+		&i.Fee,
+		&i.FeeRuleID,
+	)
+	return i, err
+}
+
+const getRecordByIdempotencyKey = `-- name: GetRecordByIdempotencyKey :one
+SELECT id, from_trader_id, to_trader_id, number, created_time, membername, idempotency_key, reversal_of, fee, fee_rule_id FROM records
+WHERE membername = $1 AND idempotency_key = $2
+LIMIT 1
+`
+
+type GetRecordByIdempotencyKeyParams struct {
+	Membername     pgtype.Text `json:"membername"`
+	IdempotencyKey pgtype.Text `json:"idempotency_key"`
+}
+
+func (q *Queries) GetRecordByIdempotencyKey(ctx context.Context, arg GetRecordByIdempotencyKeyParams) (Record, error) {
+	row := q.db.QueryRow(ctx, getRecordByIdempotencyKey, arg.Membername, arg.IdempotencyKey)
+	var i Record
+	err := row.Scan(
+		&i.ID,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.Number,
+		&i.CreatedTime,
+		&i.Membername,
+		&i.IdempotencyKey,
+		&i.ReversalOf,
+		&i.Fee,
+		&i.FeeRuleID,
+	)
+	return i, err
+}
+
+const getRecordForUpdate = `-- name: GetRecordForUpdate :one
+SELECT id, from_trader_id, to_trader_id, number, created_time, membername, idempotency_key, reversal_of, fee, fee_rule_id FROM records
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetRecordForUpdate(ctx context.Context, id int64) (Record, error) {
+	row := q.db.QueryRow(ctx, getRecordForUpdate, id)
+	var i Record
+	err := row.Scan(
+		&i.ID,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.Number,
+		&i.CreatedTime,
+		&i.Membername,
+		&i.IdempotencyKey,
+		&i.ReversalOf,
+		&i.Fee,
+		&i.FeeRuleID,
 	)
 	return i, err
 }
 
+const getReversedNumber = `-- name: GetReversedNumber :one
+SELECT COALESCE(SUM(number), 0)::bigint AS reversed
+FROM records
+WHERE reversal_of = $1::bigint
+`
+
+func (q *Queries) GetReversedNumber(ctx context.Context, recordID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, getReversedNumber, recordID)
+	var reversed int64
+	err := row.Scan(&reversed)
+	return reversed, err
+}
+
 const listRecords = `-- name: ListRecords :many
-SELECT id, from_trader_id, to_trader_id, number, created_time FROM records
-WHERE 
-    from_trader_id = $1 OR
-    to_trader_id = $2
+SELECT id, from_trader_id, to_trader_id, number, created_time, membername, idempotency_key, reversal_of, fee, fee_rule_id FROM records
+WHERE
+    (from_trader_id = $1 OR to_trader_id = $1) AND
+    id > $2
 ORDER BY id
 LIMIT $3
-OFFSET $4
 `
 
 type ListRecordsParams struct {
-	FromTraderID int64 `json:"from_trader_id"`
-	ToTraderID   int64 `json:"to_trader_id"`
-	Limit        int32 `json:"limit"`
-	Offset       int32 `json:"offset"`
+	TraderID int64 `json:"trader_id"`
+	AfterID  int64 `json:"after_id"`
+	Limit    int32 `json:"limit"`
 }
 
 func (q *Queries) ListRecords(ctx context.Context, arg ListRecordsParams) ([]Record, error) {
-	rows, err := q.db.Query(ctx, listRecords,
-		arg.FromTraderID,
-		arg.ToTraderID,
-		arg.Limit,
-		arg.Offset,
-	)
+	rows, err := q.db.Query(ctx, listRecords, arg.TraderID, arg.AfterID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -93,6 +183,11 @@ func (q *Queries) ListRecords(ctx context.Context, arg ListRecordsParams) ([]Rec
 			&i.ToTraderID,
 			&i.Number,
 			&i.CreatedTime,
+			&i.Membername,
+			&i.IdempotencyKey,
+			&i.ReversalOf,
+			&i.Fee,
+			&i.FeeRuleID,
 		); err != nil {
 			return nil, err
 		}
@@ -62,17 +62,21 @@ func TestListRecord(t *testing.T) {
 	}
 
 	arg := ListRecordsParams{
-		FromTraderID: trader1.ID,
-		ToTraderID:   trader1.ID,
-		Limit:         2,
-		Offset:        2,
+		TraderID: trader1.ID,
+		Limit:    2,
 	}
 
 	records, err := testStore.ListRecords(context.Background(), arg)
 	require.NoError(t, err)
 	require.Len(t, records, 2)
 
-	for _, record := range records {
+	arg.AfterID = records[1].ID
+	nextRecords, err := testStore.ListRecords(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, nextRecords, 2)
+	require.Greater(t, nextRecords[0].ID, records[1].ID)
+
+	for _, record := range append(records, nextRecords...) {
 		require.NotEmpty(t, record)
 		require.True(t, record.FromTraderID == trader1.ID || record.ToTraderID == trader1.ID)
 	}
@@ -0,0 +1,379 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: scheduled_transfer.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const claimScheduledTransfer = `-- name: ClaimScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+  next_run_time = $1,
+  last_run_time = $2::timestamptz
+WHERE id = $3 AND active AND next_run_time = $2::timestamptz
+RETURNING id, holder, from_trader_id, to_trader_id, number, cronspec, on_insufficient_funds, active, next_run_time, last_run_time, created_time
+`
+
+type ClaimScheduledTransferParams struct {
+	NextRunTime   time.Time `json:"next_run_time"`
+	ScheduledTime time.Time `json:"scheduled_time"`
+	ID            int64     `json:"id"`
+}
+
+func (q *Queries) ClaimScheduledTransfer(ctx context.Context, arg ClaimScheduledTransferParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, claimScheduledTransfer, arg.NextRunTime, arg.ScheduledTime, arg.ID)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.Number,
+		&i.Cronspec,
+		&i.OnInsufficientFunds,
+		&i.Active,
+		&i.NextRunTime,
+		&i.LastRunTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const createScheduledTransfer = `-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers (
+  holder,
+  from_trader_id,
+  to_trader_id,
+  number,
+  cronspec,
+  on_insufficient_funds,
+  next_run_time
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING id, holder, from_trader_id, to_trader_id, number, cronspec, on_insufficient_funds, active, next_run_time, last_run_time, created_time
+`
+
+type CreateScheduledTransferParams struct {
+	Holder              string    `json:"holder"`
+	FromTraderID        int64     `json:"from_trader_id"`
+	ToTraderID          int64     `json:"to_trader_id"`
+	Number              int64     `json:"number"`
+	Cronspec            string    `json:"cronspec"`
+	OnInsufficientFunds string    `json:"on_insufficient_funds"`
+	NextRunTime         time.Time `json:"next_run_time"`
+}
+
+func (q *Queries) CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, createScheduledTransfer,
+		arg.Holder,
+		arg.FromTraderID,
+		arg.ToTraderID,
+		arg.Number,
+		arg.Cronspec,
+		arg.OnInsufficientFunds,
+		arg.NextRunTime,
+	)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.Number,
+		&i.Cronspec,
+		&i.OnInsufficientFunds,
+		&i.Active,
+		&i.NextRunTime,
+		&i.LastRunTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const createScheduledTransferRun = `-- name: CreateScheduledTransferRun :one
+INSERT INTO scheduled_transfer_runs (
+  schedule_id,
+  scheduled_time,
+  status,
+  record_id,
+  error,
+  attempts
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (schedule_id, scheduled_time) DO NOTHING
+RETURNING id, schedule_id, scheduled_time, status, record_id, error, attempts, created_time
+`
+
+type CreateScheduledTransferRunParams struct {
+	ScheduleID    int64       `json:"schedule_id"`
+	ScheduledTime time.Time   `json:"scheduled_time"`
+	Status        string      `json:"status"`
+	RecordID      pgtype.Int8 `json:"record_id"`
+	Error         string      `json:"error"`
+	Attempts      int32       `json:"attempts"`
+}
+
+func (q *Queries) CreateScheduledTransferRun(ctx context.Context, arg CreateScheduledTransferRunParams) (ScheduledTransferRun, error) {
+	row := q.db.QueryRow(ctx, createScheduledTransferRun,
+		arg.ScheduleID,
+		arg.ScheduledTime,
+		arg.Status,
+		arg.RecordID,
+		arg.Error,
+		arg.Attempts,
+	)
+	var i ScheduledTransferRun
+	err := row.Scan(
+		&i.ID,
+		&i.ScheduleID,
+		&i.ScheduledTime,
+		&i.Status,
+		&i.RecordID,
+		&i.Error,
+		&i.Attempts,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const deleteScheduledTransfer = `-- name: DeleteScheduledTransfer :exec
+DELETE FROM scheduled_transfers
+WHERE id = $1
+`
+
+func (q *Queries) DeleteScheduledTransfer(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteScheduledTransfer, id)
+	return err
+}
+
+const getScheduledTransfer = `-- name: GetScheduledTransfer :one
+SELECT id, holder, from_trader_id, to_trader_id, number, cronspec, on_insufficient_funds, active, next_run_time, last_run_time, created_time FROM scheduled_transfers
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetScheduledTransfer(ctx context.Context, id int64) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, getScheduledTransfer, id)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.Number,
+		&i.Cronspec,
+		&i.OnInsufficientFunds,
+		&i.Active,
+		&i.NextRunTime,
+		&i.LastRunTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getScheduledTransferForUpdate = `-- name: GetScheduledTransferForUpdate :one
+SELECT id, holder, from_trader_id, to_trader_id, number, cronspec, on_insufficient_funds, active, next_run_time, last_run_time, created_time FROM scheduled_transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetScheduledTransferForUpdate(ctx context.Context, id int64) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, getScheduledTransferForUpdate, id)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.Number,
+		&i.Cronspec,
+		&i.OnInsufficientFunds,
+		&i.Active,
+		&i.NextRunTime,
+		&i.LastRunTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const listDueScheduledTransfers = `-- name: ListDueScheduledTransfers :many
+SELECT id, holder, from_trader_id, to_trader_id, number, cronspec, on_insufficient_funds, active, next_run_time, last_run_time, created_time FROM scheduled_transfers
+WHERE active AND next_run_time <= now()
+ORDER BY next_run_time
+LIMIT $1
+`
+
+func (q *Queries) ListDueScheduledTransfers(ctx context.Context, limit int32) ([]ScheduledTransfer, error) {
+	rows, err := q.db.Query(ctx, listDueScheduledTransfers, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduledTransfer{}
+	for rows.Next() {
+		var i ScheduledTransfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.Holder,
+			&i.FromTraderID,
+			&i.ToTraderID,
+			&i.Number,
+			&i.Cronspec,
+			&i.OnInsufficientFunds,
+			&i.Active,
+			&i.NextRunTime,
+			&i.LastRunTime,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduledTransferRuns = `-- name: ListScheduledTransferRuns :many
+SELECT id, schedule_id, scheduled_time, status, record_id, error, attempts, created_time FROM scheduled_transfer_runs
+WHERE schedule_id = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3
+`
+
+type ListScheduledTransferRunsParams struct {
+	ScheduleID int64 `json:"schedule_id"`
+	Limit      int32 `json:"limit"`
+	Offset     int32 `json:"offset"`
+}
+
+func (q *Queries) ListScheduledTransferRuns(ctx context.Context, arg ListScheduledTransferRunsParams) ([]ScheduledTransferRun, error) {
+	rows, err := q.db.Query(ctx, listScheduledTransferRuns, arg.ScheduleID, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduledTransferRun{}
+	for rows.Next() {
+		var i ScheduledTransferRun
+		if err := rows.Scan(
+			&i.ID,
+			&i.ScheduleID,
+			&i.ScheduledTime,
+			&i.Status,
+			&i.RecordID,
+			&i.Error,
+			&i.Attempts,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listScheduledTransfers = `-- name: ListScheduledTransfers :many
+SELECT id, holder, from_trader_id, to_trader_id, number, cronspec, on_insufficient_funds, active, next_run_time, last_run_time, created_time FROM scheduled_transfers
+WHERE holder = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3
+`
+
+type ListScheduledTransfersParams struct {
+	Holder string `json:"holder"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListScheduledTransfers(ctx context.Context, arg ListScheduledTransfersParams) ([]ScheduledTransfer, error) {
+	rows, err := q.db.Query(ctx, listScheduledTransfers, arg.Holder, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ScheduledTransfer{}
+	for rows.Next() {
+		var i ScheduledTransfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.Holder,
+			&i.FromTraderID,
+			&i.ToTraderID,
+			&i.Number,
+			&i.Cronspec,
+			&i.OnInsufficientFunds,
+			&i.Active,
+			&i.NextRunTime,
+			&i.LastRunTime,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateScheduledTransfer = `-- name: UpdateScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+  number = COALESCE($1, number),
+  cronspec = COALESCE($2, cronspec),
+  on_insufficient_funds = COALESCE($3, on_insufficient_funds),
+  active = COALESCE($4, active),
+  next_run_time = COALESCE($5, next_run_time)
+WHERE id = $6
+RETURNING id, holder, from_trader_id, to_trader_id, number, cronspec, on_insufficient_funds, active, next_run_time, last_run_time, created_time
+`
+
+type UpdateScheduledTransferParams struct {
+	Number              pgtype.Int8        `json:"number"`
+	Cronspec            pgtype.Text        `json:"cronspec"`
+	OnInsufficientFunds pgtype.Text        `json:"on_insufficient_funds"`
+	Active              pgtype.Bool        `json:"active"`
+	NextRunTime         pgtype.Timestamptz `json:"next_run_time"`
+	ID                  int64              `json:"id"`
+}
+
+func (q *Queries) UpdateScheduledTransfer(ctx context.Context, arg UpdateScheduledTransferParams) (ScheduledTransfer, error) {
+	row := q.db.QueryRow(ctx, updateScheduledTransfer,
+		arg.Number,
+		arg.Cronspec,
+		arg.OnInsufficientFunds,
+		arg.Active,
+		arg.NextRunTime,
+		arg.ID,
+	)
+	var i ScheduledTransfer
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.Number,
+		&i.Cronspec,
+		&i.OnInsufficientFunds,
+		&i.Active,
+		&i.NextRunTime,
+		&i.LastRunTime,
+		&i.CreatedTime,
+	)
+	return i, err
+}
@@ -0,0 +1,190 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func createRandomScheduledTransfer(t *testing.T, nextRunTime time.Time) ScheduledTransfer {
+	fromTrader := createFundedTrader(t, 1000)
+	toTrader := createFundedTrader(t, 0)
+
+	arg := CreateScheduledTransferParams{
+		Holder:              fromTrader.Holder,
+		FromTraderID:        fromTrader.ID,
+		ToTraderID:          toTrader.ID,
+		Number:              util.RandomAmount(),
+		Cronspec:            "@every 24h",
+		OnInsufficientFunds: util.SkipOnInsufficientFunds,
+		NextRunTime:         nextRunTime,
+	}
+
+	schedule, err := testStore.CreateScheduledTransfer(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, schedule)
+
+	require.Equal(t, arg.Holder, schedule.Holder)
+	require.Equal(t, arg.FromTraderID, schedule.FromTraderID)
+	require.Equal(t, arg.ToTraderID, schedule.ToTraderID)
+	require.Equal(t, arg.Number, schedule.Number)
+	require.Equal(t, arg.Cronspec, schedule.Cronspec)
+	require.Equal(t, arg.OnInsufficientFunds, schedule.OnInsufficientFunds)
+	require.True(t, schedule.Active)
+	require.WithinDuration(t, arg.NextRunTime, schedule.NextRunTime, time.Second)
+	require.False(t, schedule.LastRunTime.Valid)
+
+	return schedule
+}
+
+func TestCreateScheduledTransfer(t *testing.T) {
+	createRandomScheduledTransfer(t, time.Now().Add(time.Hour))
+}
+
+func TestUpdateScheduledTransfer(t *testing.T) {
+	schedule1 := createRandomScheduledTransfer(t, time.Now().Add(time.Hour))
+
+	schedule2, err := testStore.UpdateScheduledTransfer(context.Background(), UpdateScheduledTransferParams{
+		ID:     schedule1.ID,
+		Active: pgtype.Bool{Bool: false, Valid: true},
+	})
+	require.NoError(t, err)
+	require.False(t, schedule2.Active)
+	require.Equal(t, schedule1.Number, schedule2.Number)
+	require.Equal(t, schedule1.Cronspec, schedule2.Cronspec)
+	require.Equal(t, schedule1.NextRunTime, schedule2.NextRunTime)
+}
+
+func TestListDueScheduledTransfers(t *testing.T) {
+	due := createRandomScheduledTransfer(t, time.Now().Add(-time.Minute))
+	notDue := createRandomScheduledTransfer(t, time.Now().Add(time.Hour))
+
+	schedules, err := testStore.ListDueScheduledTransfers(context.Background(), 1000)
+	require.NoError(t, err)
+
+	ids := make(map[int64]bool)
+	for _, schedule := range schedules {
+		require.True(t, schedule.Active)
+		require.False(t, schedule.NextRunTime.After(time.Now()))
+		ids[schedule.ID] = true
+	}
+	require.True(t, ids[due.ID])
+	require.False(t, ids[notDue.ID])
+}
+
+func TestClaimScheduledTransfer(t *testing.T) {
+	schedule1 := createRandomScheduledTransfer(t, time.Now().Add(-time.Minute))
+	nextRunTime := schedule1.NextRunTime.Add(24 * time.Hour)
+
+	arg := ClaimScheduledTransferParams{
+		ID:            schedule1.ID,
+		ScheduledTime: schedule1.NextRunTime,
+		NextRunTime:   nextRunTime,
+	}
+
+	schedule2, err := testStore.ClaimScheduledTransfer(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, nextRunTime.Unix(), schedule2.NextRunTime.Unix())
+	require.True(t, schedule2.LastRunTime.Valid)
+	require.Equal(t, schedule1.NextRunTime, schedule2.LastRunTime.Time)
+
+	// the same run cannot be claimed twice
+	_, err = testStore.ClaimScheduledTransfer(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
+
+func TestCreateScheduledTransferRun(t *testing.T) {
+	schedule := createRandomScheduledTransfer(t, time.Now().Add(-time.Minute))
+
+	arg := CreateScheduledTransferRunParams{
+		ScheduleID:    schedule.ID,
+		ScheduledTime: schedule.NextRunTime,
+		Status:        util.SkippedRunStatus,
+		Error:         ErrInsufficientFunds.Error(),
+		Attempts:      1,
+	}
+
+	run, err := testStore.CreateScheduledTransferRun(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.ScheduleID, run.ScheduleID)
+	require.Equal(t, arg.Status, run.Status)
+	require.Equal(t, arg.Error, run.Error)
+	require.False(t, run.RecordID.Valid)
+
+	// a run is recorded once per scheduled time
+	arg.Status = util.SucceededRunStatus
+	_, err = testStore.CreateScheduledTransferRun(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+
+	runs, err := testStore.ListScheduledTransferRuns(context.Background(), ListScheduledTransferRunsParams{
+		ScheduleID: schedule.ID,
+		Limit:      5,
+		Offset:     0,
+	})
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	require.Equal(t, run, runs[0])
+
+	err = testStore.DeleteScheduledTransfer(context.Background(), schedule.ID)
+	require.NoError(t, err)
+
+	runs, err = testStore.ListScheduledTransferRuns(context.Background(), ListScheduledTransferRunsParams{
+		ScheduleID: schedule.ID,
+		Limit:      5,
+		Offset:     0,
+	})
+	require.NoError(t, err)
+	require.Empty(t, runs)
+}
+
+func TestRunScheduledTransferTx(t *testing.T) {
+	schedule := createRandomScheduledTransfer(t, time.Now().Add(time.Hour))
+	schedule, err := testStore.UpdateScheduledTransfer(context.Background(), UpdateScheduledTransferParams{
+		ID:     schedule.ID,
+		Number: pgtype.Int8{Int64: 100, Valid: true},
+	})
+	require.NoError(t, err)
+
+	arg := RunScheduledTransferTxParams{
+		ScheduleID:    schedule.ID,
+		ScheduledTime: time.Now().Truncate(time.Second),
+	}
+	result, err := testStore.RunScheduledTransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, schedule.ID, result.Schedule.ID)
+	require.Equal(t, schedule.FromTraderID, result.Transfer.Record.FromTraderID)
+	require.Equal(t, int64(100), result.Transfer.Record.Number)
+	record := result.Transfer.Record
+
+	// a pause after dispatch stops the runs still to come
+	_, err = testStore.UpdateScheduledTransfer(context.Background(), UpdateScheduledTransferParams{
+		ID:     schedule.ID,
+		Active: pgtype.Bool{Bool: false, Valid: true},
+	})
+	require.NoError(t, err)
+
+	paused := arg
+	paused.ScheduledTime = arg.ScheduledTime.Add(24 * time.Hour)
+	result, err = testStore.RunScheduledTransferTx(context.Background(), paused)
+	require.ErrorIs(t, err, ErrScheduleNotActive)
+	require.Zero(t, result.Transfer.Record.ID)
+
+	// but a run that already moved the money is still replayed
+	result, err = testStore.RunScheduledTransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, record.ID, result.Transfer.Record.ID)
+
+	fromTrader, err := testStore.GetTrader(context.Background(), schedule.FromTraderID)
+	require.NoError(t, err)
+	require.Equal(t, int64(900)-record.Fee, fromTrader.Rest)
+
+	err = testStore.DeleteScheduledTransfer(context.Background(), schedule.ID)
+	require.NoError(t, err)
+
+	_, err = testStore.RunScheduledTransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrRecordNotFound)
+}
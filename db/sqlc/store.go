@@ -3,24 +3,56 @@ package db
 import (
 	"context"
 
+	"github.com/YuanData/allegro-trade/screening"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type Store interface {
 	Querier
 	RecordTx(ctx context.Context, arg RecordTxParams) (RecordTxResult, error)
+	RunScheduledTransferTx(ctx context.Context, arg RunScheduledTransferTxParams) (RunScheduledTransferTxResult, error)
 	CreateMemberTx(ctx context.Context, arg CreateMemberTxParams) (CreateMemberTxResult, error)
 	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
+	FillTx(ctx context.Context, arg FillTxParams) (FillTxResult, error)
+	ExchangeTx(ctx context.Context, arg ExchangeTxParams) (ExchangeTxResult, error)
+	PostJournalEntry(ctx context.Context, arg PostJournalEntryParams) (PostJournalEntryResult, error)
+	Reconcile(ctx context.Context) (ReconcileReport, error)
+	DepositTx(ctx context.Context, arg DepositTxParams) (DepositTxResult, error)
+	WithdrawalTx(ctx context.Context, arg WithdrawalTxParams) (WithdrawalTxResult, error)
+	ReviewWithdrawalTx(ctx context.Context, arg ReviewWithdrawalTxParams) (ReviewWithdrawalTxResult, error)
+	PlaceHold(ctx context.Context, arg PlaceHoldParams) (HoldResult, error)
+	ExpireHoldTx(ctx context.Context, holdID int64) (HoldResult, error)
+	ReleaseHold(ctx context.Context, holdID int64) (HoldResult, error)
+	CaptureHold(ctx context.Context, arg CaptureHoldParams) (CaptureHoldResult, error)
+	CreateOrderTx(ctx context.Context, arg CreateOrderTxParams) (Order, error)
+	CancelOrderTx(ctx context.Context, orderID int64) (Order, error)
+	ReverseRecordTx(ctx context.Context, arg ReverseRecordTxParams) (ReverseRecordTxResult, error)
+	BatchRecordTx(ctx context.Context, arg BatchRecordTxParams) (BatchRecordTxResult, error)
+	OpenEscrowTx(ctx context.Context, arg OpenEscrowTxParams) (EscrowTxResult, error)
+	ConfirmEscrowTx(ctx context.Context, arg EscrowActionParams) (EscrowTxResult, error)
+	DisputeEscrowTx(ctx context.Context, arg EscrowActionParams) (EscrowTxResult, error)
+	CancelEscrowTx(ctx context.Context, arg EscrowActionParams) (EscrowTxResult, error)
+	ExpireEscrowTx(ctx context.Context, escrowID int64) (EscrowTxResult, error)
+	ResolveEscrowTx(ctx context.Context, arg ResolveEscrowTxParams) (EscrowTxResult, error)
+	SetFeeRuleTx(ctx context.Context, arg SetFeeRuleTxParams) (FeeRuleResult, error)
+	CreateSymbolTx(ctx context.Context, arg CreateSymbolParams) (CreateSymbolTxResult, error)
+	UpdateTraderStatusTx(ctx context.Context, arg UpdateTraderStatusTxParams) (UpdateTraderStatusTxResult, error)
+	ReviewTransferTx(ctx context.Context, arg ReviewTransferTxParams) (ReviewTransferTxResult, error)
+	ReviewKYCSubmissionTx(ctx context.Context, arg ReviewKYCSubmissionTxParams) (ReviewKYCSubmissionTxResult, error)
 }
 
 type SQLStore struct {
 	connPool *pgxpool.Pool
 	*Queries
+	screener screening.Screener
 }
 
-func NewStore(connPool *pgxpool.Pool) Store {
+// NewStore returns a store whose transfers are screened by screener, or not
+// screened at all when it is nil.
+func NewStore(connPool *pgxpool.Pool, screener screening.Screener) Store {
 	return &SQLStore{
 		connPool: connPool,
 		Queries:  New(connPool),
+		screener: screener,
 	}
 }
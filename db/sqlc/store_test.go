@@ -2,14 +2,31 @@ package db
 
 import (
 	"context"
+	"math"
 	"testing"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/require"
+	"github.com/YuanData/allegro-trade/screening"
+	"github.com/YuanData/allegro-trade/util"
 )
 
+func createFundedTrader(t *testing.T, rest int64) Trader {
+	trader := createRandomTraderOfSymbol(t, util.ETH)
+
+	trader, err := testStore.UpdateTrader(context.Background(), UpdateTraderParams{
+		ID:   trader.ID,
+		Rest: rest,
+	})
+	require.NoError(t, err)
+
+	return trader
+}
+
 func TestRecordTx(t *testing.T) {
-	trader1 := createRandomTrader(t)
-	trader2 := createRandomTrader(t)
+	trader1 := createFundedTrader(t, 10000)
+	trader2 := createRandomTraderOfSymbol(t, util.ETH)
 
 	n := 6
 	number := int64(800)
@@ -102,8 +119,8 @@ func TestRecordTx(t *testing.T) {
 }
 
 func TestRecordTxDeadlock(t *testing.T) {
-	trader1 := createRandomTrader(t)
-	trader2 := createRandomTrader(t)
+	trader1 := createFundedTrader(t, 10000)
+	trader2 := createFundedTrader(t, 10000)
 
 	n := 6
 	number := int64(800)
@@ -143,3 +160,1543 @@ func TestRecordTxDeadlock(t *testing.T) {
 	require.Equal(t, trader1.Rest, updatedTrader1.Rest)
 	require.Equal(t, trader2.Rest, updatedTrader2.Rest)
 }
+
+func TestRecordTxInsufficientFunds(t *testing.T) {
+	trader1 := createFundedTrader(t, 100)
+	trader2 := createRandomTraderOfSymbol(t, util.ETH)
+
+	_, err := testStore.RecordTx(context.Background(), RecordTxParams{
+		FromTraderID: trader1.ID,
+		ToTraderID:   trader2.ID,
+		Number:       101,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	updatedTrader1, err := testStore.GetTrader(context.Background(), trader1.ID)
+	require.NoError(t, err)
+	require.Equal(t, trader1.Rest, updatedTrader1.Rest)
+
+	_, err = testStore.UpdateTraderOverdraftLimit(context.Background(), UpdateTraderOverdraftLimitParams{
+		ID:             trader1.ID,
+		OverdraftLimit: 50,
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.RecordTx(context.Background(), RecordTxParams{
+		FromTraderID: trader1.ID,
+		ToTraderID:   trader2.ID,
+		Number:       150,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(-50), result.FromTrader.Rest)
+
+	_, err = testStore.RecordTx(context.Background(), RecordTxParams{
+		FromTraderID: trader1.ID,
+		ToTraderID:   trader2.ID,
+		Number:       1,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+}
+
+func TestUpdateTraderStatusTx(t *testing.T) {
+	member := createRandomMember(t)
+	trader1 := createFundedTrader(t, 100)
+	trader2 := createRandomTraderOfSymbol(t, util.ETH)
+	require.Equal(t, util.ActiveTraderStatus, trader1.Status)
+
+	result, err := testStore.UpdateTraderStatusTx(context.Background(), UpdateTraderStatusTxParams{
+		TraderID:   trader2.ID,
+		Status:     util.FrozenTraderStatus,
+		Reason:     "under review",
+		Membername: member.Membername,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.FrozenTraderStatus, result.Trader.Status)
+	require.Equal(t, "under review", result.Trader.StatusReason)
+	require.Equal(t, util.ActiveTraderStatus, result.Change.FromStatus)
+	require.Equal(t, util.FrozenTraderStatus, result.Change.ToStatus)
+	require.Equal(t, member.Membername, result.Change.Membername)
+
+	_, err = testStore.RecordTx(context.Background(), RecordTxParams{
+		FromTraderID: trader1.ID,
+		ToTraderID:   trader2.ID,
+		Number:       10,
+	})
+	require.ErrorIs(t, err, ErrTraderNotActive)
+
+	updatedTrader1, err := testStore.GetTrader(context.Background(), trader1.ID)
+	require.NoError(t, err)
+	require.Equal(t, trader1.Rest, updatedTrader1.Rest)
+
+	_, err = testStore.UpdateTraderStatusTx(context.Background(), UpdateTraderStatusTxParams{
+		TraderID:   trader2.ID,
+		Status:     util.FrozenTraderStatus,
+		Reason:     "under review",
+		Membername: member.Membername,
+	})
+	require.ErrorIs(t, err, ErrSameTraderStatus)
+
+	_, err = testStore.UpdateTraderStatusTx(context.Background(), UpdateTraderStatusTxParams{
+		TraderID:   trader1.ID,
+		Status:     util.ClosedTraderStatus,
+		Reason:     "requested by holder",
+		Membername: member.Membername,
+	})
+	require.ErrorIs(t, err, ErrTraderNotEmpty)
+
+	empty, err := testStore.UpdateTrader(context.Background(), UpdateTraderParams{
+		ID:   trader2.ID,
+		Rest: 0,
+	})
+	require.NoError(t, err)
+
+	result, err = testStore.UpdateTraderStatusTx(context.Background(), UpdateTraderStatusTxParams{
+		TraderID:   empty.ID,
+		Status:     util.ClosedTraderStatus,
+		Reason:     "requested by holder",
+		Membername: member.Membername,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ClosedTraderStatus, result.Trader.Status)
+
+	_, err = testStore.UpdateTraderStatusTx(context.Background(), UpdateTraderStatusTxParams{
+		TraderID:   empty.ID,
+		Status:     util.ActiveTraderStatus,
+		Reason:     "reopened on request",
+		Membername: member.Membername,
+	})
+	require.ErrorIs(t, err, ErrTraderStatusFlow)
+
+	changes, err := testStore.ListTraderStatusChanges(context.Background(), empty.ID)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, util.FrozenTraderStatus, changes[0].ToStatus)
+	require.Equal(t, util.ClosedTraderStatus, changes[1].ToStatus)
+}
+
+func TestUpdateTraderStatusTxUnsettledEscrow(t *testing.T) {
+	member := createRandomMember(t)
+	buyer := createFundedTrader(t, 100)
+	seller := createRandomTraderOfSymbol(t, util.ETH)
+
+	_, err := testStore.OpenEscrowTx(context.Background(), OpenEscrowTxParams{
+		BuyerTraderID:  buyer.ID,
+		SellerTraderID: seller.ID,
+		Number:         100,
+		ExpiresTime:    time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	// the buyer holds nothing now, but the escrow may still be refunded to it
+	for _, trader := range []Trader{buyer, seller} {
+		_, err = testStore.UpdateTraderStatusTx(context.Background(), UpdateTraderStatusTxParams{
+			TraderID:   trader.ID,
+			Status:     util.ClosedTraderStatus,
+			Reason:     "requested by holder",
+			Membername: member.Membername,
+		})
+		require.ErrorIs(t, err, ErrTraderInUse)
+	}
+}
+
+func setTraderStatus(t *testing.T, traderID int64, status string) {
+	_, err := testStore.UpdateTraderStatus(context.Background(), UpdateTraderStatusParams{
+		ID:           traderID,
+		Status:       status,
+		StatusReason: "test",
+	})
+	require.NoError(t, err)
+}
+
+func TestMoneyPathsTraderNotActive(t *testing.T) {
+	for _, traderStatus := range []string{util.FrozenTraderStatus, util.ClosedTraderStatus} {
+		t.Run(traderStatus, func(t *testing.T) {
+			ctx := context.Background()
+
+			inactive := createFundedTrader(t, 1000)
+			active := createFundedTrader(t, 1000)
+
+			// a record made while both were active, reversed after the status changed
+			paid, err := testStore.RecordTx(ctx, RecordTxParams{
+				FromTraderID: active.ID,
+				ToTraderID:   inactive.ID,
+				Number:       100,
+			})
+			require.NoError(t, err)
+			inactive = paid.ToTrader
+			setTraderStatus(t, inactive.ID, traderStatus)
+
+			_, err = testStore.ReverseRecordTx(ctx, ReverseRecordTxParams{
+				RecordID: paid.Record.ID,
+			})
+			require.ErrorIs(t, err, ErrTraderNotActive)
+
+			_, err = testStore.DepositTx(ctx, DepositTxParams{
+				TraderID:  inactive.ID,
+				Number:    100,
+				Reference: util.RandomString(12),
+			})
+			require.ErrorIs(t, err, ErrTraderNotActive)
+
+			_, err = testStore.WithdrawalTx(ctx, WithdrawalTxParams{
+				TraderID:    inactive.ID,
+				Number:      100,
+				Destination: util.RandomString(20),
+			})
+			require.ErrorIs(t, err, ErrTraderNotActive)
+
+			_, err = testStore.PlaceHold(ctx, PlaceHoldParams{
+				TraderID: inactive.ID,
+				Number:   100,
+				Reason:   util.OrderHoldReason,
+			})
+			require.ErrorIs(t, err, ErrTraderNotActive)
+
+			_, err = testStore.OpenEscrowTx(ctx, OpenEscrowTxParams{
+				BuyerTraderID:  inactive.ID,
+				SellerTraderID: active.ID,
+				Number:         100,
+				ExpiresTime:    time.Now().Add(time.Hour),
+			})
+			require.ErrorIs(t, err, ErrTraderNotActive)
+
+			_, err = testStore.OpenEscrowTx(ctx, OpenEscrowTxParams{
+				BuyerTraderID:  active.ID,
+				SellerTraderID: inactive.ID,
+				Number:         100,
+				ExpiresTime:    time.Now().Add(time.Hour),
+			})
+			require.ErrorIs(t, err, ErrTraderNotActive)
+
+			updated, err := testStore.GetTrader(ctx, inactive.ID)
+			require.NoError(t, err)
+			require.Equal(t, inactive.Rest, updated.Rest)
+			require.Zero(t, updated.Held)
+
+			baseTrader, quoteTrader := createRandomTraderPair(t)
+			setTraderStatus(t, quoteTrader.ID, traderStatus)
+			_, err = testStore.CreateOrderTx(ctx, CreateOrderTxParams{
+				CreateOrderParams: CreateOrderParams{
+					Holder:        baseTrader.Holder,
+					BaseTraderID:  baseTrader.ID,
+					QuoteTraderID: quoteTrader.ID,
+					BaseSymbol:    util.BTC,
+					QuoteSymbol:   util.ETH,
+					Side:          util.SellSide,
+					Kind:          util.LimitKind,
+					Price:         3 * btcUnit,
+					Quantity:      10,
+				},
+			})
+			require.ErrorIs(t, err, ErrTraderNotActive)
+		})
+	}
+}
+
+func TestSettleTraderNotActive(t *testing.T) {
+	ctx := context.Background()
+
+	maker := createRandomOrder(t, util.SellSide, 3*btcUnit, 10)
+	taker := createRandomOrder(t, util.BuySide, 3*btcUnit, 4)
+	setTraderStatus(t, maker.BaseTraderID, util.FrozenTraderStatus)
+
+	_, err := testStore.FillTx(ctx, FillTxParams{
+		MakerOrderID: maker.ID,
+		TakerOrderID: taker.ID,
+		Price:        maker.Price,
+		Quantity:     taker.Quantity,
+	})
+	require.ErrorIs(t, err, ErrTraderNotActive)
+	var orderErr *OrderError
+	require.ErrorAs(t, err, &orderErr)
+	require.Equal(t, maker.ID, orderErr.OrderID)
+
+	trader := createFundedTrader(t, 1000)
+	withdrawal, err := testStore.WithdrawalTx(ctx, WithdrawalTxParams{
+		TraderID:    trader.ID,
+		Number:      300,
+		Destination: util.RandomString(20),
+	})
+	require.NoError(t, err)
+	setTraderStatus(t, trader.ID, util.FrozenTraderStatus)
+
+	reviewer := createRandomMember(t)
+	_, err = testStore.ReviewWithdrawalTx(ctx, ReviewWithdrawalTxParams{
+		WithdrawalID: withdrawal.Withdrawal.ID,
+		Reviewer:     reviewer.Membername,
+		Approve:      true,
+	})
+	require.ErrorIs(t, err, ErrTraderNotActive)
+
+	// rejecting only releases the hold, which a frozen trader may keep
+	rejected, err := testStore.ReviewWithdrawalTx(ctx, ReviewWithdrawalTxParams{
+		WithdrawalID: withdrawal.Withdrawal.ID,
+		Reviewer:     reviewer.Membername,
+		Approve:      false,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), rejected.Trader.Rest)
+	require.Zero(t, rejected.Trader.Held)
+
+	escrow, buyerTrader, _ := openTestEscrow(t, time.Now().Add(-time.Minute))
+	setTraderStatus(t, buyerTrader.ID, util.ClosedTraderStatus)
+
+	_, err = testStore.ExpireEscrowTx(ctx, escrow.ID)
+	require.ErrorIs(t, err, ErrTraderNotActive)
+
+	escrow, err = testStore.GetEscrow(ctx, escrow.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.OpenEscrowStatus, escrow.Status)
+}
+
+func TestRecordTxTransferLimit(t *testing.T) {
+	// a symbol of its own keeps the limit away from other tests
+	symbol := createRandomSymbol(t).Symbol
+	trader1 := createRandomTraderOfSymbol(t, symbol.Code)
+	trader2 := createRandomTraderOfSymbol(t, symbol.Code)
+
+	trader1, err := testStore.UpdateTrader(context.Background(), UpdateTraderParams{
+		ID:   trader1.ID,
+		Rest: 1000,
+	})
+	require.NoError(t, err)
+
+	limit, err := testStore.UpsertTransferLimit(context.Background(), UpsertTransferLimitParams{
+		Symbol:       symbol.Code,
+		DailyLimit:   100,
+		MonthlyLimit: 150,
+	})
+	require.NoError(t, err)
+	defer testStore.DeleteTransferLimit(context.Background(), limit.ID)
+
+	arg := RecordTxParams{
+		FromTraderID: trader1.ID,
+		ToTraderID:   trader2.ID,
+		Number:       60,
+	}
+	_, err = testStore.RecordTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	arg.Number = 50
+	_, err = testStore.RecordTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrTransferLimitExceeded)
+
+	var limitErr *TransferLimitError
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, util.DailyLimitPeriod, limitErr.Period)
+	require.Equal(t, int64(100), limitErr.Limit)
+	require.Equal(t, int64(60), limitErr.Sent)
+	require.Equal(t, int64(40), limitErr.Remaining)
+
+	arg.Number = 40
+	_, err = testStore.RecordTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	_, err = testStore.UpsertTransferLimit(context.Background(), UpsertTransferLimitParams{
+		Symbol:       symbol.Code,
+		MonthlyLimit: 120,
+	})
+	require.NoError(t, err)
+
+	// the legs of a batch count against the limit together
+	_, err = testStore.BatchRecordTx(context.Background(), BatchRecordTxParams{
+		FromTraderID: trader1.ID,
+		Legs: []BatchLegParams{
+			{ToTraderID: trader2.ID, Number: 10},
+			{ToTraderID: trader2.ID, Number: 15},
+		},
+	})
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, util.MonthlyLimitPeriod, limitErr.Period)
+	require.Equal(t, int64(100), limitErr.Sent)
+	require.Equal(t, int64(20), limitErr.Remaining)
+
+	updatedTrader1, err := testStore.GetTrader(context.Background(), trader1.ID)
+	require.NoError(t, err)
+	require.Equal(t, trader1.Rest-100, updatedTrader1.Rest)
+}
+
+func TestWithdrawalAndEscrowTransferLimit(t *testing.T) {
+	symbol := createRandomSymbol(t).Symbol
+	buyer := createRandomTraderOfSymbol(t, symbol.Code)
+	seller := createRandomTraderOfSymbol(t, symbol.Code)
+
+	buyer, err := testStore.UpdateTrader(context.Background(), UpdateTraderParams{
+		ID:   buyer.ID,
+		Rest: 1000,
+	})
+	require.NoError(t, err)
+
+	limit, err := testStore.UpsertTransferLimit(context.Background(), UpsertTransferLimitParams{
+		Symbol:     symbol.Code,
+		DailyLimit: 100,
+	})
+	require.NoError(t, err)
+	defer testStore.DeleteTransferLimit(context.Background(), limit.ID)
+
+	withdrawal, err := testStore.WithdrawalTx(context.Background(), WithdrawalTxParams{
+		TraderID:    buyer.ID,
+		Number:      60,
+		Destination: util.RandomString(20),
+	})
+	require.NoError(t, err)
+
+	// the escrow funding counts together with the pending withdrawal
+	_, err = testStore.OpenEscrowTx(context.Background(), OpenEscrowTxParams{
+		BuyerTraderID:  buyer.ID,
+		SellerTraderID: seller.ID,
+		Number:         50,
+		ExpiresTime:    time.Now().Add(time.Hour),
+	})
+	var limitErr *TransferLimitError
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, int64(60), limitErr.Sent)
+	require.Equal(t, int64(40), limitErr.Remaining)
+
+	_, err = testStore.OpenEscrowTx(context.Background(), OpenEscrowTxParams{
+		BuyerTraderID:  buyer.ID,
+		SellerTraderID: seller.ID,
+		Number:         40,
+		ExpiresTime:    time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.WithdrawalTx(context.Background(), WithdrawalTxParams{
+		TraderID:    buyer.ID,
+		Number:      1,
+		Destination: util.RandomString(20),
+	})
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, int64(100), limitErr.Sent)
+
+	// a rejected withdrawal gives its part of the allowance back
+	reviewer := createRandomMember(t)
+	_, err = testStore.ReviewWithdrawalTx(context.Background(), ReviewWithdrawalTxParams{
+		WithdrawalID: withdrawal.Withdrawal.ID,
+		Reviewer:     reviewer.Membername,
+		Approve:      false,
+	})
+	require.NoError(t, err)
+
+	result, err := testStore.WithdrawalTx(context.Background(), WithdrawalTxParams{
+		TraderID:    buyer.ID,
+		Number:      60,
+		Destination: util.RandomString(20),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(960), result.Trader.Rest)
+	require.Equal(t, int64(60), result.Trader.Held)
+}
+
+type stubScreener struct {
+	verdict screening.Verdict
+}
+
+func (screener stubScreener) Screen(ctx context.Context, history screening.History, transfer screening.Transfer) (screening.Verdict, error) {
+	return screener.verdict, nil
+}
+
+func screenedStore(decision screening.Decision) Store {
+	verdict := screening.Verdict{Decision: decision, Reasons: []string{screening.LargeAmountReason}}
+	return NewStore(testStore.(*SQLStore).connPool, stubScreener{verdict: verdict})
+}
+
+func TestRecordTxScreeningReject(t *testing.T) {
+	trader1 := createFundedTrader(t, 100)
+	trader2 := createRandomTraderOfSymbol(t, util.ETH)
+
+	_, err := screenedStore(screening.Reject).RecordTx(context.Background(), RecordTxParams{
+		FromTraderID: trader1.ID,
+		ToTraderID:   trader2.ID,
+		Number:       10,
+	})
+	require.ErrorIs(t, err, ErrTransferRejected)
+
+	updatedTrader1, err := testStore.GetTrader(context.Background(), trader1.ID)
+	require.NoError(t, err)
+	require.Equal(t, trader1.Rest, updatedTrader1.Rest)
+	require.Zero(t, updatedTrader1.Held)
+}
+
+func TestRecordTxScreeningReview(t *testing.T) {
+	reviewer := createRandomMember(t)
+	trader1 := createFundedTrader(t, 100)
+	trader2 := createRandomTraderOfSymbol(t, util.ETH)
+
+	arg := RecordTxParams{
+		FromTraderID:   trader1.ID,
+		ToTraderID:     trader2.ID,
+		Number:         10,
+		Membername:     trader1.Holder,
+		IdempotencyKey: util.RandomString(16),
+	}
+	store := screenedStore(screening.Review)
+
+	result, err := store.RecordTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrTransferHeld)
+	require.NotZero(t, result.Review.ID)
+	require.Zero(t, result.Record.ID)
+	require.Equal(t, util.PendingReviewStatus, result.Review.Status)
+	require.Equal(t, []string{screening.LargeAmountReason}, result.Review.Reasons)
+	require.Equal(t, trader1.Rest, result.FromTrader.Rest)
+	require.GreaterOrEqual(t, result.FromTrader.Held, arg.Number)
+
+	// the same key finds the same review instead of holding the funds twice
+	replayed, err := store.RecordTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrTransferHeld)
+	require.Equal(t, result.Review.ID, replayed.Review.ID)
+
+	reviewed, err := testStore.ReviewTransferTx(context.Background(), ReviewTransferTxParams{
+		ReviewID: result.Review.ID,
+		Reviewer: reviewer.Membername,
+		Approve:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ApprovedReviewStatus, reviewed.Review.Status)
+	require.Equal(t, reviewed.Record.Record.ID, reviewed.Review.RecordID.Int64)
+	require.Zero(t, reviewed.FromTrader.Held)
+	require.Equal(t, trader1.Rest-arg.Number-reviewed.Record.Fee.Fee, reviewed.FromTrader.Rest)
+
+	_, err = testStore.ReviewTransferTx(context.Background(), ReviewTransferTxParams{
+		ReviewID: result.Review.ID,
+		Reviewer: reviewer.Membername,
+	})
+	require.ErrorIs(t, err, ErrTransferReviewNotPending)
+
+	// once approved the key replays the recorded transfer
+	replayed, err = store.RecordTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, reviewed.Record.Record.ID, replayed.Record.ID)
+
+	arg.IdempotencyKey = ""
+	result, err = store.RecordTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrTransferHeld)
+
+	reviewed, err = testStore.ReviewTransferTx(context.Background(), ReviewTransferTxParams{
+		ReviewID: result.Review.ID,
+		Reviewer: reviewer.Membername,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.RejectedReviewStatus, reviewed.Review.Status)
+	require.False(t, reviewed.Review.RecordID.Valid)
+	require.Zero(t, reviewed.FromTrader.Held)
+}
+
+// recipientScreener screens transfers to the listed traders with their decision
+// and approves the rest.
+type recipientScreener map[int64]screening.Decision
+
+func (screener recipientScreener) Screen(ctx context.Context, history screening.History, transfer screening.Transfer) (screening.Verdict, error) {
+	decision, ok := screener[transfer.ToTraderID]
+	if !ok {
+		return screening.Verdict{Decision: screening.Approve}, nil
+	}
+	return screening.Verdict{Decision: decision, Reasons: []string{screening.LargeAmountReason}}, nil
+}
+
+func TestBatchRecordTxScreening(t *testing.T) {
+	fromTrader := createFundedTrader(t, 1000)
+	approved := createRandomTraderOfSymbol(t, util.ETH)
+	held := createRandomTraderOfSymbol(t, util.ETH)
+	rejected := createRandomTraderOfSymbol(t, util.ETH)
+
+	store := NewStore(testStore.(*SQLStore).connPool, recipientScreener{
+		held.ID:     screening.Review,
+		rejected.ID: screening.Reject,
+	})
+
+	_, err := store.BatchRecordTx(context.Background(), BatchRecordTxParams{
+		FromTraderID: fromTrader.ID,
+		Legs: []BatchLegParams{
+			{ToTraderID: approved.ID, Number: 10},
+			{ToTraderID: rejected.ID, Number: 10},
+		},
+	})
+	require.ErrorIs(t, err, ErrTransferRejected)
+
+	var legErr *BatchLegError
+	require.ErrorAs(t, err, &legErr)
+	require.Equal(t, 1, legErr.Index)
+
+	result, err := store.BatchRecordTx(context.Background(), BatchRecordTxParams{
+		FromTraderID: fromTrader.ID,
+		Legs: []BatchLegParams{
+			{ToTraderID: held.ID, Number: 20},
+			{ToTraderID: approved.ID, Number: 10},
+			{ToTraderID: held.ID, Number: 30},
+		},
+		Membername: fromTrader.Holder,
+	})
+	require.ErrorIs(t, err, ErrTransferHeld)
+	require.Len(t, result.Legs, 3)
+
+	require.NotZero(t, result.Legs[0].Review.ID)
+	require.Zero(t, result.Legs[0].Record.ID)
+	require.Equal(t, int64(20), result.Legs[0].Review.Number)
+	require.NotZero(t, result.Legs[1].Record.ID)
+	require.Zero(t, result.Legs[1].Review.ID)
+	require.NotZero(t, result.Legs[2].Review.ID)
+	require.Equal(t, int64(30), result.Legs[2].Review.Number)
+
+	require.Equal(t, fromTrader.Rest-10-result.Legs[1].Fee.Fee, result.FromTrader.Rest)
+	require.GreaterOrEqual(t, result.FromTrader.Held, int64(50))
+
+	updatedHeld, err := testStore.GetTrader(context.Background(), held.ID)
+	require.NoError(t, err)
+	require.Equal(t, held.Rest, updatedHeld.Rest)
+}
+
+func TestOpenEscrowTxScreeningReview(t *testing.T) {
+	reviewer := createRandomMember(t)
+	buyer := createFundedTrader(t, 1000)
+	seller := createRandomTraderOfSymbol(t, util.ETH)
+
+	arg := OpenEscrowTxParams{
+		BuyerTraderID:  buyer.ID,
+		SellerTraderID: seller.ID,
+		Number:         100,
+		ExpiresTime:    time.Now().Add(time.Hour).Truncate(time.Microsecond),
+	}
+
+	_, err := screenedStore(screening.Reject).OpenEscrowTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrTransferRejected)
+
+	result, err := screenedStore(screening.Review).OpenEscrowTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrTransferHeld)
+	require.NotZero(t, result.Review.ID)
+	require.Zero(t, result.Escrow.ID)
+	require.True(t, result.Review.EscrowExpiresTime.Valid)
+	require.Equal(t, buyer.Rest, result.Trader.Rest)
+	require.Equal(t, arg.Number, result.Trader.Held)
+
+	reviewed, err := testStore.ReviewTransferTx(context.Background(), ReviewTransferTxParams{
+		ReviewID: result.Review.ID,
+		Reviewer: reviewer.Membername,
+		Approve:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ApprovedReviewStatus, reviewed.Review.Status)
+	require.False(t, reviewed.Review.RecordID.Valid)
+	require.Equal(t, reviewed.Escrow.ID, reviewed.Review.EscrowID.Int64)
+	require.Equal(t, arg.Number, reviewed.Escrow.Number)
+	require.WithinDuration(t, arg.ExpiresTime, reviewed.Escrow.ExpiresTime, time.Second)
+	require.Zero(t, reviewed.FromTrader.Held)
+	require.Equal(t, buyer.Rest-arg.Number, reviewed.FromTrader.Rest)
+}
+
+func TestRecordTxIdempotency(t *testing.T) {
+	trader1 := createFundedTrader(t, 10000)
+	trader2 := createRandomTraderOfSymbol(t, util.ETH)
+
+	arg := RecordTxParams{
+		FromTraderID:   trader1.ID,
+		ToTraderID:     trader2.ID,
+		Number:         10,
+		Membername:     trader1.Holder,
+		IdempotencyKey: util.RandomString(16),
+	}
+
+	result1, err := testStore.RecordTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	result2, err := testStore.RecordTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, result1.Record, result2.Record)
+	require.Equal(t, result1.FromDetail, result2.FromDetail)
+	require.Equal(t, result1.ToDetail, result2.ToDetail)
+	require.Equal(t, trader1.Rest-arg.Number, result2.FromTrader.Rest)
+
+	updatedTrader1, err := testStore.GetTrader(context.Background(), trader1.ID)
+	require.NoError(t, err)
+	require.Equal(t, trader1.Rest-arg.Number, updatedTrader1.Rest)
+
+	arg.Number = 20
+	_, err = testStore.RecordTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrIdempotencyKeyReused)
+}
+
+func TestBatchRecordTx(t *testing.T) {
+	fromTrader := createFundedTrader(t, 1000)
+
+	n := 5
+	legs := make([]BatchLegParams, n)
+	toTraders := make([]Trader, n)
+	for i := 0; i < n; i++ {
+		toTraders[i] = createRandomTraderOfSymbol(t, util.ETH)
+		legs[i] = BatchLegParams{ToTraderID: toTraders[i].ID, Number: int64(10 * (i + 1))}
+	}
+
+	result, err := testStore.BatchRecordTx(context.Background(), BatchRecordTxParams{
+		FromTraderID: fromTrader.ID,
+		Legs:         legs,
+		Membername:   fromTrader.Holder,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Legs, n)
+	require.Equal(t, fromTrader.Rest-150, result.FromTrader.Rest)
+
+	for i, leg := range result.Legs {
+		require.Equal(t, fromTrader.ID, leg.Record.FromTraderID)
+		require.Equal(t, legs[i].ToTraderID, leg.Record.ToTraderID)
+		require.Equal(t, legs[i].Number, leg.Record.Number)
+		require.Equal(t, -legs[i].Number, leg.FromDetail.Number)
+		require.Equal(t, legs[i].Number, leg.ToDetail.Number)
+		require.Equal(t, toTraders[i].Rest+legs[i].Number, leg.ToTrader.Rest)
+	}
+}
+
+func TestBatchRecordTxAllOrNothing(t *testing.T) {
+	fromTrader := createFundedTrader(t, 100)
+	toTrader1 := createRandomTraderOfSymbol(t, util.ETH)
+	toTrader2 := createRandomTraderOfSymbol(t, util.ETH)
+
+	_, err := testStore.BatchRecordTx(context.Background(), BatchRecordTxParams{
+		FromTraderID: fromTrader.ID,
+		Legs: []BatchLegParams{
+			{ToTraderID: toTrader1.ID, Number: 60},
+			{ToTraderID: toTrader2.ID, Number: 60},
+		},
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	var legErr *BatchLegError
+	require.ErrorAs(t, err, &legErr)
+	require.Equal(t, 1, legErr.Index)
+
+	for _, trader := range []Trader{fromTrader, toTrader1, toTrader2} {
+		updatedTrader, err := testStore.GetTrader(context.Background(), trader.ID)
+		require.NoError(t, err)
+		require.Equal(t, trader.Rest, updatedTrader.Rest)
+	}
+
+	otherSymbol := createRandomTraderOfSymbol(t, util.BTC)
+
+	_, err = testStore.BatchRecordTx(context.Background(), BatchRecordTxParams{
+		FromTraderID: fromTrader.ID,
+		Legs: []BatchLegParams{
+			{ToTraderID: toTrader1.ID, Number: 10},
+			{ToTraderID: otherSymbol.ID, Number: 10},
+		},
+	})
+	require.ErrorIs(t, err, ErrSymbolMismatch)
+	require.ErrorAs(t, err, &legErr)
+	require.Equal(t, 1, legErr.Index)
+}
+
+func TestRecordTxFees(t *testing.T) {
+	// a symbol of its own keeps the rules of this test away from the others
+	created := createRandomSymbol(t)
+	symbol, house := created.Symbol.Code, created.HouseTrader
+
+	fromTrader := createRandomTraderOfSymbol(t, symbol)
+	fromTrader, err := testStore.UpdateTrader(context.Background(), UpdateTraderParams{
+		ID:   fromTrader.ID,
+		Rest: 100000,
+	})
+	require.NoError(t, err)
+	toTrader := createRandomTraderOfSymbol(t, symbol)
+
+	rule, err := testStore.SetFeeRuleTx(context.Background(), SetFeeRuleTxParams{
+		UpsertFeeRuleParams: UpsertFeeRuleParams{
+			Symbol:  symbol,
+			Kind:    util.PercentageFee,
+			RateBps: 100,
+			MinFee:  5,
+		},
+	})
+	require.NoError(t, err)
+
+	arg := RecordTxParams{
+		FromTraderID:   fromTrader.ID,
+		ToTraderID:     toTrader.ID,
+		Number:         1000,
+		Membername:     fromTrader.Holder,
+		IdempotencyKey: util.RandomString(16),
+	}
+
+	result, err := testStore.RecordTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, rule.Rule.ID, result.Fee.RuleID)
+	require.Equal(t, int64(10), result.Fee.Fee)
+	require.Equal(t, int64(10), result.Record.Fee)
+	require.Equal(t, int64(-10), result.Fee.FeeDetail.Number)
+	require.Equal(t, int64(10), result.Fee.HouseDetail.Number)
+	require.Equal(t, house.ID, result.Fee.HouseTrader.ID)
+	require.Equal(t, house.Rest+10, result.Fee.HouseTrader.Rest)
+	require.Equal(t, int64(100000-1010), result.FromTrader.Rest)
+	require.Equal(t, toTrader.Rest+1000, result.ToTrader.Rest)
+
+	replayed, err := testStore.RecordTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, result.FromDetail, replayed.FromDetail)
+	require.Equal(t, result.ToDetail, replayed.ToDetail)
+	require.Equal(t, result.Fee.FeeDetail, replayed.Fee.FeeDetail)
+	require.Equal(t, result.Fee.HouseDetail, replayed.Fee.HouseDetail)
+	require.Equal(t, house.ID, replayed.Fee.HouseTrader.ID)
+
+	// 1000 was sent this month, which reaches the cheaper tier
+	_, err = testStore.SetFeeRuleTx(context.Background(), SetFeeRuleTxParams{
+		UpsertFeeRuleParams: UpsertFeeRuleParams{
+			Symbol:  symbol,
+			Kind:    util.TieredFee,
+			RateBps: 100,
+		},
+		Tiers: []FeeTierParams{
+			{MinVolume: 1000, RateBps: 50},
+			{MinVolume: 1000000, RateBps: 10},
+		},
+	})
+	require.NoError(t, err)
+
+	arg.IdempotencyKey = ""
+	result, err = testStore.RecordTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, util.TieredFee, result.Fee.Kind)
+	require.Equal(t, int64(1000), result.Fee.MonthlyVolume)
+	require.Equal(t, int32(50), result.Fee.RateBps)
+	require.Equal(t, int64(5), result.Fee.Fee)
+
+	// a rule for the sender's role wins over the rule for every role
+	_, err = testStore.SetFeeRuleTx(context.Background(), SetFeeRuleTxParams{
+		UpsertFeeRuleParams: UpsertFeeRuleParams{
+			Symbol:  symbol,
+			Role:    util.PrayerRole,
+			Kind:    util.FlatFee,
+			FlatFee: 7,
+		},
+	})
+	require.NoError(t, err)
+
+	result, err = testStore.RecordTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, util.FlatFee, result.Fee.Kind)
+	require.Equal(t, int64(7), result.Fee.Fee)
+
+	// reversals carry no fee
+	reversal, err := testStore.ReverseRecordTx(context.Background(), ReverseRecordTxParams{
+		RecordID: result.Record.ID,
+	})
+	require.NoError(t, err)
+	require.Zero(t, reversal.Fee.Fee)
+	require.Zero(t, reversal.Record.Fee)
+	// and the fee of the fully reversed record stays with the house trader
+	require.Equal(t, result.FromTrader.Rest+arg.Number, reversal.ToTrader.Rest)
+
+	// a captured hold pays exactly what was held, without a fee on top
+	held, err := testStore.PlaceHold(context.Background(), PlaceHoldParams{
+		TraderID: fromTrader.ID,
+		Number:   1000,
+		Reason:   util.OrderHoldReason,
+	})
+	require.NoError(t, err)
+
+	captured, err := testStore.CaptureHold(context.Background(), CaptureHoldParams{
+		HoldID:     held.Hold.ID,
+		ToTraderID: toTrader.ID,
+		Number:     1000,
+	})
+	require.NoError(t, err)
+	require.Zero(t, captured.Record.Fee.Fee)
+	require.Zero(t, captured.Record.Record.Fee)
+	require.Equal(t, reversal.ToTrader.Rest-1000, captured.Record.FromTrader.Rest)
+	require.Zero(t, captured.Record.FromTrader.Held)
+}
+
+func TestPostJournalEntry(t *testing.T) {
+	trader1 := createFundedTrader(t, 1000)
+	trader2 := createRandomTraderOfSymbol(t, util.ETH)
+	trader3 := createRandomTraderOfSymbol(t, util.ETH)
+
+	result, err := testStore.PostJournalEntry(context.Background(), PostJournalEntryParams{
+		Kind: util.TransferEntry,
+		Postings: []PostingParams{
+			{TraderID: trader1.ID, Number: -300},
+			{TraderID: trader2.ID, Number: 100},
+			{TraderID: trader3.ID, Number: 200},
+		},
+	})
+	require.NoError(t, err)
+	require.NotZero(t, result.Entry.ID)
+	require.Len(t, result.Postings, 3)
+	require.Len(t, result.Details, 3)
+	require.Equal(t, trader1.Rest-300, result.Traders[0].Rest)
+	require.Equal(t, trader2.Rest+100, result.Traders[1].Rest)
+	require.Equal(t, trader3.Rest+200, result.Traders[2].Rest)
+
+	postings, err := testStore.ListPostingsByEntry(context.Background(), result.Entry.ID)
+	require.NoError(t, err)
+	require.Equal(t, result.Postings, postings)
+
+	_, err = testStore.PostJournalEntry(context.Background(), PostJournalEntryParams{
+		Kind: util.TransferEntry,
+		Postings: []PostingParams{
+			{TraderID: trader1.ID, Number: -300},
+			{TraderID: trader2.ID, Number: 100},
+		},
+	})
+	require.ErrorIs(t, err, ErrUnbalancedEntry)
+
+	btcTrader := createRandomTraderOfSymbol(t, util.BTC)
+	_, err = testStore.PostJournalEntry(context.Background(), PostJournalEntryParams{
+		Kind: util.TransferEntry,
+		Postings: []PostingParams{
+			{TraderID: trader1.ID, Number: -100},
+			{TraderID: btcTrader.ID, Number: 100},
+		},
+	})
+	require.ErrorIs(t, err, ErrUnbalancedEntry)
+
+	_, err = testStore.PostJournalEntry(context.Background(), PostJournalEntryParams{
+		Kind:     util.TransferEntry,
+		Postings: []PostingParams{{TraderID: trader1.ID, Number: -100}},
+	})
+	require.ErrorIs(t, err, ErrEmptyJournalEntry)
+
+	updatedTrader1, err := testStore.GetTrader(context.Background(), trader1.ID)
+	require.NoError(t, err)
+	require.Equal(t, trader1.Rest-300, updatedTrader1.Rest)
+}
+
+func TestReverseRecordTx(t *testing.T) {
+	trader1 := createFundedTrader(t, 1000)
+	trader2 := createFundedTrader(t, 1000)
+
+	original, err := testStore.RecordTx(context.Background(), RecordTxParams{
+		FromTraderID: trader1.ID,
+		ToTraderID:   trader2.ID,
+		Number:       300,
+	})
+	require.NoError(t, err)
+
+	partial, err := testStore.ReverseRecordTx(context.Background(), ReverseRecordTxParams{
+		RecordID: original.Record.ID,
+		Number:   100,
+	})
+	require.NoError(t, err)
+	require.Equal(t, original.Record.ID, partial.Record.ReversalOf.Int64)
+	require.Equal(t, trader2.ID, partial.Record.FromTraderID)
+	require.Equal(t, trader1.ID, partial.Record.ToTraderID)
+	require.Equal(t, int64(100), partial.Record.Number)
+	require.Equal(t, int64(800), partial.ToTrader.Rest)
+
+	_, err = testStore.ReverseRecordTx(context.Background(), ReverseRecordTxParams{
+		RecordID: original.Record.ID,
+		Number:   201,
+	})
+	require.ErrorIs(t, err, ErrReversalExceeded)
+
+	rest, err := testStore.ReverseRecordTx(context.Background(), ReverseRecordTxParams{
+		RecordID: original.Record.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(200), rest.Record.Number)
+	require.Equal(t, int64(1000), rest.ToTrader.Rest)
+	require.Equal(t, int64(1000), rest.FromTrader.Rest)
+
+	_, err = testStore.ReverseRecordTx(context.Background(), ReverseRecordTxParams{
+		RecordID: original.Record.ID,
+	})
+	require.ErrorIs(t, err, ErrRecordAlreadyReversed)
+
+	_, err = testStore.ReverseRecordTx(context.Background(), ReverseRecordTxParams{
+		RecordID: partial.Record.ID,
+	})
+	require.ErrorIs(t, err, ErrReverseReversal)
+
+	records, err := testStore.ListRecords(context.Background(), ListRecordsParams{
+		TraderID: trader2.ID,
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.False(t, records[0].ReversalOf.Valid)
+	require.Equal(t, original.Record.ID, records[1].ReversalOf.Int64)
+	require.Equal(t, original.Record.ID, records[2].ReversalOf.Int64)
+}
+
+func TestDepositTx(t *testing.T) {
+	trader := createRandomTraderOfSymbol(t, util.ETH)
+
+	result, err := testStore.DepositTx(context.Background(), DepositTxParams{
+		TraderID:  trader.ID,
+		Number:    500,
+		Reference: util.RandomString(12),
+	})
+	require.NoError(t, err)
+	require.NotZero(t, result.Deposit.ID)
+	require.Equal(t, trader.ID, result.Deposit.TraderID)
+	require.Equal(t, int64(500), result.Detail.Number)
+	require.Equal(t, trader.Rest+500, result.Trader.Rest)
+
+	postings, err := testStore.ListPostingsByEntry(context.Background(), result.Deposit.EntryID)
+	require.NoError(t, err)
+	require.Len(t, postings, 2)
+	require.Equal(t, int64(-500), postings[0].Number)
+}
+
+func TestWithdrawalTx(t *testing.T) {
+	trader := createFundedTrader(t, 1000)
+
+	result, err := testStore.WithdrawalTx(context.Background(), WithdrawalTxParams{
+		TraderID:    trader.ID,
+		Number:      300,
+		Destination: util.RandomString(20),
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.PendingWithdrawalStatus, result.Withdrawal.Status)
+	require.Equal(t, result.Hold.ID, result.Withdrawal.HoldID.Int64)
+	require.Equal(t, int64(1000), result.Trader.Rest)
+	require.Equal(t, int64(300), result.Trader.Held)
+
+	_, err = testStore.WithdrawalTx(context.Background(), WithdrawalTxParams{
+		TraderID:    trader.ID,
+		Number:      701,
+		Destination: util.RandomString(20),
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	reviewer := createRandomMember(t)
+
+	rejected, err := testStore.ReviewWithdrawalTx(context.Background(), ReviewWithdrawalTxParams{
+		WithdrawalID: result.Withdrawal.ID,
+		Reviewer:     reviewer.Membername,
+		Approve:      false,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.RejectedWithdrawalStatus, rejected.Withdrawal.Status)
+	require.Equal(t, reviewer.Membername, rejected.Withdrawal.Reviewer.String)
+	require.False(t, rejected.Withdrawal.EntryID.Valid)
+	require.Equal(t, int64(1000), rejected.Trader.Rest)
+	require.Zero(t, rejected.Trader.Held)
+
+	_, err = testStore.ReviewWithdrawalTx(context.Background(), ReviewWithdrawalTxParams{
+		WithdrawalID: result.Withdrawal.ID,
+		Reviewer:     reviewer.Membername,
+		Approve:      true,
+	})
+	require.ErrorIs(t, err, ErrWithdrawalNotPending)
+
+	result, err = testStore.WithdrawalTx(context.Background(), WithdrawalTxParams{
+		TraderID:    trader.ID,
+		Number:      400,
+		Destination: util.RandomString(20),
+	})
+	require.NoError(t, err)
+
+	approved, err := testStore.ReviewWithdrawalTx(context.Background(), ReviewWithdrawalTxParams{
+		WithdrawalID: result.Withdrawal.ID,
+		Reviewer:     reviewer.Membername,
+		Approve:      true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ApprovedWithdrawalStatus, approved.Withdrawal.Status)
+	require.True(t, approved.Withdrawal.EntryID.Valid)
+	require.Equal(t, int64(600), approved.Trader.Rest)
+	require.Zero(t, approved.Trader.Held)
+
+	hold, err := testStore.GetHold(context.Background(), result.Hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.CapturedHoldStatus, hold.Status)
+}
+
+func TestHolds(t *testing.T) {
+	trader1 := createFundedTrader(t, 1000)
+	trader2 := createRandomTraderOfSymbol(t, util.ETH)
+
+	held, err := testStore.PlaceHold(context.Background(), PlaceHoldParams{
+		TraderID: trader1.ID,
+		Number:   600,
+		Reason:   util.OrderHoldReason,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ActiveHoldStatus, held.Hold.Status)
+	require.Equal(t, int64(600), held.Hold.Remaining)
+	require.Equal(t, int64(600), held.Trader.Held)
+	require.Equal(t, int64(1000), held.Trader.Rest)
+
+	_, err = testStore.RecordTx(context.Background(), RecordTxParams{
+		FromTraderID: trader1.ID,
+		ToTraderID:   trader2.ID,
+		Number:       401,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	_, err = testStore.PlaceHold(context.Background(), PlaceHoldParams{
+		TraderID: trader1.ID,
+		Number:   401,
+		Reason:   util.OrderHoldReason,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	captured, err := testStore.CaptureHold(context.Background(), CaptureHoldParams{
+		HoldID:     held.Hold.ID,
+		ToTraderID: trader2.ID,
+		Number:     250,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ActiveHoldStatus, captured.Hold.Status)
+	require.Equal(t, int64(350), captured.Hold.Remaining)
+	require.Equal(t, int64(750), captured.Record.FromTrader.Rest)
+	require.Equal(t, int64(350), captured.Record.FromTrader.Held)
+	require.Equal(t, trader2.Rest+250, captured.Record.ToTrader.Rest)
+
+	_, err = testStore.CaptureHold(context.Background(), CaptureHoldParams{
+		HoldID:     held.Hold.ID,
+		ToTraderID: trader2.ID,
+		Number:     351,
+	})
+	require.ErrorIs(t, err, ErrHoldExceeded)
+
+	released, err := testStore.ReleaseHold(context.Background(), held.Hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.ReleasedHoldStatus, released.Hold.Status)
+	require.Zero(t, released.Hold.Remaining)
+	require.Zero(t, released.Trader.Held)
+	require.Equal(t, int64(750), released.Trader.Rest)
+
+	_, err = testStore.ReleaseHold(context.Background(), held.Hold.ID)
+	require.ErrorIs(t, err, ErrHoldNotActive)
+}
+
+func TestExpiredHolds(t *testing.T) {
+	trader := createFundedTrader(t, 1000)
+
+	held, err := testStore.PlaceHold(context.Background(), PlaceHoldParams{
+		TraderID:    trader.ID,
+		Number:      100,
+		Reason:      util.WithdrawalHoldReason,
+		ExpiresTime: time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	holds, err := testStore.ListExpiredHolds(context.Background(), 1000)
+	require.NoError(t, err)
+
+	var found bool
+	for _, hold := range holds {
+		if hold.ID == held.Hold.ID {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestExpireHoldTx(t *testing.T) {
+	trader := createFundedTrader(t, 1000)
+
+	withdrawal, err := testStore.WithdrawalTx(context.Background(), WithdrawalTxParams{
+		TraderID:        trader.ID,
+		Number:          300,
+		Destination:     "0xdeadbeef",
+		HoldExpiresTime: time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(300), withdrawal.Trader.Held)
+
+	expired, err := testStore.ExpireHoldTx(context.Background(), withdrawal.Hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.ReleasedHoldStatus, expired.Hold.Status)
+	require.Zero(t, expired.Trader.Held)
+	require.Equal(t, int64(1000), expired.Trader.Rest)
+
+	rejected, err := testStore.GetWithdrawal(context.Background(), withdrawal.Withdrawal.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.RejectedWithdrawalStatus, rejected.Status)
+	require.Equal(t, util.SystemMembername, rejected.Reviewer.String)
+
+	_, err = testStore.ExpireHoldTx(context.Background(), withdrawal.Hold.ID)
+	require.ErrorIs(t, err, ErrHoldNotActive)
+
+	baseTrader, quoteTrader := createRandomTraderPair(t)
+	order, err := testStore.CreateOrderTx(context.Background(), CreateOrderTxParams{
+		CreateOrderParams: CreateOrderParams{
+			Holder:        baseTrader.Holder,
+			BaseTraderID:  baseTrader.ID,
+			QuoteTraderID: quoteTrader.ID,
+			BaseSymbol:    util.BTC,
+			QuoteSymbol:   util.ETH,
+			Side:          util.SellSide,
+			Kind:          util.LimitKind,
+			Price:         2 * btcUnit,
+			Quantity:      1,
+		},
+		HoldExpiresTime: time.Now().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.ExpireHoldTx(context.Background(), order.HoldID.Int64)
+	require.NoError(t, err)
+
+	cancelled, err := testStore.GetOrder(context.Background(), order.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.CancelledOrderStatus, cancelled.Status)
+
+	// a hold without an expiry, or one still to come, is left alone
+	held, err := testStore.PlaceHold(context.Background(), PlaceHoldParams{
+		TraderID:    trader.ID,
+		Number:      100,
+		Reason:      util.OrderHoldReason,
+		ExpiresTime: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.ExpireHoldTx(context.Background(), held.Hold.ID)
+	require.ErrorIs(t, err, ErrHoldNotExpired)
+}
+
+func TestFillTx(t *testing.T) {
+	maker := createRandomOrder(t, util.SellSide, 3*btcUnit, 10)
+	taker := createRandomOrder(t, util.BuySide, 3*btcUnit, 4)
+
+	sellerBase, err := testStore.GetTrader(context.Background(), maker.BaseTraderID)
+	require.NoError(t, err)
+	buyerQuote, err := testStore.GetTrader(context.Background(), taker.QuoteTraderID)
+	require.NoError(t, err)
+
+	result, err := testStore.FillTx(context.Background(), FillTxParams{
+		MakerOrderID: maker.ID,
+		TakerOrderID: taker.ID,
+		Price:        maker.Price,
+		Quantity:     taker.Quantity,
+	})
+	require.NoError(t, err)
+
+	fill := result.Fill
+	require.NotZero(t, fill.ID)
+	require.Equal(t, maker.ID, fill.MakerOrderID)
+	require.Equal(t, taker.ID, fill.TakerOrderID)
+	require.Equal(t, result.BaseRecord.Record.ID, fill.BaseRecordID)
+	require.Equal(t, result.QuoteRecord.Record.ID, fill.QuoteRecordID)
+
+	require.Equal(t, maker.BaseTraderID, result.BaseRecord.Record.FromTraderID)
+	require.Equal(t, taker.BaseTraderID, result.BaseRecord.Record.ToTraderID)
+	require.Equal(t, taker.Quantity, result.BaseRecord.Record.Number)
+
+	require.Equal(t, taker.QuoteTraderID, result.QuoteRecord.Record.FromTraderID)
+	require.Equal(t, maker.QuoteTraderID, result.QuoteRecord.Record.ToTraderID)
+	require.Equal(t, taker.Quantity*maker.Price/btcUnit, result.QuoteRecord.Record.Number)
+
+	require.Equal(t, sellerBase.Rest-taker.Quantity, result.BaseRecord.FromTrader.Rest)
+	require.Equal(t, buyerQuote.Rest-taker.Quantity*maker.Price/btcUnit, result.QuoteRecord.FromTrader.Rest)
+
+	require.Equal(t, taker.Quantity, result.MakerOrder.Filled)
+	require.Equal(t, util.OpenOrderStatus, result.MakerOrder.Status)
+	require.Equal(t, taker.Quantity, result.TakerOrder.Filled)
+	require.Equal(t, util.FilledOrderStatus, result.TakerOrder.Status)
+
+	_, err = testStore.FillTx(context.Background(), FillTxParams{
+		MakerOrderID: maker.ID,
+		TakerOrderID: taker.ID,
+		Price:        maker.Price,
+		Quantity:     1,
+	})
+	require.ErrorIs(t, err, ErrOrderNotOpen)
+}
+
+func TestFillTxPriceBelowOneMinorUnit(t *testing.T) {
+	// half an ETH minor unit per BTC minor unit
+	maker := createRandomOrder(t, util.SellSide, btcUnit/2, 10)
+	taker := createRandomOrder(t, util.BuySide, btcUnit/2, 3)
+
+	result, err := testStore.FillTx(context.Background(), FillTxParams{
+		MakerOrderID: maker.ID,
+		TakerOrderID: taker.ID,
+		Price:        maker.Price,
+		Quantity:     taker.Quantity,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), result.BaseRecord.Record.Number)
+	// rounded down in favour of the buyer
+	require.Equal(t, int64(1), result.QuoteRecord.Record.Number)
+
+	dust := createRandomOrder(t, util.BuySide, btcUnit/2, 1)
+	_, err = testStore.FillTx(context.Background(), FillTxParams{
+		MakerOrderID: maker.ID,
+		TakerOrderID: dust.ID,
+		Price:        maker.Price,
+		Quantity:     dust.Quantity,
+	})
+	require.ErrorIs(t, err, ErrOrderTooSmall)
+	var orderErr *OrderError
+	require.ErrorAs(t, err, &orderErr)
+	require.Equal(t, dust.ID, orderErr.OrderID)
+}
+
+func TestExchangeTx(t *testing.T) {
+	fromTrader, _ := createRandomTraderPair(t)
+	_, toTrader := createRandomTraderPair(t)
+
+	fromNumber := int64(100)
+	rate := int64(ExchangeRateScale * 3 / 2)
+
+	result, err := testStore.ExchangeTx(context.Background(), ExchangeTxParams{
+		FromTraderID: fromTrader.ID,
+		ToTraderID:   toTrader.ID,
+		FromNumber:   fromNumber,
+		Rate:         rate,
+	})
+	require.NoError(t, err)
+
+	exchange := result.Exchange
+	require.NotZero(t, exchange.ID)
+	require.Equal(t, fromTrader.Symbol, exchange.FromSymbol)
+	require.Equal(t, toTrader.Symbol, exchange.ToSymbol)
+	require.Equal(t, fromNumber, exchange.FromNumber)
+	require.Equal(t, int64(150), exchange.ToNumber)
+	require.Equal(t, rate, exchange.Rate)
+	require.Equal(t, result.FromDetail.ID, exchange.FromDetailID)
+	require.Equal(t, result.ToDetail.ID, exchange.ToDetailID)
+
+	require.Equal(t, -fromNumber, result.FromDetail.Number)
+	require.Equal(t, exchange.ToNumber, result.ToDetail.Number)
+	require.Equal(t, fromTrader.Rest-fromNumber, result.FromTrader.Rest)
+	require.Equal(t, toTrader.Rest+exchange.ToNumber, result.ToTrader.Rest)
+
+	postings, err := testStore.ListPostingsByEntry(context.Background(), exchange.EntryID.Int64)
+	require.NoError(t, err)
+	require.Len(t, postings, 4)
+
+	sums := make(map[string]int64)
+	for _, posting := range postings {
+		sums[posting.Symbol] += posting.Number
+	}
+	require.Equal(t, map[string]int64{fromTrader.Symbol: 0, toTrader.Symbol: 0}, sums)
+
+	_, err = testStore.ExchangeTx(context.Background(), ExchangeTxParams{
+		FromTraderID: fromTrader.ID,
+		ToTraderID:   fromTrader.ID,
+		FromNumber:   fromNumber,
+		Rate:         rate,
+	})
+	require.ErrorIs(t, err, ErrSameSymbol)
+}
+
+func TestExchangeAmount(t *testing.T) {
+	number, err := ExchangeAmount(3, ExchangeRateScale/2)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), number)
+
+	_, err = ExchangeAmount(1, ExchangeRateScale/2)
+	require.ErrorIs(t, err, ErrExchangeAmountTooLow)
+
+	_, err = ExchangeAmount(1, 0)
+	require.ErrorIs(t, err, ErrInvalidExchangeRate)
+
+	_, err = ExchangeAmount(math.MaxInt64, ExchangeRateScale*2)
+	require.ErrorIs(t, err, ErrExchangeOverflow)
+}
+
+func openTestEscrow(t *testing.T, expiresTime time.Time) (Escrow, Trader, Trader) {
+	buyerTrader := createFundedTrader(t, 1000)
+	sellerTrader := createRandomTraderOfSymbol(t, util.ETH)
+
+	result, err := testStore.OpenEscrowTx(context.Background(), OpenEscrowTxParams{
+		BuyerTraderID:  buyerTrader.ID,
+		SellerTraderID: sellerTrader.ID,
+		Number:         300,
+		ExpiresTime:    expiresTime,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.OpenEscrowStatus, result.Escrow.Status)
+	require.Equal(t, buyerTrader.Holder, result.Escrow.Buyer)
+	require.Equal(t, sellerTrader.Holder, result.Escrow.Seller)
+	require.Equal(t, int64(700), result.Trader.Rest)
+
+	return result.Escrow, buyerTrader, sellerTrader
+}
+
+func TestEscrowRelease(t *testing.T) {
+	escrow, buyerTrader, sellerTrader := openTestEscrow(t, time.Now().Add(time.Hour))
+
+	_, err := testStore.ConfirmEscrowTx(context.Background(), EscrowActionParams{
+		EscrowID:   escrow.ID,
+		Membername: util.RandomHolder(),
+	})
+	require.ErrorIs(t, err, ErrNotEscrowParty)
+
+	result, err := testStore.ConfirmEscrowTx(context.Background(), EscrowActionParams{
+		EscrowID:   escrow.ID,
+		Membername: buyerTrader.Holder,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.OpenEscrowStatus, result.Escrow.Status)
+	require.True(t, result.Escrow.BuyerConfirmed)
+	require.Empty(t, result.Trader)
+
+	result, err = testStore.ConfirmEscrowTx(context.Background(), EscrowActionParams{
+		EscrowID:   escrow.ID,
+		Membername: sellerTrader.Holder,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ReleasedEscrowStatus, result.Escrow.Status)
+	require.True(t, result.Escrow.SettleEntryID.Valid)
+	require.True(t, result.Escrow.SettledTime.Valid)
+	require.Equal(t, sellerTrader.ID, result.Trader.ID)
+	require.Equal(t, sellerTrader.Rest+escrow.Number, result.Trader.Rest)
+
+	_, err = testStore.CancelEscrowTx(context.Background(), EscrowActionParams{
+		EscrowID:   escrow.ID,
+		Membername: sellerTrader.Holder,
+	})
+	require.ErrorIs(t, err, ErrEscrowNotOpen)
+}
+
+func TestEscrowCancel(t *testing.T) {
+	escrow, buyerTrader, sellerTrader := openTestEscrow(t, time.Now().Add(time.Hour))
+
+	_, err := testStore.ConfirmEscrowTx(context.Background(), EscrowActionParams{
+		EscrowID:   escrow.ID,
+		Membername: sellerTrader.Holder,
+	})
+	require.NoError(t, err)
+
+	_, err = testStore.CancelEscrowTx(context.Background(), EscrowActionParams{
+		EscrowID:   escrow.ID,
+		Membername: buyerTrader.Holder,
+	})
+	require.ErrorIs(t, err, ErrEscrowSellerConfirmed)
+
+	result, err := testStore.CancelEscrowTx(context.Background(), EscrowActionParams{
+		EscrowID:   escrow.ID,
+		Membername: sellerTrader.Holder,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.CancelledEscrowStatus, result.Escrow.Status)
+	require.Equal(t, buyerTrader.ID, result.Trader.ID)
+	require.Equal(t, buyerTrader.Rest, result.Trader.Rest)
+}
+
+func TestEscrowDispute(t *testing.T) {
+	escrow, buyerTrader, sellerTrader := openTestEscrow(t, time.Now().Add(-time.Minute))
+	priest := createRandomMember(t)
+
+	_, err := testStore.ResolveEscrowTx(context.Background(), ResolveEscrowTxParams{
+		EscrowID: escrow.ID,
+		Resolver: priest.Membername,
+		Release:  true,
+	})
+	require.ErrorIs(t, err, ErrEscrowNotDisputed)
+
+	result, err := testStore.DisputeEscrowTx(context.Background(), EscrowActionParams{
+		EscrowID:   escrow.ID,
+		Membername: buyerTrader.Holder,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.DisputedEscrowStatus, result.Escrow.Status)
+
+	// a disputed escrow does not expire
+	_, err = testStore.ExpireEscrowTx(context.Background(), escrow.ID)
+	require.ErrorIs(t, err, ErrEscrowNotOpen)
+
+	result, err = testStore.ResolveEscrowTx(context.Background(), ResolveEscrowTxParams{
+		EscrowID: escrow.ID,
+		Resolver: priest.Membername,
+		Release:  true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ReleasedEscrowStatus, result.Escrow.Status)
+	require.Equal(t, priest.Membername, result.Escrow.Resolver.String)
+	require.Equal(t, sellerTrader.Rest+escrow.Number, result.Trader.Rest)
+}
+
+func TestExpireEscrow(t *testing.T) {
+	escrow, _, _ := openTestEscrow(t, time.Now().Add(time.Hour))
+
+	_, err := testStore.ExpireEscrowTx(context.Background(), escrow.ID)
+	require.ErrorIs(t, err, ErrEscrowNotExpired)
+
+	expired, buyerTrader, _ := openTestEscrow(t, time.Now().Add(-time.Minute))
+
+	escrows, err := testStore.ListExpiredEscrows(context.Background(), 1000)
+	require.NoError(t, err)
+
+	ids := make(map[int64]bool)
+	for _, escrow := range escrows {
+		ids[escrow.ID] = true
+	}
+	require.True(t, ids[expired.ID])
+	require.False(t, ids[escrow.ID])
+
+	result, err := testStore.ExpireEscrowTx(context.Background(), expired.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.ExpiredEscrowStatus, result.Escrow.Status)
+	require.Equal(t, buyerTrader.Rest, result.Trader.Rest)
+}
+
+func createRandomKYCSubmission(t *testing.T, membername string, level string) KycSubmission {
+	submission, err := testStore.CreateKYCSubmission(context.Background(), CreateKYCSubmissionParams{
+		Membername:     membername,
+		Level:          level,
+		LegalName:      util.RandomHolder(),
+		DateOfBirth:    pgtype.Date{Time: time.Date(1990, 4, 30, 0, 0, 0, 0, time.UTC), Valid: true},
+		Country:        "DE",
+		DocumentType:   util.PassportDocument,
+		DocumentNumber: util.RandomString(9),
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.PendingReviewStatus, submission.Status)
+	require.False(t, submission.Reviewer.Valid)
+	return submission
+}
+
+func TestReviewKYCSubmissionTx(t *testing.T) {
+	member := createRandomMember(t)
+	priest := createRandomMember(t)
+	require.Equal(t, util.NoneKYCLevel, member.KycLevel)
+
+	submission := createRandomKYCSubmission(t, member.Membername, util.FullKYCLevel)
+
+	// one pending submission per member
+	_, err := testStore.CreateKYCSubmission(context.Background(), CreateKYCSubmissionParams{
+		Membername:     member.Membername,
+		Level:          util.BasicKYCLevel,
+		LegalName:      submission.LegalName,
+		DateOfBirth:    submission.DateOfBirth,
+		Country:        submission.Country,
+		DocumentType:   submission.DocumentType,
+		DocumentNumber: submission.DocumentNumber,
+	})
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+
+	result, err := testStore.ReviewKYCSubmissionTx(context.Background(), ReviewKYCSubmissionTxParams{
+		SubmissionID: submission.ID,
+		Reviewer:     priest.Membername,
+		Approve:      true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.ApprovedReviewStatus, result.Submission.Status)
+	require.Equal(t, priest.Membername, result.Submission.Reviewer.String)
+	require.True(t, result.Submission.ReviewedTime.Valid)
+	require.Equal(t, util.FullKYCLevel, result.Member.KycLevel)
+
+	_, err = testStore.ReviewKYCSubmissionTx(context.Background(), ReviewKYCSubmissionTxParams{
+		SubmissionID: submission.ID,
+		Reviewer:     priest.Membername,
+		Approve:      false,
+		Reason:       "document expired",
+	})
+	require.ErrorIs(t, err, ErrKYCSubmissionNotPending)
+
+	// approving a lower level keeps the higher one
+	lower := createRandomKYCSubmission(t, member.Membername, util.BasicKYCLevel)
+	result, err = testStore.ReviewKYCSubmissionTx(context.Background(), ReviewKYCSubmissionTxParams{
+		SubmissionID: lower.ID,
+		Reviewer:     priest.Membername,
+		Approve:      true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.FullKYCLevel, result.Member.KycLevel)
+
+	other := createRandomMember(t)
+	rejected := createRandomKYCSubmission(t, other.Membername, util.BasicKYCLevel)
+	result, err = testStore.ReviewKYCSubmissionTx(context.Background(), ReviewKYCSubmissionTxParams{
+		SubmissionID: rejected.ID,
+		Reviewer:     priest.Membername,
+		Approve:      false,
+		Reason:       "document expired",
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.RejectedReviewStatus, result.Submission.Status)
+	require.Equal(t, "document expired", result.Submission.Reason)
+	require.Equal(t, util.NoneKYCLevel, result.Member.KycLevel)
+}
@@ -0,0 +1,139 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: symbol.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createSymbol = `-- name: CreateSymbol :one
+INSERT INTO symbols (
+  code,
+  display_name,
+  decimals,
+  min_transfer,
+  enabled
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING code, display_name, decimals, min_transfer, enabled, created_time
+`
+
+type CreateSymbolParams struct {
+	Code        string `json:"code"`
+	DisplayName string `json:"display_name"`
+	Decimals    int32  `json:"decimals"`
+	MinTransfer int64  `json:"min_transfer"`
+	Enabled     bool   `json:"enabled"`
+}
+
+func (q *Queries) CreateSymbol(ctx context.Context, arg CreateSymbolParams) (Symbol, error) {
+	row := q.db.QueryRow(ctx, createSymbol,
+		arg.Code,
+		arg.DisplayName,
+		arg.Decimals,
+		arg.MinTransfer,
+		arg.Enabled,
+	)
+	var i Symbol
+	err := row.Scan(
+		&i.Code,
+		&i.DisplayName,
+		&i.Decimals,
+		&i.MinTransfer,
+		&i.Enabled,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const getSymbol = `-- name: GetSymbol :one
+SELECT code, display_name, decimals, min_transfer, enabled, created_time FROM symbols
+WHERE code = $1 LIMIT 1
+`
+
+func (q *Queries) GetSymbol(ctx context.Context, code string) (Symbol, error) {
+	row := q.db.QueryRow(ctx, getSymbol, code)
+	var i Symbol
+	err := row.Scan(
+		&i.Code,
+		&i.DisplayName,
+		&i.Decimals,
+		&i.MinTransfer,
+		&i.Enabled,
+		&i.CreatedTime,
+	)
+	return i, err
+}
+
+const listSymbols = `-- name: ListSymbols :many
+SELECT code, display_name, decimals, min_transfer, enabled, created_time FROM symbols
+ORDER BY code
+`
+
+func (q *Queries) ListSymbols(ctx context.Context) ([]Symbol, error) {
+	rows, err := q.db.Query(ctx, listSymbols)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Symbol{}
+	for rows.Next() {
+		var i Symbol
+		if err := rows.Scan(
+			&i.Code,
+			&i.DisplayName,
+			&i.Decimals,
+			&i.MinTransfer,
+			&i.Enabled,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateSymbol = `-- name: UpdateSymbol :one
+UPDATE symbols
+SET
+  display_name = COALESCE($1, display_name),
+  min_transfer = COALESCE($2, min_transfer),
+  enabled = COALESCE($3, enabled)
+WHERE code = $4
+RETURNING code, display_name, decimals, min_transfer, enabled, created_time
+`
+
+type UpdateSymbolParams struct {
+	DisplayName pgtype.Text `json:"display_name"`
+	MinTransfer pgtype.Int8 `json:"min_transfer"`
+	Enabled     pgtype.Bool `json:"enabled"`
+	Code        string      `json:"code"`
+}
+
+func (q *Queries) UpdateSymbol(ctx context.Context, arg UpdateSymbolParams) (Symbol, error) {
+	row := q.db.QueryRow(ctx, updateSymbol,
+		arg.DisplayName,
+		arg.MinTransfer,
+		arg.Enabled,
+		arg.Code,
+	)
+	var i Symbol
+	err := row.Scan(
+		&i.Code,
+		&i.DisplayName,
+		&i.Decimals,
+		&i.MinTransfer,
+		&i.Enabled,
+		&i.CreatedTime,
+	)
+	return i, err
+}
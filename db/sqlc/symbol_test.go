@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func createRandomSymbol(t *testing.T) CreateSymbolTxResult {
+	arg := CreateSymbolParams{
+		Code:        strings.ToUpper(util.RandomString(6)),
+		DisplayName: util.RandomString(8),
+		Decimals:    int32(util.RandomInt(0, 18)),
+		MinTransfer: util.RandomInt(1, 10),
+		Enabled:     true,
+	}
+
+	result, err := testStore.CreateSymbolTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	symbol := result.Symbol
+	require.Equal(t, arg.Code, symbol.Code)
+	require.Equal(t, arg.DisplayName, symbol.DisplayName)
+	require.Equal(t, arg.Decimals, symbol.Decimals)
+	require.Equal(t, arg.MinTransfer, symbol.MinTransfer)
+	require.True(t, symbol.Enabled)
+	require.NotZero(t, symbol.CreatedTime)
+
+	require.Equal(t, util.SystemMembername, result.SystemTrader.Holder)
+	require.Equal(t, arg.Code, result.SystemTrader.Symbol)
+	require.Equal(t, int64(math.MaxInt64), result.SystemTrader.OverdraftLimit)
+	require.Equal(t, util.EscrowMembername, result.EscrowTrader.Holder)
+	require.Equal(t, arg.Code, result.EscrowTrader.Symbol)
+	require.Equal(t, util.HouseMembername, result.HouseTrader.Holder)
+	require.Equal(t, arg.Code, result.HouseTrader.Symbol)
+
+	return result
+}
+
+func TestCreateSymbolTx(t *testing.T) {
+	created := createRandomSymbol(t)
+
+	_, err := testStore.CreateSymbolTx(context.Background(), CreateSymbolParams{
+		Code:        created.Symbol.Code,
+		DisplayName: created.Symbol.DisplayName,
+		MinTransfer: 1,
+	})
+	require.Error(t, err)
+	require.Equal(t, UniqueViolation, ErrorCode(err))
+}
+
+func TestUpdateSymbol(t *testing.T) {
+	symbol1 := createRandomSymbol(t).Symbol
+
+	symbol2, err := testStore.UpdateSymbol(context.Background(), UpdateSymbolParams{
+		Code:        symbol1.Code,
+		MinTransfer: pgtype.Int8{Int64: 100, Valid: true},
+		Enabled:     pgtype.Bool{Bool: false, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), symbol2.MinTransfer)
+	require.False(t, symbol2.Enabled)
+	require.Equal(t, symbol1.DisplayName, symbol2.DisplayName)
+	require.Equal(t, symbol1.Decimals, symbol2.Decimals)
+}
+
+func TestListSymbols(t *testing.T) {
+	createRandomSymbol(t)
+
+	symbols, err := testStore.ListSymbols(context.Background())
+	require.NoError(t, err)
+
+	codes := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		codes[symbol.Code] = true
+	}
+	require.True(t, codes[util.ETH])
+	require.True(t, codes[util.BTC])
+	require.True(t, codes[util.ADA])
+}
+
+func TestTraderRequiresKnownSymbol(t *testing.T) {
+	member := createRandomMember(t)
+
+	_, err := testStore.CreateTrader(context.Background(), CreateTraderParams{
+		Holder: member.Membername,
+		Symbol: strings.ToUpper(util.RandomString(7)),
+	})
+	require.Error(t, err)
+	require.Equal(t, ForeignKeyViolation, ErrorCode(err))
+}
@@ -9,11 +9,40 @@ import (
 	"context"
 )
 
+const addTraderHeld = `-- name: AddTraderHeld :one
+UPDATE traders
+SET held = held + $1
+WHERE id = $2
+RETURNING id, holder, rest, symbol, created_time, overdraft_limit, held, status, status_reason
+`
+
+type AddTraderHeldParams struct {
+	Number int64 `json:"number"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) AddTraderHeld(ctx context.Context, arg AddTraderHeldParams) (Trader, error) {
+	row := q.db.QueryRow(ctx, addTraderHeld, arg.Number, arg.ID)
+	var i Trader
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.Rest,
+		&i.Symbol,
+		&i.CreatedTime,
+		&i.OverdraftLimit,
+		&i.Held,
+		&i.Status,
+		&i.StatusReason,
+	)
+	return i, err
+}
+
 const addTraderRest = `-- name: AddTraderRest :one
 UPDATE traders
 SET rest = rest + $1
 WHERE id = $2
-RETURNING id, holder, rest, symbol, created_time
+RETURNING id, holder, rest, symbol, created_time, overdraft_limit, held, status, status_reason
 `
 
 type AddTraderRestParams struct {
@@ -30,6 +59,10 @@ func (q *Queries) AddTraderRest(ctx context.Context, arg AddTraderRestParams) (T
 		&i.Rest,
 		&i.Symbol,
 		&i.CreatedTime,
+		&i.OverdraftLimit,
+		&i.Held,
+		&i.Status,
+		&i.StatusReason,
 	)
 	return i, err
 }
@@ -41,7 +74,7 @@ INSERT INTO traders (
   symbol
 ) VALUES (
   $1, $2, $3
-) RETURNING id, holder, rest, symbol, created_time
+) RETURNING id, holder, rest, symbol, created_time, overdraft_limit, held, status, status_reason
 `
 
 type CreateTraderParams struct {
@@ -59,22 +92,57 @@ func (q *Queries) CreateTrader(ctx context.Context, arg CreateTraderParams) (Tra
 		&i.Rest,
 		&i.Symbol,
 		&i.CreatedTime,
+		&i.OverdraftLimit,
+		&i.Held,
+		&i.Status,
+		&i.StatusReason,
 	)
 	return i, err
 }
 
-const deleteTrader = `-- name: DeleteTrader :exec
-DELETE FROM traders
-WHERE id = $1
+const createTraderStatusChange = `-- name: CreateTraderStatusChange :one
+INSERT INTO trader_status_changes (
+  trader_id,
+  from_status,
+  to_status,
+  reason,
+  membername
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING id, trader_id, from_status, to_status, reason, membername, created_time
 `
 
-func (q *Queries) DeleteTrader(ctx context.Context, id int64) error {
-	_, err := q.db.Exec(ctx, deleteTrader, id)
-	return err
+type CreateTraderStatusChangeParams struct {
+	TraderID   int64  `json:"trader_id"`
+	FromStatus string `json:"from_status"`
+	ToStatus   string `json:"to_status"`
+	Reason     string `json:"reason"`
+	Membername string `json:"membername"`
+}
+
+func (q *Queries) CreateTraderStatusChange(ctx context.Context, arg CreateTraderStatusChangeParams) (TraderStatusChange, error) {
+	row := q.db.QueryRow(ctx, createTraderStatusChange,
+		arg.TraderID,
+		arg.FromStatus,
+		arg.ToStatus,
+		arg.Reason,
+		arg.Membername,
+	)
+	var i TraderStatusChange
+	err := row.Scan(
+		&i.ID,
+		&i.TraderID,
+		&i.FromStatus,
+		&i.ToStatus,
+		&i.Reason,
+		&i.Membername,
+		&i.CreatedTime,
+	)
+	return i, err
 }
 
 const getTrader = `-- name: GetTrader :one
-SELECT id, holder, rest, symbol, created_time FROM traders
+SELECT id, holder, rest, symbol, created_time, overdraft_limit, held, status, status_reason FROM traders
 WHERE id = $1 LIMIT 1
 `
 
@@ -87,12 +155,43 @@ func (q *Queries) GetTrader(ctx context.Context, id int64) (Trader, error) {
 		&i.Rest,
 		&i.Symbol,
 		&i.CreatedTime,
+		&i.OverdraftLimit,
+		&i.Held,
+		&i.Status,
+		&i.StatusReason,
+	)
+	return i, err
+}
+
+const getTraderByHolderSymbol = `-- name: GetTraderByHolderSymbol :one
+SELECT id, holder, rest, symbol, created_time, overdraft_limit, held, status, status_reason FROM traders
+WHERE holder = $1 AND symbol = $2 LIMIT 1
+`
+
+type GetTraderByHolderSymbolParams struct {
+	Holder string `json:"holder"`
+	Symbol string `json:"symbol"`
+}
+
+func (q *Queries) GetTraderByHolderSymbol(ctx context.Context, arg GetTraderByHolderSymbolParams) (Trader, error) {
+	row := q.db.QueryRow(ctx, getTraderByHolderSymbol, arg.Holder, arg.Symbol)
+	var i Trader
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.Rest,
+		&i.Symbol,
+		&i.CreatedTime,
+		&i.OverdraftLimit,
+		&i.Held,
+		&i.Status,
+		&i.StatusReason,
 	)
 	return i, err
 }
 
 const getTraderForUpdate = `-- name: GetTraderForUpdate :one
-SELECT id, holder, rest, symbol, created_time FROM traders
+SELECT id, holder, rest, symbol, created_time, overdraft_limit, held, status, status_reason FROM traders
 WHERE id = $1 LIMIT 1
 FOR NO KEY UPDATE
 `
@@ -106,26 +205,63 @@ func (q *Queries) GetTraderForUpdate(ctx context.Context, id int64) (Trader, err
 		&i.Rest,
 		&i.Symbol,
 		&i.CreatedTime,
+		&i.OverdraftLimit,
+		&i.Held,
+		&i.Status,
+		&i.StatusReason,
 	)
 	return i, err
 }
 
+const listTraderStatusChanges = `-- name: ListTraderStatusChanges :many
+SELECT id, trader_id, from_status, to_status, reason, membername, created_time FROM trader_status_changes
+WHERE trader_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListTraderStatusChanges(ctx context.Context, traderID int64) ([]TraderStatusChange, error) {
+	rows, err := q.db.Query(ctx, listTraderStatusChanges, traderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TraderStatusChange{}
+	for rows.Next() {
+		var i TraderStatusChange
+		if err := rows.Scan(
+			&i.ID,
+			&i.TraderID,
+			&i.FromStatus,
+			&i.ToStatus,
+			&i.Reason,
+			&i.Membername,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTraders = `-- name: ListTraders :many
-SELECT id, holder, rest, symbol, created_time FROM traders
-WHERE holder = $1
+SELECT id, holder, rest, symbol, created_time, overdraft_limit, held, status, status_reason FROM traders
+WHERE holder = $1 AND id > $2
 ORDER BY id
-LIMIT $2
-OFFSET $3
+LIMIT $3
 `
 
 type ListTradersParams struct {
-	Holder string `json:"holder"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
+	Holder  string `json:"holder"`
+	AfterID int64  `json:"after_id"`
+	Limit   int32  `json:"limit"`
 }
 
 func (q *Queries) ListTraders(ctx context.Context, arg ListTradersParams) ([]Trader, error) {
-	rows, err := q.db.Query(ctx, listTraders, arg.Holder, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, listTraders, arg.Holder, arg.AfterID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +275,10 @@ func (q *Queries) ListTraders(ctx context.Context, arg ListTradersParams) ([]Tra
 			&i.Rest,
 			&i.Symbol,
 			&i.CreatedTime,
+			&i.OverdraftLimit,
+			&i.Held,
+			&i.Status,
+			&i.StatusReason,
 		); err != nil {
 			return nil, err
 		}
@@ -154,7 +294,7 @@ const updateTrader = `-- name: UpdateTrader :one
 UPDATE traders
 SET rest = $2
 WHERE id = $1
-RETURNING id, holder, rest, symbol, created_time
+RETURNING id, holder, rest, symbol, created_time, overdraft_limit, held, status, status_reason
 `
 
 type UpdateTraderParams struct {
@@ -171,6 +311,69 @@ func (q *Queries) UpdateTrader(ctx context.Context, arg UpdateTraderParams) (Tra
 		&i.Rest,
 		&i.Symbol,
 		&i.CreatedTime,
+		&i.OverdraftLimit,
+		&i.Held,
+		&i.Status,
+		&i.StatusReason,
+	)
+	return i, err
+}
+
+const updateTraderOverdraftLimit = `-- name: UpdateTraderOverdraftLimit :one
+UPDATE traders
+SET overdraft_limit = $2
+WHERE id = $1
+RETURNING id, holder, rest, symbol, created_time, overdraft_limit, held, status, status_reason
+`
+
+type UpdateTraderOverdraftLimitParams struct {
+	ID             int64 `json:"id"`
+	OverdraftLimit int64 `json:"overdraft_limit"`
+}
+
+func (q *Queries) UpdateTraderOverdraftLimit(ctx context.Context, arg UpdateTraderOverdraftLimitParams) (Trader, error) {
+	row := q.db.QueryRow(ctx, updateTraderOverdraftLimit, arg.ID, arg.OverdraftLimit)
+	var i Trader
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.Rest,
+		&i.Symbol,
+		&i.CreatedTime,
+		&i.OverdraftLimit,
+		&i.Held,
+		&i.Status,
+		&i.StatusReason,
+	)
+	return i, err
+}
+
+const updateTraderStatus = `-- name: UpdateTraderStatus :one
+UPDATE traders
+SET status = $1, status_reason = $2
+WHERE id = $3
+RETURNING id, holder, rest, symbol, created_time, overdraft_limit, held, status, status_reason
+`
+
+type UpdateTraderStatusParams struct {
+	Status       string `json:"status"`
+	StatusReason string `json:"status_reason"`
+	ID           int64  `json:"id"`
+}
+
+func (q *Queries) UpdateTraderStatus(ctx context.Context, arg UpdateTraderStatusParams) (Trader, error) {
+	row := q.db.QueryRow(ctx, updateTraderStatus, arg.Status, arg.StatusReason, arg.ID)
+	var i Trader
+	err := row.Scan(
+		&i.ID,
+		&i.Holder,
+		&i.Rest,
+		&i.Symbol,
+		&i.CreatedTime,
+		&i.OverdraftLimit,
+		&i.Held,
+		&i.Status,
+		&i.StatusReason,
 	)
 	return i, err
 }
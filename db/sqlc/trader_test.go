@@ -10,12 +10,16 @@ import (
 )
 
 func createRandomTrader(t *testing.T) Trader {
+	return createRandomTraderOfSymbol(t, util.RandomSymbol())
+}
+
+func createRandomTraderOfSymbol(t *testing.T, symbol string) Trader {
 	member := createRandomMember(t)
 
 	arg := CreateTraderParams{
 		Holder:    member.Membername,
 		Rest:  util.RandomAmount(),
-		Symbol: util.RandomSymbol(),
+		Symbol: symbol,
 	}
 
 	trader, err := testStore.CreateTrader(context.Background(), arg)
@@ -68,17 +72,6 @@ func TestUpdateTrader(t *testing.T) {
 	require.WithinDuration(t, trader1.CreatedTime, trader2.CreatedTime, time.Second)
 }
 
-func TestDeleteTrader(t *testing.T) {
-	trader1 := createRandomTrader(t)
-	err := testStore.DeleteTrader(context.Background(), trader1.ID)
-	require.NoError(t, err)
-
-	trader2, err := testStore.GetTrader(context.Background(), trader1.ID)
-	require.Error(t, err)
-	require.EqualError(t, err, ErrRecordNotFound.Error())
-	require.Empty(t, trader2)
-}
-
 func TestListTraders(t *testing.T) {
 	var lastTrader Trader
 	for i := 0; i < 4; i++ {
@@ -87,8 +80,8 @@ func TestListTraders(t *testing.T) {
 
 	arg := ListTradersParams{
 		Holder:  lastTrader.Holder,
-		Limit:  2,
-		Offset: 0,
+		AfterID: 0,
+		Limit:   2,
 	}
 
 	traders, err := testStore.ListTraders(context.Background(), arg)
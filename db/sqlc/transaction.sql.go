@@ -0,0 +1,188 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: transaction.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const listTransactions = `-- name: ListTransactions :many
+WITH moves AS (
+  -- a sender has the fee leg of a record too, the balance is the one after both
+  SELECT d.trader_id, d.record_id, MAX(d.id)::bigint AS detail_id
+  FROM details d
+  JOIN traders t ON t.id = d.trader_id
+  WHERE
+    d.record_id IS NOT NULL AND
+    ($3::varchar IS NULL OR t.holder = $3) AND
+    ($4::bigint IS NULL OR d.trader_id = $4) AND
+    (
+      $5::bigint = 0 OR
+      $1::varchar = 'number' OR
+      ($2::bool AND d.record_id < $5::bigint) OR
+      (NOT $2::bool AND d.record_id > $5::bigint)
+    )
+  GROUP BY d.trader_id, d.record_id
+), transactions AS (
+  SELECT
+    r.id AS record_id,
+    m.trader_id,
+    m.detail_id,
+    t.symbol,
+    (CASE WHEN r.from_trader_id = m.trader_id THEN 'out' ELSE 'in' END)::varchar AS direction,
+    (CASE WHEN r.from_trader_id = m.trader_id THEN r.to_trader_id ELSE r.from_trader_id END)::bigint AS counterparty_id,
+    r.number,
+    (CASE WHEN r.from_trader_id = m.trader_id THEN r.fee ELSE 0 END)::bigint AS fee,
+    r.reversal_of,
+    r.created_time
+  FROM moves m
+  JOIN records r ON r.id = m.record_id
+  JOIN traders t ON t.id = m.trader_id
+), page AS (
+  SELECT record_id, trader_id, detail_id, symbol, direction, counterparty_id, number, fee, reversal_of, created_time FROM transactions
+  WHERE
+    ($6::bigint IS NULL OR counterparty_id = $6) AND
+    ($7::varchar IS NULL OR direction = $7) AND
+    ($8::bigint IS NULL OR number >= $8) AND
+    ($9::bigint IS NULL OR number <= $9) AND
+    ($10::varchar IS NULL OR symbol = $10) AND
+    ($11::timestamptz IS NULL OR created_time >= $11) AND
+    ($12::timestamptz IS NULL OR created_time < $12) AND
+    (
+      $5::bigint = 0 OR
+      CASE
+        WHEN $1::varchar = 'number' AND $2::bool
+          THEN (number, record_id) < ($13::bigint, $5::bigint)
+        WHEN $1::varchar = 'number'
+          THEN (number, record_id) > ($13::bigint, $5::bigint)
+        WHEN $2::bool
+          THEN record_id < $5::bigint
+        ELSE record_id > $5::bigint
+      END
+    )
+  ORDER BY
+    CASE WHEN $1::varchar = 'number' AND NOT $2::bool THEN number END ASC,
+    CASE WHEN $1::varchar = 'number' AND $2::bool THEN number END DESC,
+    CASE WHEN NOT $2::bool THEN record_id END ASC,
+    CASE WHEN $2::bool THEN record_id END DESC
+  LIMIT $14
+)
+SELECT
+  p.record_id,
+  p.trader_id,
+  p.symbol,
+  p.direction,
+  p.counterparty_id,
+  p.number,
+  p.fee,
+  (
+    COALESCE(s.balance, 0) + COALESCE((
+      SELECT SUM(d.number)
+      FROM details d
+      WHERE
+        d.trader_id = p.trader_id AND
+        d.created_time >= COALESCE(s.snapshot_time, '-infinity'::timestamptz) AND
+        (d.created_time, d.id) <= (l.created_time, l.id)
+    ), 0)
+  )::bigint AS balance,
+  p.reversal_of,
+  p.created_time
+FROM page p
+JOIN details l ON l.id = p.detail_id
+LEFT JOIN LATERAL (
+  SELECT bs.snapshot_time, bs.balance
+  FROM balance_snapshots bs
+  WHERE bs.trader_id = p.trader_id AND bs.snapshot_time <= l.created_time
+  ORDER BY bs.snapshot_time DESC
+  LIMIT 1
+) s ON true
+ORDER BY
+  CASE WHEN $1::varchar = 'number' AND NOT $2::bool THEN p.number END ASC,
+  CASE WHEN $1::varchar = 'number' AND $2::bool THEN p.number END DESC,
+  CASE WHEN NOT $2::bool THEN p.record_id END ASC,
+  CASE WHEN $2::bool THEN p.record_id END DESC
+`
+
+type ListTransactionsParams struct {
+	SortBy         string             `json:"sort_by"`
+	Descending     bool               `json:"descending"`
+	Holder         pgtype.Text        `json:"holder"`
+	TraderID       pgtype.Int8        `json:"trader_id"`
+	AfterID        int64              `json:"after_id"`
+	CounterpartyID pgtype.Int8        `json:"counterparty_id"`
+	Direction      pgtype.Text        `json:"direction"`
+	MinNumber      pgtype.Int8        `json:"min_number"`
+	MaxNumber      pgtype.Int8        `json:"max_number"`
+	Symbol         pgtype.Text        `json:"symbol"`
+	CreatedFrom    pgtype.Timestamptz `json:"created_from"`
+	CreatedTo      pgtype.Timestamptz `json:"created_to"`
+	AfterNumber    int64              `json:"after_number"`
+	Limit          int32              `json:"limit"`
+}
+
+type ListTransactionsRow struct {
+	RecordID       int64       `json:"record_id"`
+	TraderID       int64       `json:"trader_id"`
+	Symbol         string      `json:"symbol"`
+	Direction      string      `json:"direction"`
+	CounterpartyID int64       `json:"counterparty_id"`
+	Number         int64       `json:"number"`
+	Fee            int64       `json:"fee"`
+	Balance        int64       `json:"balance"`
+	ReversalOf     pgtype.Int8 `json:"reversal_of"`
+	CreatedTime    time.Time   `json:"created_time"`
+}
+
+// the running balance is worked out for the rows of the page only, replayed from
+// the latest snapshot up to the last detail of the record in (created_time, id) order
+func (q *Queries) ListTransactions(ctx context.Context, arg ListTransactionsParams) ([]ListTransactionsRow, error) {
+	rows, err := q.db.Query(ctx, listTransactions,
+		arg.SortBy,
+		arg.Descending,
+		arg.Holder,
+		arg.TraderID,
+		arg.AfterID,
+		arg.CounterpartyID,
+		arg.Direction,
+		arg.MinNumber,
+		arg.MaxNumber,
+		arg.Symbol,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.AfterNumber,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTransactionsRow{}
+	for rows.Next() {
+		var i ListTransactionsRow
+		if err := rows.Scan(
+			&i.RecordID,
+			&i.TraderID,
+			&i.Symbol,
+			&i.Direction,
+			&i.CounterpartyID,
+			&i.Number,
+			&i.Fee,
+			&i.Balance,
+			&i.ReversalOf,
+			&i.CreatedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,132 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTransactions(t *testing.T) {
+	trader1 := createRandomTraderOfSymbol(t, util.ETH)
+	trader2 := createRandomTraderOfSymbol(t, util.ETH)
+
+	_, err := testStore.DepositTx(context.Background(), DepositTxParams{
+		TraderID:  trader1.ID,
+		Number:    1000,
+		Reference: util.RandomString(12),
+	})
+	require.NoError(t, err)
+
+	// balances come from details alone, the random starting rest has none
+	var balances []int64
+	var records []Record
+	for _, number := range []int64{100, 300, 200} {
+		result, err := testStore.RecordTx(context.Background(), RecordTxParams{
+			FromTraderID: trader1.ID,
+			ToTraderID:   trader2.ID,
+			Number:       number,
+		})
+		require.NoError(t, err)
+		balances = append(balances, result.FromTrader.Rest-trader1.Rest)
+		records = append(records, result.Record)
+	}
+
+	result, err := testStore.RecordTx(context.Background(), RecordTxParams{
+		FromTraderID: trader2.ID,
+		ToTraderID:   trader1.ID,
+		Number:       50,
+	})
+	require.NoError(t, err)
+	balances = append(balances, result.ToTrader.Rest-trader1.Rest)
+	records = append(records, result.Record)
+
+	arg := ListTransactionsParams{
+		Holder: pgtype.Text{String: trader1.Holder, Valid: true},
+		SortBy: util.CreatedTimeSort,
+		Limit:  10,
+	}
+
+	transactions, err := testStore.ListTransactions(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, transactions, 4)
+
+	for i, transaction := range transactions {
+		require.Equal(t, records[i].ID, transaction.RecordID)
+		require.Equal(t, trader1.ID, transaction.TraderID)
+		require.Equal(t, trader2.ID, transaction.CounterpartyID)
+		require.Equal(t, util.ETH, transaction.Symbol)
+		require.Equal(t, balances[i], transaction.Balance)
+	}
+	require.Equal(t, util.OutDirection, transactions[0].Direction)
+	require.Equal(t, util.InDirection, transactions[3].Direction)
+
+	arg.Direction = pgtype.Text{String: util.OutDirection, Valid: true}
+	arg.MinNumber = pgtype.Int8{Int64: 150, Valid: true}
+	arg.SortBy = util.NumberSort
+	arg.Descending = true
+	transactions, err = testStore.ListTransactions(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	require.Equal(t, int64(300), transactions[0].Number)
+	require.Equal(t, int64(200), transactions[1].Number)
+
+	arg.AfterNumber, arg.AfterID = transactions[0].Number, transactions[0].RecordID
+	transactions, err = testStore.ListTransactions(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	require.Equal(t, records[2].ID, transactions[0].RecordID)
+}
+
+func TestListTransactionsFromSnapshot(t *testing.T) {
+	trader1 := createRandomTraderOfSymbol(t, util.ETH)
+	trader2 := createRandomTraderOfSymbol(t, util.ETH)
+
+	_, err := testStore.DepositTx(context.Background(), DepositTxParams{
+		TraderID:  trader1.ID,
+		Number:    1000,
+		Reference: util.RandomString(12),
+	})
+	require.NoError(t, err)
+
+	var balances []int64
+	record := func(number int64) {
+		result, err := testStore.RecordTx(context.Background(), RecordTxParams{
+			FromTraderID: trader1.ID,
+			ToTraderID:   trader2.ID,
+			Number:       number,
+		})
+		require.NoError(t, err)
+		balances = append(balances, result.FromTrader.Rest-trader1.Rest)
+	}
+
+	record(100)
+	_, err = testStore.CreateBalanceSnapshots(context.Background(), CreateBalanceSnapshotsParams{
+		SnapshotTime: time.Now(),
+		AfterID:      trader1.ID - 1,
+		Limit:        1,
+	})
+	require.NoError(t, err)
+	record(300)
+
+	// each page replays its balances from the snapshot taken between the records
+	arg := ListTransactionsParams{
+		TraderID:   pgtype.Int8{Int64: trader1.ID, Valid: true},
+		SortBy:     util.CreatedTimeSort,
+		Descending: true,
+		Limit:      1,
+	}
+	transactions, err := testStore.ListTransactions(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	require.Equal(t, balances[1], transactions[0].Balance)
+
+	arg.AfterID = transactions[0].RecordID
+	transactions, err = testStore.ListTransactions(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	require.Equal(t, balances[0], transactions[0].Balance)
+}
@@ -0,0 +1,184 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: transfer_limit.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const deleteTransferLimit = `-- name: DeleteTransferLimit :exec
+DELETE FROM transfer_limits
+WHERE id = $1
+`
+
+func (q *Queries) DeleteTransferLimit(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteTransferLimit, id)
+	return err
+}
+
+const getApplicableTransferLimit = `-- name: GetApplicableTransferLimit :one
+SELECT id, symbol, role, daily_limit, monthly_limit, created_time, kyc_level FROM transfer_limits
+WHERE symbol = $1
+  AND role IN ($2::varchar, '')
+  AND kyc_level IN ($3::varchar, '')
+ORDER BY role DESC, kyc_level DESC
+LIMIT 1
+`
+
+type GetApplicableTransferLimitParams struct {
+	Symbol   string `json:"symbol"`
+	Role     string `json:"role"`
+	KycLevel string `json:"kyc_level"`
+}
+
+func (q *Queries) GetApplicableTransferLimit(ctx context.Context, arg GetApplicableTransferLimitParams) (TransferLimit, error) {
+	row := q.db.QueryRow(ctx, getApplicableTransferLimit, arg.Symbol, arg.Role, arg.KycLevel)
+	var i TransferLimit
+	err := row.Scan(
+		&i.ID,
+		&i.Symbol,
+		&i.Role,
+		&i.DailyLimit,
+		&i.MonthlyLimit,
+		&i.CreatedTime,
+		&i.KycLevel,
+	)
+	return i, err
+}
+
+const getOutgoingTotals = `-- name: GetOutgoingTotals :one
+WITH outgoing AS (
+  SELECT from_trader_id AS trader_id, number, created_time
+  FROM records
+  WHERE reversal_of IS NULL
+  UNION ALL
+  SELECT buyer_trader_id, number, created_time
+  FROM escrows
+  UNION ALL
+  SELECT trader_id, number, created_time
+  FROM withdrawals
+  WHERE status <> 'rejected'
+)
+SELECT
+  COALESCE(SUM(outgoing.number) FILTER (WHERE outgoing.created_time >= $1::timestamptz), 0)::bigint AS daily,
+  COALESCE(SUM(outgoing.number), 0)::bigint AS monthly
+FROM outgoing
+JOIN traders t ON t.id = outgoing.trader_id
+WHERE t.holder = $2
+  AND t.symbol = $3
+  AND outgoing.created_time >= $4::timestamptz
+`
+
+type GetOutgoingTotalsParams struct {
+	DailySince   time.Time `json:"daily_since"`
+	Holder       string    `json:"holder"`
+	Symbol       string    `json:"symbol"`
+	MonthlySince time.Time `json:"monthly_since"`
+}
+
+type GetOutgoingTotalsRow struct {
+	Daily   int64 `json:"daily"`
+	Monthly int64 `json:"monthly"`
+}
+
+func (q *Queries) GetOutgoingTotals(ctx context.Context, arg GetOutgoingTotalsParams) (GetOutgoingTotalsRow, error) {
+	row := q.db.QueryRow(ctx, getOutgoingTotals,
+		arg.DailySince,
+		arg.Holder,
+		arg.Symbol,
+		arg.MonthlySince,
+	)
+	var i GetOutgoingTotalsRow
+	err := row.Scan(&i.Daily, &i.Monthly)
+	return i, err
+}
+
+const listTransferLimits = `-- name: ListTransferLimits :many
+SELECT id, symbol, role, daily_limit, monthly_limit, created_time, kyc_level FROM transfer_limits
+ORDER BY symbol, role, kyc_level
+LIMIT $1
+OFFSET $2
+`
+
+type ListTransferLimitsParams struct {
+	Limit  int32 `json:"limit"`
+	Offset int32 `json:"offset"`
+}
+
+func (q *Queries) ListTransferLimits(ctx context.Context, arg ListTransferLimitsParams) ([]TransferLimit, error) {
+	rows, err := q.db.Query(ctx, listTransferLimits, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TransferLimit{}
+	for rows.Next() {
+		var i TransferLimit
+		if err := rows.Scan(
+			&i.ID,
+			&i.Symbol,
+			&i.Role,
+			&i.DailyLimit,
+			&i.MonthlyLimit,
+			&i.CreatedTime,
+			&i.KycLevel,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertTransferLimit = `-- name: UpsertTransferLimit :one
+INSERT INTO transfer_limits (
+  symbol,
+  role,
+  kyc_level,
+  daily_limit,
+  monthly_limit
+) VALUES (
+  $1, $2, $3, $4, $5
+)
+ON CONFLICT (symbol, role, kyc_level) DO UPDATE
+SET
+  daily_limit = EXCLUDED.daily_limit,
+  monthly_limit = EXCLUDED.monthly_limit
+RETURNING id, symbol, role, daily_limit, monthly_limit, created_time, kyc_level
+`
+
+type UpsertTransferLimitParams struct {
+	Symbol       string `json:"symbol"`
+	Role         string `json:"role"`
+	KycLevel     string `json:"kyc_level"`
+	DailyLimit   int64  `json:"daily_limit"`
+	MonthlyLimit int64  `json:"monthly_limit"`
+}
+
+func (q *Queries) UpsertTransferLimit(ctx context.Context, arg UpsertTransferLimitParams) (TransferLimit, error) {
+	row := q.db.QueryRow(ctx, upsertTransferLimit,
+		arg.Symbol,
+		arg.Role,
+		arg.KycLevel,
+		arg.DailyLimit,
+		arg.MonthlyLimit,
+	)
+	var i TransferLimit
+	err := row.Scan(
+		&i.ID,
+		&i.Symbol,
+		&i.Role,
+		&i.DailyLimit,
+		&i.MonthlyLimit,
+		&i.CreatedTime,
+		&i.KycLevel,
+	)
+	return i, err
+}
@@ -0,0 +1,311 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: transfer_review.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const countPendingTransferReviewsByTrader = `-- name: CountPendingTransferReviewsByTrader :one
+SELECT COUNT(*) FROM transfer_reviews
+WHERE (from_trader_id = $1 OR to_trader_id = $1)
+  AND status = 'pending'
+`
+
+func (q *Queries) CountPendingTransferReviewsByTrader(ctx context.Context, fromTraderID int64) (int64, error) {
+	row := q.db.QueryRow(ctx, countPendingTransferReviewsByTrader, fromTraderID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTransfersSince = `-- name: CountTransfersSince :one
+SELECT COUNT(*) FROM records
+WHERE from_trader_id = $1
+  AND reversal_of IS NULL
+  AND created_time >= $2
+`
+
+type CountTransfersSinceParams struct {
+	FromTraderID int64     `json:"from_trader_id"`
+	Since        time.Time `json:"since"`
+}
+
+func (q *Queries) CountTransfersSince(ctx context.Context, arg CountTransfersSinceParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countTransfersSince, arg.FromTraderID, arg.Since)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createTransferReview = `-- name: CreateTransferReview :one
+INSERT INTO transfer_reviews (
+  from_trader_id,
+  to_trader_id,
+  number,
+  membername,
+  idempotency_key,
+  reasons,
+  hold_id,
+  escrow_expires_time
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING id, from_trader_id, to_trader_id, number, membername, idempotency_key, reasons, hold_id, status, record_id, reviewer, reviewed_time, created_time, escrow_expires_time, escrow_id
+`
+
+type CreateTransferReviewParams struct {
+	FromTraderID      int64              `json:"from_trader_id"`
+	ToTraderID        int64              `json:"to_trader_id"`
+	Number            int64              `json:"number"`
+	Membername        pgtype.Text        `json:"membername"`
+	IdempotencyKey    pgtype.Text        `json:"idempotency_key"`
+	Reasons           []string           `json:"reasons"`
+	HoldID            int64              `json:"hold_id"`
+	EscrowExpiresTime pgtype.Timestamptz `json:"escrow_expires_time"`
+}
+
+func (q *Queries) CreateTransferReview(ctx context.Context, arg CreateTransferReviewParams) (TransferReview, error) {
+	row := q.db.QueryRow(ctx, createTransferReview,
+		arg.FromTraderID,
+		arg.ToTraderID,
+		arg.Number,
+		arg.Membername,
+		arg.IdempotencyKey,
+		arg.Reasons,
+		arg.HoldID,
+		arg.EscrowExpiresTime,
+	)
+	var i TransferReview
+	err := row.Scan(
+		&i.ID,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.Number,
+		&i.Membername,
+		&i.IdempotencyKey,
+		&i.Reasons,
+		&i.HoldID,
+		&i.Status,
+		&i.RecordID,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+		&i.EscrowExpiresTime,
+		&i.EscrowID,
+	)
+	return i, err
+}
+
+const getTransferReview = `-- name: GetTransferReview :one
+SELECT id, from_trader_id, to_trader_id, number, membername, idempotency_key, reasons, hold_id, status, record_id, reviewer, reviewed_time, created_time, escrow_expires_time, escrow_id FROM transfer_reviews
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetTransferReview(ctx context.Context, id int64) (TransferReview, error) {
+	row := q.db.QueryRow(ctx, getTransferReview, id)
+	var i TransferReview
+	err := row.Scan(
+		&i.ID,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.Number,
+		&i.Membername,
+		&i.IdempotencyKey,
+		&i.Reasons,
+		&i.HoldID,
+		&i.Status,
+		&i.RecordID,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+		&i.EscrowExpiresTime,
+		&i.EscrowID,
+	)
+	return i, err
+}
+
+const getTransferReviewByIdempotencyKey = `-- name: GetTransferReviewByIdempotencyKey :one
+SELECT id, from_trader_id, to_trader_id, number, membername, idempotency_key, reasons, hold_id, status, record_id, reviewer, reviewed_time, created_time, escrow_expires_time, escrow_id FROM transfer_reviews
+WHERE membername = $1 AND idempotency_key = $2 LIMIT 1
+`
+
+type GetTransferReviewByIdempotencyKeyParams struct {
+	Membername     pgtype.Text `json:"membername"`
+	IdempotencyKey pgtype.Text `json:"idempotency_key"`
+}
+
+func (q *Queries) GetTransferReviewByIdempotencyKey(ctx context.Context, arg GetTransferReviewByIdempotencyKeyParams) (TransferReview, error) {
+	row := q.db.QueryRow(ctx, getTransferReviewByIdempotencyKey, arg.Membername, arg.IdempotencyKey)
+	var i TransferReview
+	err := row.Scan(
+		&i.ID,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.Number,
+		&i.Membername,
+		&i.IdempotencyKey,
+		&i.Reasons,
+		&i.HoldID,
+		&i.Status,
+		&i.RecordID,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+		&i.EscrowExpiresTime,
+		&i.EscrowID,
+	)
+	return i, err
+}
+
+const getTransferReviewForUpdate = `-- name: GetTransferReviewForUpdate :one
+SELECT id, from_trader_id, to_trader_id, number, membername, idempotency_key, reasons, hold_id, status, record_id, reviewer, reviewed_time, created_time, escrow_expires_time, escrow_id FROM transfer_reviews
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTransferReviewForUpdate(ctx context.Context, id int64) (TransferReview, error) {
+	row := q.db.QueryRow(ctx, getTransferReviewForUpdate, id)
+	var i TransferReview
+	err := row.Scan(
+		&i.ID,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.Number,
+		&i.Membername,
+		&i.IdempotencyKey,
+		&i.Reasons,
+		&i.HoldID,
+		&i.Status,
+		&i.RecordID,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+		&i.EscrowExpiresTime,
+		&i.EscrowID,
+	)
+	return i, err
+}
+
+const hasPaid = `-- name: HasPaid :one
+SELECT EXISTS (
+  SELECT 1 FROM records
+  WHERE from_trader_id = $1 AND to_trader_id = $2 AND reversal_of IS NULL
+)
+`
+
+type HasPaidParams struct {
+	FromTraderID int64 `json:"from_trader_id"`
+	ToTraderID   int64 `json:"to_trader_id"`
+}
+
+func (q *Queries) HasPaid(ctx context.Context, arg HasPaidParams) (bool, error) {
+	row := q.db.QueryRow(ctx, hasPaid, arg.FromTraderID, arg.ToTraderID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listTransferReviews = `-- name: ListTransferReviews :many
+SELECT id, from_trader_id, to_trader_id, number, membername, idempotency_key, reasons, hold_id, status, record_id, reviewer, reviewed_time, created_time, escrow_expires_time, escrow_id FROM transfer_reviews
+WHERE status = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3
+`
+
+type ListTransferReviewsParams struct {
+	Status string `json:"status"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListTransferReviews(ctx context.Context, arg ListTransferReviewsParams) ([]TransferReview, error) {
+	rows, err := q.db.Query(ctx, listTransferReviews, arg.Status, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TransferReview{}
+	for rows.Next() {
+		var i TransferReview
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromTraderID,
+			&i.ToTraderID,
+			&i.Number,
+			&i.Membername,
+			&i.IdempotencyKey,
+			&i.Reasons,
+			&i.HoldID,
+			&i.Status,
+			&i.RecordID,
+			&i.Reviewer,
+			&i.ReviewedTime,
+			&i.CreatedTime,
+			&i.EscrowExpiresTime,
+			&i.EscrowID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const settleTransferReview = `-- name: SettleTransferReview :one
+UPDATE transfer_reviews
+SET
+  status = $1,
+  reviewer = $2::varchar,
+  reviewed_time = now(),
+  record_id = $3,
+  escrow_id = $4
+WHERE id = $5
+RETURNING id, from_trader_id, to_trader_id, number, membername, idempotency_key, reasons, hold_id, status, record_id, reviewer, reviewed_time, created_time, escrow_expires_time, escrow_id
+`
+
+type SettleTransferReviewParams struct {
+	Status   string      `json:"status"`
+	Reviewer string      `json:"reviewer"`
+	RecordID pgtype.Int8 `json:"record_id"`
+	EscrowID pgtype.Int8 `json:"escrow_id"`
+	ID       int64       `json:"id"`
+}
+
+func (q *Queries) SettleTransferReview(ctx context.Context, arg SettleTransferReviewParams) (TransferReview, error) {
+	row := q.db.QueryRow(ctx, settleTransferReview,
+		arg.Status,
+		arg.Reviewer,
+		arg.RecordID,
+		arg.EscrowID,
+		arg.ID,
+	)
+	var i TransferReview
+	err := row.Scan(
+		&i.ID,
+		&i.FromTraderID,
+		&i.ToTraderID,
+		&i.Number,
+		&i.Membername,
+		&i.IdempotencyKey,
+		&i.Reasons,
+		&i.HoldID,
+		&i.Status,
+		&i.RecordID,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+		&i.EscrowExpiresTime,
+		&i.EscrowID,
+	)
+	return i, err
+}
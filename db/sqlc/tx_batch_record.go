@@ -0,0 +1,199 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/YuanData/allegro-trade/screening"
+)
+
+var ErrSymbolMismatch = errors.New("traders hold different symbols")
+
+// BatchLegError tells which leg of a batch made the whole batch fail.
+type BatchLegError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchLegError) Error() string {
+	return fmt.Sprintf("leg %d: %s", e.Index, e.Err)
+}
+
+func (e *BatchLegError) Unwrap() error {
+	return e.Err
+}
+
+type BatchLegParams struct {
+	ToTraderID int64 `json:"to_trader_id"`
+	Number     int64 `json:"number"`
+}
+
+type BatchRecordTxParams struct {
+	FromTraderID int64            `json:"from_trader_id"`
+	Legs         []BatchLegParams `json:"legs"`
+	Membername   string           `json:"membername"`
+}
+
+type BatchLegResult struct {
+	Record     Record       `json:"record"`
+	ToTrader   Trader       `json:"to_trader"`
+	FromDetail Detail       `json:"from_detail"`
+	ToDetail   Detail       `json:"to_detail"`
+	Fee        FeeBreakdown `json:"fee"`
+	// Review is set instead of the record when screening held the leg
+	Review TransferReview `json:"review"`
+}
+
+// BatchRecordTxResult lists leg results in the order of the given legs.
+// FromTrader holds the rest after the whole batch was applied.
+type BatchRecordTxResult struct {
+	FromTrader Trader           `json:"from_trader"`
+	Legs       []BatchLegResult `json:"legs"`
+}
+
+// BatchRecordTx pays many traders from one trader in a single transaction, so
+// either every leg is recorded or none is. All traders of the batch are locked
+// in id order before the first leg is applied, the same order every other
+// transaction uses, so concurrent batches cannot deadlock with each other.
+//
+// The screener of the store sees the legs to each recipient together, as one
+// transfer of their total, so a batch cannot be split into small legs to slip
+// under the rules. A rejected recipient fails the whole batch; the legs to a
+// recipient held for review are kept on hold one by one while the other legs
+// are recorded, and the batch then comes back with ErrTransferHeld.
+func (store *SQLStore) BatchRecordTx(ctx context.Context, arg BatchRecordTxParams) (BatchRecordTxResult, error) {
+	var result BatchRecordTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		fromTrader, err := q.GetTrader(ctx, arg.FromTraderID)
+		if err != nil {
+			return err
+		}
+
+		// fees of every leg go to the house trader, so it is locked up front too
+		house, err := houseTrader(ctx, q, fromTrader.Symbol)
+		if err != nil {
+			return err
+		}
+
+		verdicts, err := store.screenLegs(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		traderIDs := make([]int64, 0, len(arg.Legs)+2)
+		traderIDs = append(traderIDs, arg.FromTraderID, house.ID)
+		for _, leg := range arg.Legs {
+			traderIDs = append(traderIDs, leg.ToTraderID)
+		}
+
+		traders, err := lockTraders(ctx, q, traderIDs...)
+		if err != nil {
+			return err
+		}
+
+		if err = checkActive(traders[arg.FromTraderID]); err != nil {
+			return err
+		}
+
+		for i, leg := range arg.Legs {
+			if traders[leg.ToTraderID].Symbol != fromTrader.Symbol {
+				return &BatchLegError{Index: i, Err: ErrSymbolMismatch}
+			}
+			if err = checkActive(traders[leg.ToTraderID]); err != nil {
+				return &BatchLegError{Index: i, Err: err}
+			}
+		}
+
+		var total int64
+		result.Legs = make([]BatchLegResult, 0, len(arg.Legs))
+		for i, leg := range arg.Legs {
+			legArg := RecordTxParams{
+				FromTraderID: arg.FromTraderID,
+				ToTraderID:   leg.ToTraderID,
+				Number:       leg.Number,
+				Membername:   arg.Membername,
+			}
+
+			if verdict := verdicts[leg.ToTraderID]; verdict.Decision == screening.Review {
+				review, fromTrader, err := holdForReview(ctx, q, legArg, verdict.Reasons, time.Time{})
+				if err != nil {
+					return &BatchLegError{Index: i, Err: err}
+				}
+
+				result.FromTrader = fromTrader
+				result.Legs = append(result.Legs, BatchLegResult{
+					ToTrader: traders[leg.ToTraderID],
+					Review:   review,
+				})
+				continue
+			}
+
+			total += leg.Number
+
+			record, err := recordMoney(ctx, q, legArg, pgtype.Int8{}, true)
+			if err != nil {
+				return &BatchLegError{Index: i, Err: err}
+			}
+
+			result.FromTrader = record.FromTrader
+			result.Legs = append(result.Legs, BatchLegResult{
+				Record:     record.Record,
+				ToTrader:   record.ToTrader,
+				FromDetail: record.FromDetail,
+				ToDetail:   record.ToDetail,
+				Fee:        record.Fee,
+			})
+		}
+
+		// the recorded legs count against the limits together, as one outgoing amount
+		if total == 0 {
+			return nil
+		}
+		return checkTransferLimit(ctx, q, result.FromTrader, total)
+	})
+
+	if err == nil {
+		for _, leg := range result.Legs {
+			if leg.Review.ID != 0 {
+				return result, fmt.Errorf("batch leg transfer review [%d]: %w", leg.Review.ID, ErrTransferHeld)
+			}
+		}
+	}
+	return result, err
+}
+
+// screenLegs screens the total of the legs to each recipient of a batch and
+// returns the verdicts by recipient trader id. A rejected recipient is reported
+// at its first leg.
+func (store *SQLStore) screenLegs(ctx context.Context, q *Queries, arg BatchRecordTxParams) (map[int64]screening.Verdict, error) {
+	totals := make(map[int64]int64, len(arg.Legs))
+	firstLeg := make(map[int64]int, len(arg.Legs))
+	recipients := make([]int64, 0, len(arg.Legs))
+	for i, leg := range arg.Legs {
+		if _, ok := totals[leg.ToTraderID]; !ok {
+			firstLeg[leg.ToTraderID] = i
+			recipients = append(recipients, leg.ToTraderID)
+		}
+		totals[leg.ToTraderID] += leg.Number
+	}
+
+	verdicts := make(map[int64]screening.Verdict, len(recipients))
+	for _, toTraderID := range recipients {
+		verdict, err := store.screen(ctx, q, RecordTxParams{
+			FromTraderID: arg.FromTraderID,
+			ToTraderID:   toTraderID,
+			Number:       totals[toTraderID],
+			Membername:   arg.Membername,
+		})
+		if err != nil {
+			return nil, &BatchLegError{Index: firstLeg[toTraderID], Err: err}
+		}
+		verdicts[toTraderID] = verdict
+	}
+	return verdicts, nil
+}
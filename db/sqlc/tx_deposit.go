@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+
+	"github.com/YuanData/allegro-trade/util"
+)
+
+type DepositTxParams struct {
+	TraderID  int64  `json:"trader_id"`
+	Number    int64  `json:"number"`
+	Reference string `json:"reference"`
+}
+
+type DepositTxResult struct {
+	Deposit Deposit `json:"deposit"`
+	Trader  Trader  `json:"trader"`
+	Detail  Detail  `json:"detail"`
+}
+
+// DepositTx credits funds that arrived from outside to an active member trader,
+// debiting the omnibus system trader of the same symbol.
+func (store *SQLStore) DepositTx(ctx context.Context, arg DepositTxParams) (DepositTxResult, error) {
+	var result DepositTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		trader, err := q.GetTrader(ctx, arg.TraderID)
+		if err != nil {
+			return err
+		}
+
+		omnibus, err := systemTrader(ctx, q, trader.Symbol)
+		if err != nil {
+			return err
+		}
+
+		entry, err := postJournalEntry(ctx, q, PostJournalEntryParams{
+			Kind: util.DepositEntry,
+			Postings: []PostingParams{
+				{TraderID: omnibus.ID, Number: -arg.Number},
+				{TraderID: arg.TraderID, Number: arg.Number},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Detail, result.Trader = entry.Details[1], entry.Traders[1]
+		if err = checkActive(result.Trader); err != nil {
+			return err
+		}
+
+		result.Deposit, err = q.CreateDeposit(ctx, CreateDepositParams{
+			TraderID:  arg.TraderID,
+			Number:    arg.Number,
+			Reference: arg.Reference,
+			EntryID:   entry.Entry.ID,
+		})
+		return err
+	})
+
+	return result, err
+}
@@ -0,0 +1,353 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YuanData/allegro-trade/screening"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrEscrowNotOpen         = errors.New("escrow is not open")
+	ErrEscrowNotDisputed     = errors.New("escrow is not disputed")
+	ErrEscrowNotExpired      = errors.New("escrow has not expired yet")
+	ErrNotEscrowParty        = errors.New("member is neither buyer nor seller of the escrow")
+	ErrEscrowSellerConfirmed = errors.New("seller already confirmed the escrow")
+	ErrSameEscrowParty       = errors.New("buyer and seller must be different members")
+)
+
+type OpenEscrowTxParams struct {
+	BuyerTraderID  int64     `json:"buyer_trader_id"`
+	SellerTraderID int64     `json:"seller_trader_id"`
+	Number         int64     `json:"number"`
+	ExpiresTime    time.Time `json:"expires_time"`
+}
+
+type EscrowActionParams struct {
+	EscrowID   int64  `json:"escrow_id"`
+	Membername string `json:"membername"`
+}
+
+type ResolveEscrowTxParams struct {
+	EscrowID int64  `json:"escrow_id"`
+	Resolver string `json:"resolver"`
+	Release  bool   `json:"release"`
+}
+
+// EscrowTxResult holds the escrow after the action. Trader is the member trader
+// whose funds moved: the buyer when funding or refunding, the seller on release.
+// It is empty when the action moved no money.
+type EscrowTxResult struct {
+	Escrow Escrow `json:"escrow"`
+	Trader Trader `json:"trader"`
+	// Review is set instead of the escrow when screening held the funding
+	Review TransferReview `json:"review"`
+}
+
+// OpenEscrowTx moves the buyer's funds into the escrow trader of the symbol,
+// where they stay until both parties confirm, the escrow is cancelled or it expires.
+// The funding counts against the transfer limits of the buyer. The screener of
+// the store sees the funding as a transfer from buyer to seller; one it holds
+// for review comes back with ErrTransferHeld and the review in the result, and
+// the escrow is only opened once the review is approved.
+func (store *SQLStore) OpenEscrowTx(ctx context.Context, arg OpenEscrowTxParams) (EscrowTxResult, error) {
+	var result EscrowTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		buyerTrader, sellerTrader, err := escrowParties(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		funding := RecordTxParams{
+			FromTraderID: arg.BuyerTraderID,
+			ToTraderID:   arg.SellerTraderID,
+			Number:       arg.Number,
+		}
+		verdict, err := store.screen(ctx, q, funding)
+		if err != nil {
+			return err
+		}
+
+		if verdict.Decision == screening.Review {
+			result.Review, result.Trader, err = holdForReview(ctx, q, funding, verdict.Reasons, arg.ExpiresTime)
+			return err
+		}
+
+		result, err = fundEscrow(ctx, q, arg, buyerTrader, sellerTrader)
+		return err
+	})
+
+	if err == nil && result.Review.ID != 0 {
+		return result, fmt.Errorf("transfer review [%d]: %w", result.Review.ID, ErrTransferHeld)
+	}
+	return result, err
+}
+
+// escrowParties reads the buyer and seller traders of an escrow about to be
+// opened and makes sure they can trade with each other.
+func escrowParties(ctx context.Context, q *Queries, arg OpenEscrowTxParams) (buyerTrader Trader, sellerTrader Trader, err error) {
+	buyerTrader, err = q.GetTrader(ctx, arg.BuyerTraderID)
+	if err != nil {
+		return
+	}
+
+	sellerTrader, err = q.GetTrader(ctx, arg.SellerTraderID)
+	if err != nil {
+		return
+	}
+
+	if buyerTrader.Symbol != sellerTrader.Symbol {
+		err = ErrSymbolMismatch
+		return
+	}
+	if buyerTrader.Holder == sellerTrader.Holder {
+		err = ErrSameEscrowParty
+	}
+	return
+}
+
+// fundEscrow moves the funds of the buyer into the escrow trader and opens the escrow.
+func fundEscrow(ctx context.Context, q *Queries, arg OpenEscrowTxParams, buyerTrader Trader, sellerTrader Trader) (EscrowTxResult, error) {
+	var result EscrowTxResult
+
+	escrowTrader, err := q.GetTraderByHolderSymbol(ctx, GetTraderByHolderSymbolParams{
+		Holder: util.EscrowMembername,
+		Symbol: buyerTrader.Symbol,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	// the seller is checked too, so funds are not escrowed for a trader that cannot be paid
+	traders, err := lockTraders(ctx, q, buyerTrader.ID, sellerTrader.ID, escrowTrader.ID)
+	if err != nil {
+		return result, err
+	}
+	if err = checkActive(traders[buyerTrader.ID]); err != nil {
+		return result, err
+	}
+	if err = checkActive(traders[sellerTrader.ID]); err != nil {
+		return result, err
+	}
+
+	entry, err := postJournalEntry(ctx, q, PostJournalEntryParams{
+		Kind: util.EscrowFundEntry,
+		Postings: []PostingParams{
+			{TraderID: buyerTrader.ID, Number: -arg.Number},
+			{TraderID: escrowTrader.ID, Number: arg.Number},
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.Trader = entry.Traders[0]
+
+	result.Escrow, err = q.CreateEscrow(ctx, CreateEscrowParams{
+		Buyer:          buyerTrader.Holder,
+		Seller:         sellerTrader.Holder,
+		BuyerTraderID:  buyerTrader.ID,
+		SellerTraderID: sellerTrader.ID,
+		EscrowTraderID: escrowTrader.ID,
+		Number:         arg.Number,
+		FundEntryID:    entry.Entry.ID,
+		ExpiresTime:    arg.ExpiresTime,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	return result, checkTransferLimit(ctx, q, result.Trader, arg.Number)
+}
+
+// ConfirmEscrowTx records the confirmation of one party. Once both buyer and
+// seller confirmed, the escrowed funds are released to the seller.
+func (store *SQLStore) ConfirmEscrowTx(ctx context.Context, arg EscrowActionParams) (EscrowTxResult, error) {
+	var result EscrowTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		escrow, err := lockOpenEscrow(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		if arg.Membername == escrow.Buyer {
+			escrow.BuyerConfirmed = true
+		} else {
+			escrow.SellerConfirmed = true
+		}
+
+		if escrow.BuyerConfirmed && escrow.SellerConfirmed {
+			result, err = settleEscrow(ctx, q, escrow, util.ReleasedEscrowStatus, "")
+			return err
+		}
+
+		result.Escrow, err = q.UpdateEscrow(ctx, UpdateEscrowParams{
+			ID:              escrow.ID,
+			Status:          escrow.Status,
+			BuyerConfirmed:  escrow.BuyerConfirmed,
+			SellerConfirmed: escrow.SellerConfirmed,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// DisputeEscrowTx freezes an open escrow. A disputed escrow no longer expires;
+// it stays until a priest resolves it.
+func (store *SQLStore) DisputeEscrowTx(ctx context.Context, arg EscrowActionParams) (EscrowTxResult, error) {
+	var result EscrowTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		escrow, err := lockOpenEscrow(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		result.Escrow, err = q.UpdateEscrow(ctx, UpdateEscrowParams{
+			ID:              escrow.ID,
+			Status:          util.DisputedEscrowStatus,
+			BuyerConfirmed:  escrow.BuyerConfirmed,
+			SellerConfirmed: escrow.SellerConfirmed,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// CancelEscrowTx refunds an open escrow to the buyer. The seller may cancel at
+// any time, while the buyer may only cancel until the seller has confirmed.
+func (store *SQLStore) CancelEscrowTx(ctx context.Context, arg EscrowActionParams) (EscrowTxResult, error) {
+	var result EscrowTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		escrow, err := lockOpenEscrow(ctx, q, arg)
+		if err != nil {
+			return err
+		}
+
+		if arg.Membername == escrow.Buyer && escrow.SellerConfirmed {
+			return fmt.Errorf("escrow [%d]: %w", escrow.ID, ErrEscrowSellerConfirmed)
+		}
+
+		result, err = settleEscrow(ctx, q, escrow, util.CancelledEscrowStatus, "")
+		return err
+	})
+
+	return result, err
+}
+
+// ExpireEscrowTx refunds an open escrow whose time ran out to the buyer.
+func (store *SQLStore) ExpireEscrowTx(ctx context.Context, escrowID int64) (EscrowTxResult, error) {
+	var result EscrowTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		escrow, err := q.GetEscrowForUpdate(ctx, escrowID)
+		if err != nil {
+			return err
+		}
+
+		if escrow.Status != util.OpenEscrowStatus {
+			return fmt.Errorf("escrow [%d] is %s: %w", escrow.ID, escrow.Status, ErrEscrowNotOpen)
+		}
+		if time.Now().Before(escrow.ExpiresTime) {
+			return fmt.Errorf("escrow [%d]: %w", escrow.ID, ErrEscrowNotExpired)
+		}
+
+		result, err = settleEscrow(ctx, q, escrow, util.ExpiredEscrowStatus, "")
+		return err
+	})
+
+	return result, err
+}
+
+// ResolveEscrowTx settles a disputed escrow on a priest's decision, either
+// releasing the funds to the seller or refunding them to the buyer.
+func (store *SQLStore) ResolveEscrowTx(ctx context.Context, arg ResolveEscrowTxParams) (EscrowTxResult, error) {
+	var result EscrowTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		escrow, err := q.GetEscrowForUpdate(ctx, arg.EscrowID)
+		if err != nil {
+			return err
+		}
+
+		if escrow.Status != util.DisputedEscrowStatus {
+			return fmt.Errorf("escrow [%d] is %s: %w", escrow.ID, escrow.Status, ErrEscrowNotDisputed)
+		}
+
+		status := util.CancelledEscrowStatus
+		if arg.Release {
+			status = util.ReleasedEscrowStatus
+		}
+
+		result, err = settleEscrow(ctx, q, escrow, status, arg.Resolver)
+		return err
+	})
+
+	return result, err
+}
+
+// lockOpenEscrow locks an escrow that a party acts on, making sure it is still open.
+func lockOpenEscrow(ctx context.Context, q *Queries, arg EscrowActionParams) (Escrow, error) {
+	escrow, err := q.GetEscrowForUpdate(ctx, arg.EscrowID)
+	if err != nil {
+		return escrow, err
+	}
+
+	if arg.Membername != escrow.Buyer && arg.Membername != escrow.Seller {
+		return escrow, fmt.Errorf("escrow [%d]: %w", escrow.ID, ErrNotEscrowParty)
+	}
+	if escrow.Status != util.OpenEscrowStatus {
+		return escrow, fmt.Errorf("escrow [%d] is %s: %w", escrow.ID, escrow.Status, ErrEscrowNotOpen)
+	}
+
+	return escrow, nil
+}
+
+// settleEscrow pays the escrowed funds out, to the seller on release and back to
+// the buyer otherwise, and closes the escrow with the given status.
+func settleEscrow(ctx context.Context, q *Queries, escrow Escrow, status string, resolver string) (EscrowTxResult, error) {
+	var result EscrowTxResult
+
+	kind, traderID := util.EscrowRefundEntry, escrow.BuyerTraderID
+	if status == util.ReleasedEscrowStatus {
+		kind, traderID = util.EscrowReleaseEntry, escrow.SellerTraderID
+	}
+
+	entry, err := postJournalEntry(ctx, q, PostJournalEntryParams{
+		Kind: kind,
+		Postings: []PostingParams{
+			{TraderID: escrow.EscrowTraderID, Number: -escrow.Number},
+			{TraderID: traderID, Number: escrow.Number},
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	result.Trader = entry.Traders[1]
+	if err = checkActive(result.Trader); err != nil {
+		return result, err
+	}
+
+	result.Escrow, err = q.UpdateEscrow(ctx, UpdateEscrowParams{
+		ID:              escrow.ID,
+		Status:          status,
+		BuyerConfirmed:  escrow.BuyerConfirmed,
+		SellerConfirmed: escrow.SellerConfirmed,
+		SettleEntryID:   pgtype.Int8{Int64: entry.Entry.ID, Valid: true},
+		Resolver: pgtype.Text{
+			String: resolver,
+			Valid:  resolver != "",
+		},
+	})
+	return result, err
+}
@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/YuanData/allegro-trade/util"
+)
+
+// ExchangeRateScale is the fixed-point scale of exchange rates,
+// so a rate of 150000000 means 1.5 units of the target symbol per source unit.
+const ExchangeRateScale = 100_000_000
+
+var (
+	ErrSameSymbol           = errors.New("exchange needs traders of two different symbols")
+	ErrInvalidExchangeRate  = errors.New("exchange rate must be positive")
+	ErrExchangeAmountTooLow = errors.New("exchanged amount rounds down to zero")
+	ErrExchangeOverflow     = errors.New("exchanged amount overflows")
+)
+
+type ExchangeTxParams struct {
+	FromTraderID int64 `json:"from_trader_id"`
+	ToTraderID   int64 `json:"to_trader_id"`
+	FromNumber   int64 `json:"from_number"`
+	Rate         int64 `json:"rate"`
+}
+
+type ExchangeTxResult struct {
+	Exchange   Exchange `json:"exchange"`
+	FromTrader Trader   `json:"from_trader"`
+	ToTrader   Trader   `json:"to_trader"`
+	FromDetail Detail   `json:"from_detail"`
+	ToDetail   Detail   `json:"to_detail"`
+}
+
+// ExchangeTx debits FromNumber from one trader and credits the converted amount,
+// rounded down, to a trader of another symbol. The system traders of both symbols
+// take the other side, so the journal entry balances per symbol, and an
+// exchanges row links the two legs together with the quoted rate.
+func (store *SQLStore) ExchangeTx(ctx context.Context, arg ExchangeTxParams) (ExchangeTxResult, error) {
+	var result ExchangeTxResult
+
+	toNumber, err := ExchangeAmount(arg.FromNumber, arg.Rate)
+	if err != nil {
+		return result, err
+	}
+
+	err = store.execTx(ctx, func(q *Queries) error {
+		fromTrader, err := q.GetTrader(ctx, arg.FromTraderID)
+		if err != nil {
+			return err
+		}
+
+		toTrader, err := q.GetTrader(ctx, arg.ToTraderID)
+		if err != nil {
+			return err
+		}
+
+		if fromTrader.Symbol == toTrader.Symbol {
+			return ErrSameSymbol
+		}
+
+		fromSystem, err := systemTrader(ctx, q, fromTrader.Symbol)
+		if err != nil {
+			return err
+		}
+
+		toSystem, err := systemTrader(ctx, q, toTrader.Symbol)
+		if err != nil {
+			return err
+		}
+
+		entry, err := postJournalEntry(ctx, q, PostJournalEntryParams{
+			Kind: util.ExchangeEntry,
+			Postings: []PostingParams{
+				{TraderID: arg.FromTraderID, Number: -arg.FromNumber},
+				{TraderID: fromSystem.ID, Number: arg.FromNumber},
+				{TraderID: toSystem.ID, Number: -toNumber},
+				{TraderID: arg.ToTraderID, Number: toNumber},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.FromDetail, result.ToDetail = entry.Details[0], entry.Details[3]
+		result.FromTrader, result.ToTrader = entry.Traders[0], entry.Traders[3]
+		if err = checkActive(result.FromTrader); err != nil {
+			return err
+		}
+		if err = checkActive(result.ToTrader); err != nil {
+			return err
+		}
+
+		result.Exchange, err = q.CreateExchange(ctx, CreateExchangeParams{
+			FromTraderID: arg.FromTraderID,
+			ToTraderID:   arg.ToTraderID,
+			FromSymbol:   fromTrader.Symbol,
+			ToSymbol:     toTrader.Symbol,
+			FromNumber:   arg.FromNumber,
+			ToNumber:     toNumber,
+			Rate:         arg.Rate,
+			FromDetailID: result.FromDetail.ID,
+			ToDetailID:   result.ToDetail.ID,
+			EntryID:      entry.Entry.ID,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// ExchangeAmount converts an amount at a rate scaled by ExchangeRateScale, rounding down.
+func ExchangeAmount(number int64, rate int64) (int64, error) {
+	if rate <= 0 {
+		return 0, ErrInvalidExchangeRate
+	}
+
+	amount := new(big.Int).Mul(big.NewInt(number), big.NewInt(rate))
+	amount.Quo(amount, big.NewInt(ExchangeRateScale))
+	if !amount.IsInt64() {
+		return 0, ErrExchangeOverflow
+	}
+	if amount.Sign() <= 0 {
+		return 0, ErrExchangeAmountTooLow
+	}
+	return amount.Int64(), nil
+}
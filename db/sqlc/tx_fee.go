@@ -0,0 +1,147 @@
+package db
+
+import (
+	"context"
+	"errors"
+
+	"github.com/YuanData/allegro-trade/util"
+)
+
+// FeeBreakdown explains the fee charged on a transfer. RuleID is zero when no
+// rule applied. RateBps is the rate that was used, which for a tiered rule is the
+// rate of the tier reached by the sender's MonthlyVolume before this transfer.
+type FeeBreakdown struct {
+	RuleID        int64  `json:"rule_id"`
+	Kind          string `json:"kind"`
+	RateBps       int32  `json:"rate_bps"`
+	MonthlyVolume int64  `json:"monthly_volume"`
+	Fee           int64  `json:"fee"`
+	FeeDetail     Detail `json:"fee_detail"`
+	HouseDetail   Detail `json:"house_detail"`
+	HouseTrader   Trader `json:"house_trader"`
+}
+
+type FeeTierParams struct {
+	MinVolume int64 `json:"min_volume"`
+	RateBps   int32 `json:"rate_bps"`
+}
+
+type SetFeeRuleTxParams struct {
+	UpsertFeeRuleParams
+	Tiers []FeeTierParams `json:"tiers"`
+}
+
+type FeeRuleResult struct {
+	Rule  FeeRule   `json:"rule"`
+	Tiers []FeeTier `json:"tiers"`
+}
+
+// SetFeeRuleTx creates the fee rule of a symbol and role, or replaces it
+// together with its tiers.
+func (store *SQLStore) SetFeeRuleTx(ctx context.Context, arg SetFeeRuleTxParams) (FeeRuleResult, error) {
+	var result FeeRuleResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Rule, err = q.UpsertFeeRule(ctx, arg.UpsertFeeRuleParams)
+		if err != nil {
+			return err
+		}
+
+		err = q.DeleteFeeTiers(ctx, result.Rule.ID)
+		if err != nil {
+			return err
+		}
+
+		result.Tiers = make([]FeeTier, 0, len(arg.Tiers))
+		for _, tier := range arg.Tiers {
+			feeTier, err := q.CreateFeeTier(ctx, CreateFeeTierParams{
+				RuleID:    result.Rule.ID,
+				MinVolume: tier.MinVolume,
+				RateBps:   tier.RateBps,
+			})
+			if err != nil {
+				return err
+			}
+			result.Tiers = append(result.Tiers, feeTier)
+		}
+
+		return nil
+	})
+
+	return result, err
+}
+
+// transferFee works out the fee the sender pays on top of a transfer, using the
+// rule for the sender's role or else the rule for every role of the symbol.
+func transferFee(ctx context.Context, q *Queries, fromTraderID int64, number int64) (FeeBreakdown, error) {
+	var fee FeeBreakdown
+
+	trader, err := q.GetTrader(ctx, fromTraderID)
+	if err != nil {
+		return fee, err
+	}
+
+	member, err := q.GetMember(ctx, trader.Holder)
+	if err != nil {
+		return fee, err
+	}
+
+	rule, err := q.GetApplicableFeeRule(ctx, GetApplicableFeeRuleParams{
+		Symbol: trader.Symbol,
+		Role:   member.Role,
+	})
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			return fee, nil
+		}
+		return fee, err
+	}
+
+	fee.RuleID, fee.Kind, fee.RateBps = rule.ID, rule.Kind, rule.RateBps
+
+	switch rule.Kind {
+	case util.FlatFee:
+		fee.Fee = rule.FlatFee
+	case util.PercentageFee:
+		fee.Fee = util.RateFee(number, rule.RateBps, rule.MinFee, rule.MaxFee)
+	case util.TieredFee:
+		fee.MonthlyVolume, err = q.GetMonthlyVolume(ctx, GetMonthlyVolumeParams{
+			Holder: trader.Holder,
+			Symbol: trader.Symbol,
+		})
+		if err != nil {
+			return fee, err
+		}
+
+		tiers, err := q.ListFeeTiers(ctx, rule.ID)
+		if err != nil {
+			return fee, err
+		}
+
+		for _, tier := range tiers {
+			if fee.MonthlyVolume >= tier.MinVolume {
+				fee.RateBps = tier.RateBps
+			}
+		}
+		fee.Fee = util.RateFee(number, fee.RateBps, rule.MinFee, rule.MaxFee)
+	}
+
+	if fee.Fee > 0 {
+		fee.HouseTrader, err = houseTrader(ctx, q, trader.Symbol)
+		if err != nil {
+			return fee, err
+		}
+	}
+
+	return fee, nil
+}
+
+// houseTrader returns the trader that collects the fees of a symbol.
+func houseTrader(ctx context.Context, q *Queries, symbol string) (Trader, error) {
+	return q.GetTraderByHolderSymbol(ctx, GetTraderByHolderSymbolParams{
+		Holder: util.HouseMembername,
+		Symbol: symbol,
+	})
+}
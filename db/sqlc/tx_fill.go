@@ -0,0 +1,234 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/YuanData/allegro-trade/util"
+)
+
+var ErrOrderNotOpen = errors.New("order is not open")
+
+// OrderError names the order a fill could not be settled for, so the matching
+// engine can take a maker that can no longer trade out of the book rather than
+// let it block every taker after it.
+type OrderError struct {
+	OrderID int64
+	Err     error
+}
+
+func (e *OrderError) Error() string {
+	return fmt.Sprintf("order [%d]: %s", e.OrderID, e.Err)
+}
+
+func (e *OrderError) Unwrap() error {
+	return e.Err
+}
+
+type FillTxParams struct {
+	MakerOrderID int64 `json:"maker_order_id"`
+	TakerOrderID int64 `json:"taker_order_id"`
+	Price        int64 `json:"price"`
+	Quantity     int64 `json:"quantity"`
+}
+
+type FillTxResult struct {
+	Fill        Fill           `json:"fill"`
+	MakerOrder  Order          `json:"maker_order"`
+	TakerOrder  Order          `json:"taker_order"`
+	BaseRecord  RecordTxResult `json:"base_record"`
+	QuoteRecord RecordTxResult `json:"quote_record"`
+}
+
+// FillTx settles a match between a resting maker order and an incoming taker order.
+// The base leg moves from the seller to the buyer and the quote leg moves back,
+// both as regular records so that each side gets its own details. The quote leg
+// is the quantity at the price, see quoteNumber. A fill worth nothing is refused
+// with ErrOrderTooSmall, blamed on the order it would have completed.
+func (store *SQLStore) FillTx(ctx context.Context, arg FillTxParams) (FillTxResult, error) {
+	var result FillTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		if arg.MakerOrderID < arg.TakerOrderID {
+			result.MakerOrder, result.TakerOrder, err = lockOrders(ctx, q, arg.MakerOrderID, arg.TakerOrderID)
+		} else {
+			result.TakerOrder, result.MakerOrder, err = lockOrders(ctx, q, arg.TakerOrderID, arg.MakerOrderID)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, order := range []Order{result.MakerOrder, result.TakerOrder} {
+			if order.Status != util.OpenOrderStatus || order.Quantity-order.Filled < arg.Quantity {
+				return &OrderError{OrderID: order.ID, Err: ErrOrderNotOpen}
+			}
+		}
+
+		base, err := q.GetSymbol(ctx, result.MakerOrder.BaseSymbol)
+		if err != nil {
+			return err
+		}
+
+		quote, err := quoteNumber(arg.Quantity, arg.Price, base.Decimals)
+		if err != nil {
+			return err
+		}
+		if quote == 0 {
+			// a fill is as large as the smaller rest of the two orders, so that rest is too small
+			dust := result.MakerOrder
+			if dust.Quantity-dust.Filled != arg.Quantity {
+				dust = result.TakerOrder
+			}
+			return &OrderError{OrderID: dust.ID, Err: ErrOrderTooSmall}
+		}
+
+		buyer, seller := result.TakerOrder, result.MakerOrder
+		if buyer.Side != util.BuySide {
+			buyer, seller = seller, buyer
+		}
+
+		holds, err := lockOrderHolds(ctx, q, result.MakerOrder, result.TakerOrder)
+		if err != nil {
+			return err
+		}
+
+		traders, err := lockTraders(ctx, q, seller.BaseTraderID, buyer.BaseTraderID, buyer.QuoteTraderID, seller.QuoteTraderID)
+		if err != nil {
+			return err
+		}
+
+		for _, order := range []Order{seller, buyer} {
+			for _, traderID := range []int64{order.BaseTraderID, order.QuoteTraderID} {
+				if err = checkActive(traders[traderID]); err != nil {
+					return orderFailure(order.ID, err)
+				}
+			}
+		}
+
+		result.BaseRecord, err = settleLeg(ctx, q, holds[seller.ID], RecordTxParams{
+			FromTraderID: seller.BaseTraderID,
+			ToTraderID:   buyer.BaseTraderID,
+			Number:       arg.Quantity,
+		})
+		if err != nil {
+			return orderFailure(seller.ID, err)
+		}
+
+		result.QuoteRecord, err = settleLeg(ctx, q, holds[buyer.ID], RecordTxParams{
+			FromTraderID: buyer.QuoteTraderID,
+			ToTraderID:   seller.QuoteTraderID,
+			Number:       quote,
+		})
+		if err != nil {
+			return orderFailure(buyer.ID, err)
+		}
+
+		result.MakerOrder, err = q.AddOrderFilled(ctx, AddOrderFilledParams{
+			ID:       arg.MakerOrderID,
+			Quantity: arg.Quantity,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.TakerOrder, err = q.AddOrderFilled(ctx, AddOrderFilledParams{
+			ID:       arg.TakerOrderID,
+			Quantity: arg.Quantity,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, order := range []Order{result.MakerOrder, result.TakerOrder} {
+			if err = releaseOrderHold(ctx, q, order); err != nil {
+				return err
+			}
+		}
+
+		result.Fill, err = q.CreateFill(ctx, CreateFillParams{
+			MakerOrderID:  arg.MakerOrderID,
+			TakerOrderID:  arg.TakerOrderID,
+			Price:         arg.Price,
+			Quantity:      arg.Quantity,
+			BaseRecordID:  result.BaseRecord.Record.ID,
+			QuoteRecordID: result.QuoteRecord.Record.ID,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// orderFailure blames the order paying a leg for the errors only its side can
+// cause, and passes any other error on as it is.
+func orderFailure(orderID int64, err error) error {
+	if errors.Is(err, ErrInsufficientFunds) || errors.Is(err, ErrTraderNotActive) {
+		return &OrderError{OrderID: orderID, Err: err}
+	}
+	return err
+}
+
+func lockOrders(ctx context.Context, q *Queries, orderID1 int64, orderID2 int64) (order1 Order, order2 Order, err error) {
+	order1, err = q.GetOrderForUpdate(ctx, orderID1)
+	if err != nil {
+		return
+	}
+
+	order2, err = q.GetOrderForUpdate(ctx, orderID2)
+	return
+}
+
+// lockOrderHolds locks the active holds of the given orders, keyed by order id,
+// before any trader is locked.
+func lockOrderHolds(ctx context.Context, q *Queries, orders ...Order) (map[int64]Hold, error) {
+	holds := make(map[int64]Hold)
+	for _, order := range orders {
+		if !order.HoldID.Valid {
+			continue
+		}
+		hold, err := q.GetHoldForUpdate(ctx, order.HoldID.Int64)
+		if err != nil {
+			return nil, err
+		}
+		if hold.Status == util.ActiveHoldStatus {
+			holds[order.ID] = hold
+		}
+	}
+	return holds, nil
+}
+
+// settleLeg pays one side of a fill, out of the order's hold when it has one.
+// Neither leg is charged a transfer fee.
+func settleLeg(ctx context.Context, q *Queries, hold Hold, arg RecordTxParams) (RecordTxResult, error) {
+	if hold.ID == 0 {
+		return settleMoney(ctx, q, arg)
+	}
+
+	_, record, err := captureHold(ctx, q, hold, arg.ToTraderID, arg.Number)
+	return record, err
+}
+
+// lockTraders takes row locks on every given trader in ascending id order, so that
+// transactions touching more than two traders cannot deadlock each other.
+func lockTraders(ctx context.Context, q *Queries, traderIDs ...int64) (map[int64]Trader, error) {
+	ids := make([]int64, len(traderIDs))
+	copy(ids, traderIDs)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	traders := make(map[int64]Trader, len(ids))
+	for _, id := range ids {
+		if _, ok := traders[id]; ok {
+			continue
+		}
+		trader, err := q.GetTraderForUpdate(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		traders[id] = trader
+	}
+	return traders, nil
+}
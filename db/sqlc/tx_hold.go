@@ -0,0 +1,277 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrHoldNotActive  = errors.New("hold is not active")
+	ErrHoldExceeded   = errors.New("amount exceeds what remains on the hold")
+	ErrHoldNotExpired = errors.New("hold has not expired")
+)
+
+type PlaceHoldParams struct {
+	TraderID    int64     `json:"trader_id"`
+	Number      int64     `json:"number"`
+	Reason      string    `json:"reason"`
+	ExpiresTime time.Time `json:"expires_time"`
+}
+
+type HoldResult struct {
+	Hold   Hold   `json:"hold"`
+	Trader Trader `json:"trader"`
+}
+
+// PlaceHold reserves part of the available funds of an active trader without moving them.
+// A zero ExpiresTime keeps the hold until it is released or captured.
+func (store *SQLStore) PlaceHold(ctx context.Context, arg PlaceHoldParams) (HoldResult, error) {
+	var result HoldResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = placeHold(ctx, q, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// ReleaseHold gives whatever remains on an active hold back to the available funds.
+func (store *SQLStore) ReleaseHold(ctx context.Context, holdID int64) (HoldResult, error) {
+	var result HoldResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := lockActiveHold(ctx, q, holdID)
+		if err != nil {
+			return err
+		}
+
+		result.Hold, result.Trader, err = releaseHold(ctx, q, hold)
+		return err
+	})
+
+	return result, err
+}
+
+// ExpireHoldTx releases an active hold whose expiry passed, together with what it
+// reserved the funds for: an open order is cancelled and a pending withdrawal is
+// rejected, as neither could be settled without its hold. The order or withdrawal
+// is locked before the hold, like on every other path that settles one.
+func (store *SQLStore) ExpireHoldTx(ctx context.Context, holdID int64) (HoldResult, error) {
+	var result HoldResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := q.GetHold(ctx, holdID)
+		if err != nil {
+			return err
+		}
+
+		if !hold.ExpiresTime.Valid || hold.ExpiresTime.Time.After(time.Now()) {
+			return fmt.Errorf("hold [%d]: %w", hold.ID, ErrHoldNotExpired)
+		}
+
+		switch hold.Reason {
+		case util.OrderHoldReason:
+			err = expireHoldOrder(ctx, q, hold.ID)
+		case util.WithdrawalHoldReason:
+			err = expireHoldWithdrawal(ctx, q, hold.ID)
+		}
+		if err != nil {
+			return err
+		}
+
+		hold, err = lockActiveHold(ctx, q, holdID)
+		if err != nil {
+			return err
+		}
+
+		result.Hold, result.Trader, err = releaseHold(ctx, q, hold)
+		return err
+	})
+
+	return result, err
+}
+
+func expireHoldOrder(ctx context.Context, q *Queries, holdID int64) error {
+	order, err := q.GetOrderByHoldForUpdate(ctx, pgtype.Int8{Int64: holdID, Valid: true})
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if order.Status != util.OpenOrderStatus {
+		return nil
+	}
+
+	// the matching engine drops the order from its book once it fails to fill
+	_, err = q.UpdateOrderStatus(ctx, UpdateOrderStatusParams{
+		ID:     order.ID,
+		Status: util.CancelledOrderStatus,
+	})
+	return err
+}
+
+func expireHoldWithdrawal(ctx context.Context, q *Queries, holdID int64) error {
+	withdrawal, err := q.GetWithdrawalByHoldForUpdate(ctx, pgtype.Int8{Int64: holdID, Valid: true})
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if withdrawal.Status != util.PendingWithdrawalStatus {
+		return nil
+	}
+
+	_, err = q.ReviewWithdrawal(ctx, ReviewWithdrawalParams{
+		ID:       withdrawal.ID,
+		Status:   util.RejectedWithdrawalStatus,
+		Reviewer: util.SystemMembername,
+	})
+	return err
+}
+
+type CaptureHoldParams struct {
+	HoldID     int64 `json:"hold_id"`
+	ToTraderID int64 `json:"to_trader_id"`
+	Number     int64 `json:"number"`
+}
+
+type CaptureHoldResult struct {
+	Hold   Hold           `json:"hold"`
+	Record RecordTxResult `json:"record"`
+}
+
+// CaptureHold transfers part or all of an active hold to another trader.
+// The hold stays active until nothing remains on it.
+func (store *SQLStore) CaptureHold(ctx context.Context, arg CaptureHoldParams) (CaptureHoldResult, error) {
+	var result CaptureHoldResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := lockActiveHold(ctx, q, arg.HoldID)
+		if err != nil {
+			return err
+		}
+
+		result.Hold, result.Record, err = captureHold(ctx, q, hold, arg.ToTraderID, arg.Number)
+		return err
+	})
+
+	return result, err
+}
+
+func placeHold(ctx context.Context, q *Queries, arg PlaceHoldParams) (HoldResult, error) {
+	var result HoldResult
+	var err error
+
+	result.Trader, err = q.AddTraderHeld(ctx, AddTraderHeldParams{
+		ID:     arg.TraderID,
+		Number: arg.Number,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if err = checkActive(result.Trader); err != nil {
+		return result, err
+	}
+
+	err = checkFunds(result.Trader)
+	if err != nil {
+		return result, err
+	}
+
+	result.Hold, err = q.CreateHold(ctx, CreateHoldParams{
+		TraderID: arg.TraderID,
+		Number:   arg.Number,
+		Reason:   arg.Reason,
+		ExpiresTime: pgtype.Timestamptz{
+			Time:  arg.ExpiresTime,
+			Valid: !arg.ExpiresTime.IsZero(),
+		},
+	})
+	return result, err
+}
+
+// lockActiveHold locks a hold before its trader, the order every hold path follows.
+func lockActiveHold(ctx context.Context, q *Queries, holdID int64) (Hold, error) {
+	hold, err := q.GetHoldForUpdate(ctx, holdID)
+	if err != nil {
+		return hold, err
+	}
+
+	if hold.Status != util.ActiveHoldStatus {
+		return hold, fmt.Errorf("hold [%d] is %s: %w", hold.ID, hold.Status, ErrHoldNotActive)
+	}
+	return hold, nil
+}
+
+func releaseHold(ctx context.Context, q *Queries, hold Hold) (Hold, Trader, error) {
+	return takeHold(ctx, q, hold, hold.Remaining, util.ReleasedHoldStatus)
+}
+
+func captureHold(ctx context.Context, q *Queries, hold Hold, toTraderID int64, number int64) (Hold, RecordTxResult, error) {
+	var record RecordTxResult
+
+	traders, err := lockTraders(ctx, q, hold.TraderID, toTraderID)
+	if err != nil {
+		return hold, record, err
+	}
+
+	for _, trader := range traders {
+		if err = checkActive(trader); err != nil {
+			return hold, record, err
+		}
+	}
+
+	hold, _, err = takeHold(ctx, q, hold, number, util.CapturedHoldStatus)
+	if err != nil {
+		return hold, record, err
+	}
+
+	record, err = settleMoney(ctx, q, RecordTxParams{
+		FromTraderID: hold.TraderID,
+		ToTraderID:   toTraderID,
+		Number:       number,
+	})
+	return hold, record, err
+}
+
+// takeHold takes number off a locked active hold and frees it on the trader.
+// The hold ends with the given status once nothing remains on it.
+func takeHold(ctx context.Context, q *Queries, hold Hold, number int64, status string) (Hold, Trader, error) {
+	var trader Trader
+
+	if number <= 0 || number > hold.Remaining {
+		return hold, trader, fmt.Errorf("hold [%d] has %d left: %w", hold.ID, hold.Remaining, ErrHoldExceeded)
+	}
+
+	remaining := hold.Remaining - number
+	if remaining > 0 {
+		status = util.ActiveHoldStatus
+	}
+
+	hold, err := q.UpdateHold(ctx, UpdateHoldParams{
+		ID:        hold.ID,
+		Remaining: remaining,
+		Status:    status,
+	})
+	if err != nil {
+		return hold, trader, err
+	}
+
+	trader, err = q.AddTraderHeld(ctx, AddTraderHeldParams{
+		ID:     hold.TraderID,
+		Number: -number,
+	})
+	return hold, trader, err
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrEmptyJournalEntry = errors.New("journal entry needs at least two non-zero postings")
+	ErrUnbalancedEntry   = errors.New("journal entry postings do not sum to zero")
+)
+
+type PostingParams struct {
+	TraderID int64 `json:"trader_id"`
+	Number   int64 `json:"number"`
+}
+
+type PostJournalEntryParams struct {
+	Kind     string          `json:"kind"`
+	RecordID pgtype.Int8     `json:"record_id"`
+	Postings []PostingParams `json:"postings"`
+}
+
+// PostJournalEntryResult lists details, postings and traders in the order of the
+// given postings. Traders hold the rest after the whole entry was applied.
+type PostJournalEntryResult struct {
+	Entry    JournalEntry `json:"entry"`
+	Postings []Posting    `json:"postings"`
+	Details  []Detail     `json:"details"`
+	Traders  []Trader     `json:"traders"`
+}
+
+// PostJournalEntry applies a set of postings as one journal entry. The postings
+// must sum to zero per symbol; the database checks the same when committing.
+func (store *SQLStore) PostJournalEntry(ctx context.Context, arg PostJournalEntryParams) (PostJournalEntryResult, error) {
+	var result PostJournalEntryResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result, err = postJournalEntry(ctx, q, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// postJournalEntry is the single path that changes trader rests. Every trader is
+// locked in id order first, and funds are checked once all postings are applied,
+// so a trader that is debited and credited in one entry is judged on the net.
+func postJournalEntry(ctx context.Context, q *Queries, arg PostJournalEntryParams) (PostJournalEntryResult, error) {
+	var result PostJournalEntryResult
+
+	if len(arg.Postings) < 2 {
+		return result, ErrEmptyJournalEntry
+	}
+
+	traderIDs := make([]int64, len(arg.Postings))
+	for i, posting := range arg.Postings {
+		if posting.Number == 0 {
+			return result, ErrEmptyJournalEntry
+		}
+		traderIDs[i] = posting.TraderID
+	}
+
+	traders, err := lockTraders(ctx, q, traderIDs...)
+	if err != nil {
+		return result, err
+	}
+
+	sums := make(map[string]int64)
+	for _, posting := range arg.Postings {
+		sums[traders[posting.TraderID].Symbol] += posting.Number
+	}
+	for symbol, sum := range sums {
+		if sum != 0 {
+			return result, fmt.Errorf("%s off by %d: %w", symbol, sum, ErrUnbalancedEntry)
+		}
+	}
+
+	result.Entry, err = q.CreateJournalEntry(ctx, CreateJournalEntryParams{
+		Kind:     arg.Kind,
+		RecordID: arg.RecordID,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	for _, posting := range arg.Postings {
+		detail, err := q.CreateDetail(ctx, CreateDetailParams{
+			TraderID: posting.TraderID,
+			Number:   posting.Number,
+			RecordID: arg.RecordID,
+		})
+		if err != nil {
+			return result, err
+		}
+
+		line, err := q.CreatePosting(ctx, CreatePostingParams{
+			EntryID:  result.Entry.ID,
+			TraderID: posting.TraderID,
+			Symbol:   traders[posting.TraderID].Symbol,
+			Number:   posting.Number,
+			DetailID: detail.ID,
+		})
+		if err != nil {
+			return result, err
+		}
+
+		traders[posting.TraderID], err = q.AddTraderRest(ctx, AddTraderRestParams{
+			ID:     posting.TraderID,
+			Number: posting.Number,
+		})
+		if err != nil {
+			return result, err
+		}
+
+		result.Details = append(result.Details, detail)
+		result.Postings = append(result.Postings, line)
+	}
+
+	for _, posting := range arg.Postings {
+		if posting.Number < 0 {
+			if err := checkFunds(traders[posting.TraderID]); err != nil {
+				return result, err
+			}
+		}
+		result.Traders = append(result.Traders, traders[posting.TraderID])
+	}
+
+	return result, nil
+}
+
+// systemTrader returns the system trader of a symbol, which takes the other
+// side of postings that bring money in or out of member traders.
+func systemTrader(ctx context.Context, q *Queries, symbol string) (Trader, error) {
+	return q.GetTraderByHolderSymbol(ctx, GetTraderByHolderSymbolParams{
+		Holder: util.SystemMembername,
+		Symbol: symbol,
+	})
+}
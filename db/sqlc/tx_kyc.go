@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YuanData/allegro-trade/util"
+)
+
+var ErrKYCSubmissionNotPending = errors.New("kyc submission is not pending")
+
+type ReviewKYCSubmissionTxParams struct {
+	SubmissionID int64  `json:"submission_id"`
+	Reviewer     string `json:"reviewer"`
+	Approve      bool   `json:"approve"`
+	Reason       string `json:"reason"`
+}
+
+type ReviewKYCSubmissionTxResult struct {
+	Submission KycSubmission `json:"submission"`
+	Member     Member        `json:"member"`
+}
+
+// ReviewKYCSubmissionTx approves or rejects a pending KYC submission. Approving
+// raises the member to the level submitted for; a member already past it, by
+// an approval in between, keeps the higher level.
+func (store *SQLStore) ReviewKYCSubmissionTx(ctx context.Context, arg ReviewKYCSubmissionTxParams) (ReviewKYCSubmissionTxResult, error) {
+	var result ReviewKYCSubmissionTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		submission, err := q.GetKYCSubmissionForUpdate(ctx, arg.SubmissionID)
+		if err != nil {
+			return err
+		}
+
+		if submission.Status != util.PendingReviewStatus {
+			return fmt.Errorf("kyc submission [%d] is %s: %w", submission.ID, submission.Status, ErrKYCSubmissionNotPending)
+		}
+
+		review := ReviewKYCSubmissionParams{
+			ID:       submission.ID,
+			Status:   util.RejectedReviewStatus,
+			Reason:   arg.Reason,
+			Reviewer: arg.Reviewer,
+		}
+
+		result.Member, err = q.GetMember(ctx, submission.Membername)
+		if err != nil {
+			return err
+		}
+
+		if arg.Approve {
+			review.Status = util.ApprovedReviewStatus
+
+			if !util.MeetsKYCLevel(result.Member.KycLevel, submission.Level) {
+				result.Member, err = q.UpdateMemberKYCLevel(ctx, UpdateMemberKYCLevelParams{
+					Membername: submission.Membername,
+					KycLevel:   submission.Level,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		result.Submission, err = q.ReviewKYCSubmission(ctx, review)
+		return err
+	})
+
+	return result, err
+}
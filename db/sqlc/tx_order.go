@@ -0,0 +1,158 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var ErrOrderTooSmall = errors.New("order is worth less than one minor unit of its quote symbol")
+
+type CreateOrderTxParams struct {
+	CreateOrderParams
+	// HoldExpiresTime is when an order still open gets cancelled and its hold
+	// released; zero keeps it open until it is filled or cancelled.
+	HoldExpiresTime time.Time `json:"hold_expires_time"`
+}
+
+// CreateOrderTx stores a new order together with a hold on what it may spend:
+// the base quantity for a sell, the quantity at the limit price for a limit buy.
+// A market buy has no price to reserve against and is checked at fill time only.
+// A limit order must be worth at least one minor unit of the quote symbol.
+func (store *SQLStore) CreateOrderTx(ctx context.Context, arg CreateOrderTxParams) (Order, error) {
+	var order Order
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		base, err := q.GetSymbol(ctx, arg.BaseSymbol)
+		if err != nil {
+			return err
+		}
+
+		traderID, number, err := orderHold(arg.CreateOrderParams, base.Decimals)
+		if err != nil {
+			return err
+		}
+
+		traders, err := lockTraders(ctx, q, arg.BaseTraderID, arg.QuoteTraderID)
+		if err != nil {
+			return err
+		}
+		for _, trader := range traders {
+			if err = checkActive(trader); err != nil {
+				return err
+			}
+		}
+
+		if number > 0 {
+			held, err := placeHold(ctx, q, PlaceHoldParams{
+				TraderID:    traderID,
+				Number:      number,
+				Reason:      util.OrderHoldReason,
+				ExpiresTime: arg.HoldExpiresTime,
+			})
+			if err != nil {
+				return err
+			}
+			arg.HoldID = pgtype.Int8{Int64: held.Hold.ID, Valid: true}
+		}
+
+		order, err = q.CreateOrder(ctx, arg.CreateOrderParams)
+		return err
+	})
+
+	return order, err
+}
+
+// CancelOrderTx marks an order as cancelled and releases what is left of its hold.
+func (store *SQLStore) CancelOrderTx(ctx context.Context, orderID int64) (Order, error) {
+	var order Order
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		order, err = q.GetOrderForUpdate(ctx, orderID)
+		if err != nil {
+			return err
+		}
+
+		if order.Status != util.OpenOrderStatus {
+			return fmt.Errorf("order [%d]: %w", order.ID, ErrOrderNotOpen)
+		}
+
+		order, err = q.UpdateOrderStatus(ctx, UpdateOrderStatusParams{
+			ID:     orderID,
+			Status: util.CancelledOrderStatus,
+		})
+		if err != nil {
+			return err
+		}
+
+		return releaseOrderHold(ctx, q, order)
+	})
+
+	return order, err
+}
+
+func orderHold(arg CreateOrderParams, baseDecimals int32) (traderID int64, number int64, err error) {
+	if arg.Kind != util.LimitKind {
+		if arg.Side == util.SellSide {
+			return arg.BaseTraderID, arg.Quantity, nil
+		}
+		return arg.QuoteTraderID, 0, nil
+	}
+
+	number, err = quoteNumber(arg.Quantity, arg.Price, baseDecimals)
+	if err != nil {
+		return 0, 0, err
+	}
+	if number == 0 {
+		return 0, 0, fmt.Errorf("%d at %d: %w", arg.Quantity, arg.Price, ErrOrderTooSmall)
+	}
+
+	if arg.Side == util.SellSide {
+		return arg.BaseTraderID, arg.Quantity, nil
+	}
+	return arg.QuoteTraderID, number, nil
+}
+
+// quoteNumber returns what a quantity of the base symbol costs at a price, in
+// minor units of the quote symbol. A price is in quote minor units per whole
+// base unit, so the product is scaled down by the decimals of the base symbol.
+// It is rounded down, in favour of the buyer, which also keeps the fills of an
+// order within what its limit buy held.
+func quoteNumber(quantity int64, price int64, baseDecimals int32) (int64, error) {
+	if price <= 0 {
+		return 0, fmt.Errorf("order price %d is not positive", price)
+	}
+
+	number := new(big.Int).Mul(big.NewInt(quantity), big.NewInt(price))
+	number.Quo(number, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(baseDecimals)), nil))
+	if !number.IsInt64() {
+		return 0, fmt.Errorf("order amount overflows: %d x %d", quantity, price)
+	}
+	return number.Int64(), nil
+}
+
+// releaseOrderHold frees what an order no longer needs once it stopped being open,
+// such as the difference left by fills at a better price than the limit.
+func releaseOrderHold(ctx context.Context, q *Queries, order Order) error {
+	if !order.HoldID.Valid || order.Status == util.OpenOrderStatus {
+		return nil
+	}
+
+	hold, err := q.GetHoldForUpdate(ctx, order.HoldID.Int64)
+	if err != nil {
+		return err
+	}
+	if hold.Status != util.ActiveHoldStatus {
+		return nil
+	}
+
+	_, _, err = releaseHold(ctx, q, hold)
+	return err
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrRecordAlreadyReversed = errors.New("record is already fully reversed")
+	ErrReverseReversal       = errors.New("a reversal cannot be reversed")
+	ErrReversalExceeded      = errors.New("reversal exceeds what is left of the record")
+)
+
+type ReverseRecordTxParams struct {
+	RecordID   int64  `json:"record_id"`
+	Number     int64  `json:"number"`
+	Membername string `json:"membername"`
+}
+
+type ReverseRecordTxResult struct {
+	Original Record `json:"original"`
+	RecordTxResult
+}
+
+// ReverseRecordTx sends money of a record back from its receiver to its sender as
+// a new record linked by reversal_of. A zero Number reverses whatever is left, so
+// partial refunds can follow each other until the record is fully reversed.
+// Both traders must still be active. Only the number goes back: a reversal
+// carries no fee of its own, and the fee the original sender paid stays with
+// the house trader even once the record is fully reversed.
+func (store *SQLStore) ReverseRecordTx(ctx context.Context, arg ReverseRecordTxParams) (ReverseRecordTxResult, error) {
+	var result ReverseRecordTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Original, err = q.GetRecordForUpdate(ctx, arg.RecordID)
+		if err != nil {
+			return err
+		}
+
+		if result.Original.ReversalOf.Valid {
+			return fmt.Errorf("record [%d]: %w", result.Original.ID, ErrReverseReversal)
+		}
+
+		reversed, err := q.GetReversedNumber(ctx, result.Original.ID)
+		if err != nil {
+			return err
+		}
+
+		left := result.Original.Number - reversed
+		if left <= 0 {
+			return fmt.Errorf("record [%d]: %w", result.Original.ID, ErrRecordAlreadyReversed)
+		}
+
+		number := arg.Number
+		if number == 0 {
+			number = left
+		}
+		if number < 0 || number > left {
+			return fmt.Errorf("record [%d] has %d left: %w", result.Original.ID, left, ErrReversalExceeded)
+		}
+
+		// money must not reach a closed trader or leave a frozen one, reversal or not
+		traders, err := lockTraders(ctx, q, result.Original.FromTraderID, result.Original.ToTraderID)
+		if err != nil {
+			return err
+		}
+		if err = checkActive(traders[result.Original.ToTraderID]); err != nil {
+			return err
+		}
+		if err = checkActive(traders[result.Original.FromTraderID]); err != nil {
+			return err
+		}
+
+		result.RecordTxResult, err = recordMoney(ctx, q, RecordTxParams{
+			FromTraderID: result.Original.ToTraderID,
+			ToTraderID:   result.Original.FromTraderID,
+			Number:       number,
+			Membername:   arg.Membername,
+		}, pgtype.Int8{Int64: result.Original.ID, Valid: true}, false)
+		return err
+	})
+
+	return result, err
+}
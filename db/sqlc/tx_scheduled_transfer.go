@@ -0,0 +1,61 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrScheduleNotActive = errors.New("scheduled transfer is not active")
+
+type RunScheduledTransferTxParams struct {
+	ScheduleID    int64     `json:"schedule_id"`
+	ScheduledTime time.Time `json:"scheduled_time"`
+}
+
+type RunScheduledTransferTxResult struct {
+	Schedule ScheduledTransfer `json:"schedule"`
+	Transfer RecordTxResult    `json:"transfer"`
+}
+
+// RunScheduledTransferTx moves the money of one run of a scheduled transfer. The
+// schedule stays locked while the money moves, so a pause lands either before the
+// run, which then fails with ErrScheduleNotActive, or after the run is done.
+// The record is written under an idempotency key derived from the scheduled time,
+// and a run an earlier attempt already recorded is replayed even if the schedule
+// was paused since.
+func (store *SQLStore) RunScheduledTransferTx(ctx context.Context, arg RunScheduledTransferTxParams) (RunScheduledTransferTxResult, error) {
+	var result RunScheduledTransferTxResult
+	var transfer RecordTxParams
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.Schedule, err = q.GetScheduledTransferForUpdate(ctx, arg.ScheduleID)
+		if err != nil {
+			return err
+		}
+
+		transfer = RecordTxParams{
+			FromTraderID:   result.Schedule.FromTraderID,
+			ToTraderID:     result.Schedule.ToTraderID,
+			Number:         result.Schedule.Number,
+			Membername:     result.Schedule.Holder,
+			IdempotencyKey: fmt.Sprintf("schedule-%d-%d", result.Schedule.ID, arg.ScheduledTime.Unix()),
+		}
+
+		result.Transfer, err = store.replayRecord(ctx, transfer)
+		if !errors.Is(err, ErrRecordNotFound) {
+			return err
+		}
+
+		if !result.Schedule.Active {
+			return fmt.Errorf("scheduled transfer [%d]: %w", result.Schedule.ID, ErrScheduleNotActive)
+		}
+
+		result.Transfer, err = store.recordTransfer(ctx, q, transfer)
+		return err
+	})
+	result.Transfer, err = store.recordOutcome(ctx, transfer, result.Transfer, err)
+	return result, err
+}
@@ -0,0 +1,287 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YuanData/allegro-trade/screening"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrTransferHeld             = errors.New("transfer held for review")
+	ErrTransferRejected         = errors.New("transfer rejected")
+	ErrTransferReviewNotPending = errors.New("transfer review is not pending")
+)
+
+// screeningHistory answers the questions of a screener from within the
+// transaction recording the transfer.
+type screeningHistory struct {
+	q *Queries
+}
+
+func (history screeningHistory) CountTransfersSince(ctx context.Context, fromTraderID int64, since time.Time) (int64, error) {
+	return history.q.CountTransfersSince(ctx, CountTransfersSinceParams{
+		FromTraderID: fromTraderID,
+		Since:        since,
+	})
+}
+
+func (history screeningHistory) HasPaid(ctx context.Context, fromTraderID int64, toTraderID int64) (bool, error) {
+	return history.q.HasPaid(ctx, HasPaidParams{
+		FromTraderID: fromTraderID,
+		ToTraderID:   toTraderID,
+	})
+}
+
+func (history screeningHistory) PasswordChangedTime(ctx context.Context, membername string) (time.Time, error) {
+	member, err := history.q.GetMember(ctx, membername)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return member.PasswordChangedTime, nil
+}
+
+// screen asks the screener of the store about a transfer. Without a screener
+// every transfer is approved.
+func (store *SQLStore) screen(ctx context.Context, q *Queries, arg RecordTxParams) (screening.Verdict, error) {
+	if store.screener == nil {
+		return screening.Verdict{Decision: screening.Approve}, nil
+	}
+
+	fromTrader, err := q.GetTrader(ctx, arg.FromTraderID)
+	if err != nil {
+		return screening.Verdict{}, err
+	}
+
+	symbol, err := q.GetSymbol(ctx, fromTrader.Symbol)
+	if err != nil {
+		return screening.Verdict{}, err
+	}
+
+	verdict, err := store.screener.Screen(ctx, screeningHistory{q: q}, screening.Transfer{
+		FromTraderID: arg.FromTraderID,
+		ToTraderID:   arg.ToTraderID,
+		Holder:       fromTrader.Holder,
+		Symbol:       fromTrader.Symbol,
+		Decimals:     symbol.Decimals,
+		Number:       arg.Number,
+	})
+	if err != nil {
+		return verdict, fmt.Errorf("screen transfer err: %w", err)
+	}
+
+	switch verdict.Decision {
+	case screening.Approve, screening.Review:
+		return verdict, nil
+	case screening.Reject:
+		return verdict, fmt.Errorf("%s: %w", strings.Join(verdict.Reasons, ", "), ErrTransferRejected)
+	}
+	return verdict, fmt.Errorf("unknown screening decision %q", verdict.Decision)
+}
+
+// holdForReview keeps a transfer from going through until a priest reviews it.
+// The number and the fee due now are held on the sender, so the transfer can
+// still be paid once approved. A non-zero escrowExpiresTime holds the funding
+// of an escrow instead, which carries no fee.
+func holdForReview(ctx context.Context, q *Queries, arg RecordTxParams, reasons []string, escrowExpiresTime time.Time) (TransferReview, Trader, error) {
+	traders, err := lockTraders(ctx, q, arg.FromTraderID, arg.ToTraderID)
+	if err != nil {
+		return TransferReview{}, Trader{}, err
+	}
+
+	fromTrader, toTrader := traders[arg.FromTraderID], traders[arg.ToTraderID]
+	if fromTrader.Symbol != toTrader.Symbol {
+		return TransferReview{}, fromTrader, ErrSymbolMismatch
+	}
+	if err = checkActive(fromTrader); err != nil {
+		return TransferReview{}, fromTrader, err
+	}
+	if err = checkActive(toTrader); err != nil {
+		return TransferReview{}, fromTrader, err
+	}
+
+	var fee FeeBreakdown
+	if escrowExpiresTime.IsZero() {
+		fee, err = transferFee(ctx, q, arg.FromTraderID, arg.Number)
+		if err != nil {
+			return TransferReview{}, fromTrader, err
+		}
+	}
+
+	held, err := placeHold(ctx, q, PlaceHoldParams{
+		TraderID: arg.FromTraderID,
+		Number:   arg.Number + fee.Fee,
+		Reason:   util.ReviewHoldReason,
+	})
+	if err != nil {
+		return TransferReview{}, fromTrader, err
+	}
+
+	review, err := q.CreateTransferReview(ctx, CreateTransferReviewParams{
+		FromTraderID: arg.FromTraderID,
+		ToTraderID:   arg.ToTraderID,
+		Number:       arg.Number,
+		Membername: pgtype.Text{
+			String: arg.Membername,
+			Valid:  arg.Membername != "",
+		},
+		IdempotencyKey: pgtype.Text{
+			String: arg.IdempotencyKey,
+			Valid:  arg.IdempotencyKey != "",
+		},
+		Reasons: reasons,
+		HoldID:  held.Hold.ID,
+		EscrowExpiresTime: pgtype.Timestamptz{
+			Time:  escrowExpiresTime,
+			Valid: !escrowExpiresTime.IsZero(),
+		},
+	})
+	return review, held.Trader, err
+}
+
+// replayReview rebuilds the result of an earlier transfer made with the same
+// idempotency key that screening held for review.
+func (store *SQLStore) replayReview(ctx context.Context, arg RecordTxParams) (RecordTxResult, error) {
+	var result RecordTxResult
+	var err error
+
+	result.Review, err = store.GetTransferReviewByIdempotencyKey(ctx, GetTransferReviewByIdempotencyKeyParams{
+		Membername:     pgtype.Text{String: arg.Membername, Valid: true},
+		IdempotencyKey: pgtype.Text{String: arg.IdempotencyKey, Valid: true},
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if result.Review.FromTraderID != arg.FromTraderID ||
+		result.Review.ToTraderID != arg.ToTraderID ||
+		result.Review.Number != arg.Number {
+		return RecordTxResult{}, ErrIdempotencyKeyReused
+	}
+
+	result.FromTrader, err = store.GetTrader(ctx, arg.FromTraderID)
+	if err != nil {
+		return result, err
+	}
+
+	// an approved review was recorded under the same key, so it is not seen here
+	if result.Review.Status == util.RejectedReviewStatus {
+		return result, fmt.Errorf("transfer review [%d] rejected: %w", result.Review.ID, ErrTransferRejected)
+	}
+	return result, fmt.Errorf("transfer review [%d]: %w", result.Review.ID, ErrTransferHeld)
+}
+
+type ReviewTransferTxParams struct {
+	ReviewID int64  `json:"review_id"`
+	Reviewer string `json:"reviewer"`
+	Approve  bool   `json:"approve"`
+}
+
+// ReviewTransferTxResult holds the recorded transfer, or the opened escrow,
+// when the review was approved.
+type ReviewTransferTxResult struct {
+	Review     TransferReview `json:"review"`
+	FromTrader Trader         `json:"from_trader"`
+	Record     RecordTxResult `json:"record"`
+	Escrow     Escrow         `json:"escrow"`
+}
+
+// ReviewTransferTx approves or rejects a transfer held by screening. Either way
+// the hold is released; approving then records the transfer as RecordTx would
+// have, or opens the escrow as OpenEscrowTx would have, without screening it
+// again.
+func (store *SQLStore) ReviewTransferTx(ctx context.Context, arg ReviewTransferTxParams) (ReviewTransferTxResult, error) {
+	var result ReviewTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		review, err := q.GetTransferReviewForUpdate(ctx, arg.ReviewID)
+		if err != nil {
+			return err
+		}
+
+		if review.Status != util.PendingReviewStatus {
+			return fmt.Errorf("transfer review [%d] is %s: %w", review.ID, review.Status, ErrTransferReviewNotPending)
+		}
+
+		hold, err := lockActiveHold(ctx, q, review.HoldID)
+		if err != nil {
+			return err
+		}
+
+		_, err = lockTraders(ctx, q, review.FromTraderID, review.ToTraderID)
+		if err != nil {
+			return err
+		}
+
+		_, result.FromTrader, err = releaseHold(ctx, q, hold)
+		if err != nil {
+			return err
+		}
+
+		settle := SettleTransferReviewParams{
+			ID:       review.ID,
+			Status:   util.RejectedReviewStatus,
+			Reviewer: arg.Reviewer,
+		}
+
+		switch {
+		case arg.Approve && review.EscrowExpiresTime.Valid:
+			settle.Status = util.ApprovedReviewStatus
+
+			escrowArg := OpenEscrowTxParams{
+				BuyerTraderID:  review.FromTraderID,
+				SellerTraderID: review.ToTraderID,
+				Number:         review.Number,
+				ExpiresTime:    review.EscrowExpiresTime.Time,
+			}
+			buyerTrader, sellerTrader, err := escrowParties(ctx, q, escrowArg)
+			if err != nil {
+				return err
+			}
+
+			funded, err := fundEscrow(ctx, q, escrowArg, buyerTrader, sellerTrader)
+			if err != nil {
+				return err
+			}
+
+			result.Escrow, result.FromTrader = funded.Escrow, funded.Trader
+			settle.EscrowID = pgtype.Int8{Int64: funded.Escrow.ID, Valid: true}
+		case arg.Approve:
+			settle.Status = util.ApprovedReviewStatus
+
+			result.Record, err = recordMoney(ctx, q, RecordTxParams{
+				FromTraderID:   review.FromTraderID,
+				ToTraderID:     review.ToTraderID,
+				Number:         review.Number,
+				Membername:     review.Membername.String,
+				IdempotencyKey: review.IdempotencyKey.String,
+			}, pgtype.Int8{}, true)
+			if err != nil {
+				return err
+			}
+
+			if err = checkActive(result.Record.FromTrader); err != nil {
+				return err
+			}
+			if err = checkActive(result.Record.ToTrader); err != nil {
+				return err
+			}
+			if err = checkTransferLimit(ctx, q, result.Record.FromTrader, review.Number); err != nil {
+				return err
+			}
+
+			result.FromTrader = result.Record.FromTrader
+			settle.RecordID = pgtype.Int8{Int64: result.Record.Record.ID, Valid: true}
+		}
+
+		result.Review, err = q.SettleTransferReview(ctx, settle)
+		return err
+	})
+
+	return result, err
+}
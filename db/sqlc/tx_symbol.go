@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"math"
+
+	"github.com/YuanData/allegro-trade/util"
+)
+
+type CreateSymbolTxResult struct {
+	Symbol       Symbol `json:"symbol"`
+	SystemTrader Trader `json:"system_trader"`
+	EscrowTrader Trader `json:"escrow_trader"`
+	HouseTrader  Trader `json:"house_trader"`
+}
+
+// CreateSymbolTx adds a symbol together with the traders the system members need
+// for it: the omnibus trader deposits and withdrawals go through, the trader that
+// holds escrowed funds and the trader that collects fees.
+func (store *SQLStore) CreateSymbolTx(ctx context.Context, arg CreateSymbolParams) (CreateSymbolTxResult, error) {
+	var result CreateSymbolTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Symbol, err = q.CreateSymbol(ctx, arg)
+		if err != nil {
+			return err
+		}
+
+		result.SystemTrader, err = q.CreateTrader(ctx, CreateTraderParams{
+			Holder: util.SystemMembername,
+			Symbol: arg.Code,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.SystemTrader, err = q.UpdateTraderOverdraftLimit(ctx, UpdateTraderOverdraftLimitParams{
+			ID:             result.SystemTrader.ID,
+			OverdraftLimit: math.MaxInt64,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.EscrowTrader, err = q.CreateTrader(ctx, CreateTraderParams{
+			Holder: util.EscrowMembername,
+			Symbol: arg.Code,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.HouseTrader, err = q.CreateTrader(ctx, CreateTraderParams{
+			Holder: util.HouseMembername,
+			Symbol: arg.Code,
+		})
+		return err
+	})
+
+	return result, err
+}
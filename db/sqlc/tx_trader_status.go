@@ -0,0 +1,112 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YuanData/allegro-trade/util"
+)
+
+var (
+	ErrTraderNotActive  = errors.New("trader is not active")
+	ErrTraderNotEmpty   = errors.New("trader still holds funds")
+	ErrSameTraderStatus = errors.New("trader already has this status")
+	ErrTraderStatusFlow = errors.New("trader status cannot change this way")
+	ErrTraderInUse      = errors.New("trader has unsettled escrows or pending transfer reviews")
+)
+
+type UpdateTraderStatusTxParams struct {
+	TraderID   int64  `json:"trader_id"`
+	Status     string `json:"status"`
+	Reason     string `json:"reason"`
+	Membername string `json:"membername"`
+}
+
+type UpdateTraderStatusTxResult struct {
+	Trader Trader             `json:"trader"`
+	Change TraderStatusChange `json:"change"`
+}
+
+// UpdateTraderStatusTx freezes, unfreezes or closes a trader and keeps the change
+// with its reason. A trader can only be closed once nothing is left on it and
+// nothing is still owed to or from it, that is no open or disputed escrow and
+// no pending transfer review, so no money is stranded on a trader that can no
+// longer move it. A closed trader stays closed.
+func (store *SQLStore) UpdateTraderStatusTx(ctx context.Context, arg UpdateTraderStatusTxParams) (UpdateTraderStatusTxResult, error) {
+	var result UpdateTraderStatusTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		trader, err := q.GetTraderForUpdate(ctx, arg.TraderID)
+		if err != nil {
+			return err
+		}
+
+		if trader.Status == arg.Status {
+			return fmt.Errorf("trader [%d] is %s: %w", trader.ID, trader.Status, ErrSameTraderStatus)
+		}
+
+		if !util.CanChangeTraderStatus(trader.Status, arg.Status) {
+			return fmt.Errorf("trader [%d] is %s, not %s: %w", trader.ID, trader.Status, arg.Status, ErrTraderStatusFlow)
+		}
+
+		if arg.Status == util.ClosedTraderStatus {
+			if err = checkClosable(ctx, q, trader); err != nil {
+				return err
+			}
+		}
+
+		result.Trader, err = q.UpdateTraderStatus(ctx, UpdateTraderStatusParams{
+			ID:           trader.ID,
+			Status:       arg.Status,
+			StatusReason: arg.Reason,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Change, err = q.CreateTraderStatusChange(ctx, CreateTraderStatusChangeParams{
+			TraderID:   trader.ID,
+			FromStatus: trader.Status,
+			ToStatus:   arg.Status,
+			Reason:     arg.Reason,
+			Membername: arg.Membername,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// checkClosable rejects closing a trader that still holds funds or that an
+// escrow or a transfer review would still have to pay or refund.
+func checkClosable(ctx context.Context, q *Queries, trader Trader) error {
+	if trader.Rest != 0 || trader.Held != 0 {
+		return fmt.Errorf("trader [%d] rest %d held %d: %w", trader.ID, trader.Rest, trader.Held, ErrTraderNotEmpty)
+	}
+
+	escrows, err := q.CountUnsettledEscrowsByTrader(ctx, trader.ID)
+	if err != nil {
+		return err
+	}
+
+	reviews, err := q.CountPendingTransferReviewsByTrader(ctx, trader.ID)
+	if err != nil {
+		return err
+	}
+
+	if escrows > 0 || reviews > 0 {
+		return fmt.Errorf("trader [%d] escrows %d reviews %d: %w", trader.ID, escrows, reviews, ErrTraderInUse)
+	}
+	return nil
+}
+
+// checkActive rejects a frozen or closed trader. Like checkFunds it is meant for
+// trader rows locked by the transaction, so the status cannot change before
+// the transaction commits.
+func checkActive(trader Trader) error {
+	if trader.Status != util.ActiveTraderStatus {
+		return fmt.Errorf("trader [%d] is %s: %w", trader.ID, trader.Status, ErrTraderNotActive)
+	}
+	return nil
+}
@@ -1,83 +1,273 @@
 package db
 
-import "context"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YuanData/allegro-trade/screening"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var ErrIdempotencyKeyReused = errors.New("idempotency key already used for a different transfer")
 
 type RecordTxParams struct {
-	FromTraderID int64 `json:"from_trader_id"`
-	ToTraderID   int64 `json:"to_trader_id"`
-	Number        int64 `json:"number"`
+	FromTraderID   int64  `json:"from_trader_id"`
+	ToTraderID     int64  `json:"to_trader_id"`
+	Number         int64  `json:"number"`
+	Membername     string `json:"membername"`
+	IdempotencyKey string `json:"idempotency_key"`
 }
 
 type RecordTxResult struct {
-	Record    Record `json:"record"`
-	FromTrader Trader  `json:"from_trader"`
-	ToTrader   Trader  `json:"to_trader"`
-	FromDetail   Detail    `json:"from_detail"`
-	ToDetail     Detail    `json:"to_detail"`
+	Record     Record       `json:"record"`
+	FromTrader Trader       `json:"from_trader"`
+	ToTrader   Trader       `json:"to_trader"`
+	FromDetail Detail       `json:"from_detail"`
+	ToDetail   Detail       `json:"to_detail"`
+	Fee        FeeBreakdown `json:"fee"`
+	// Review is set instead of the rest when screening held the transfer
+	Review TransferReview `json:"review"`
 }
 
+// RecordTx moves money between two active traders, charging the sender any fee due on top.
+// The transfer must keep the sender within the transfer limits of its role.
+// The screener of the store sees the transfer first; one it holds for review
+// comes back with ErrTransferHeld and the review in the result, after the
+// funds were held.
+// When an idempotency key is given and the member already used it, the original
+// result is returned instead of moving money again.
 func (store *SQLStore) RecordTx(ctx context.Context, arg RecordTxParams) (RecordTxResult, error) {
+	if arg.IdempotencyKey != "" {
+		result, err := store.replayRecord(ctx, arg)
+		if !errors.Is(err, ErrRecordNotFound) {
+			return result, err
+		}
+	}
+
 	var result RecordTxResult
 
 	err := store.execTx(ctx, func(q *Queries) error {
 		var err error
+		result, err = store.recordTransfer(ctx, q, arg)
+		return err
+	})
+	return store.recordOutcome(ctx, arg, result, err)
+}
 
-		result.Record, err = q.CreateRecord(ctx, CreateRecordParams{
-			FromTraderID: arg.FromTraderID,
-			ToTraderID:   arg.ToTraderID,
-			Number:        arg.Number,
-		})
-		if err != nil {
-			return err
-		}
+// recordTransfer screens and moves the money of a transfer within an open transaction.
+func (store *SQLStore) recordTransfer(ctx context.Context, q *Queries, arg RecordTxParams) (RecordTxResult, error) {
+	var result RecordTxResult
 
-		result.FromDetail, err = q.CreateDetail(ctx, CreateDetailParams{
-			TraderID: arg.FromTraderID,
-			Number:    -arg.Number,
-		})
-		if err != nil {
-			return err
-		}
+	verdict, err := store.screen(ctx, q, arg)
+	if err != nil {
+		return result, err
+	}
+
+	if verdict.Decision == screening.Review {
+		result.Review, result.FromTrader, err = holdForReview(ctx, q, arg, verdict.Reasons, time.Time{})
+		return result, err
+	}
 
-		result.ToDetail, err = q.CreateDetail(ctx, CreateDetailParams{
-			TraderID: arg.ToTraderID,
-			Number:    arg.Number,
-		})
+	result, err = transferMoney(ctx, q, arg)
+	if err != nil {
+		return result, err
+	}
+
+	// both traders are locked by now, see checkActive
+	if err = checkActive(result.FromTrader); err != nil {
+		return result, err
+	}
+	if err = checkActive(result.ToTrader); err != nil {
+		return result, err
+	}
+	return result, checkTransferLimit(ctx, q, result.FromTrader, arg.Number)
+}
+
+// recordOutcome turns what the transaction of a transfer left behind into the
+// result the caller sees.
+func (store *SQLStore) recordOutcome(ctx context.Context, arg RecordTxParams, result RecordTxResult, err error) (RecordTxResult, error) {
+	// a concurrent request with the same key won the race to insert the record
+	if err != nil && arg.IdempotencyKey != "" && ErrorCode(err) == UniqueViolation {
+		return store.replayRecord(ctx, arg)
+	}
+
+	if err == nil && result.Review.ID != 0 {
+		return result, fmt.Errorf("transfer review [%d]: %w", result.Review.ID, ErrTransferHeld)
+	}
+	return result, err
+}
+
+// replayRecord rebuilds the result of an earlier transfer made with the same idempotency key.
+func (store *SQLStore) replayRecord(ctx context.Context, arg RecordTxParams) (RecordTxResult, error) {
+	var result RecordTxResult
+	var err error
+
+	result.Record, err = store.GetRecordByIdempotencyKey(ctx, GetRecordByIdempotencyKeyParams{
+		Membername:     pgtype.Text{String: arg.Membername, Valid: true},
+		IdempotencyKey: pgtype.Text{String: arg.IdempotencyKey, Valid: true},
+	})
+	if errors.Is(err, ErrRecordNotFound) {
+		return store.replayReview(ctx, arg)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	if result.Record.FromTraderID != arg.FromTraderID ||
+		result.Record.ToTraderID != arg.ToTraderID ||
+		result.Record.Number != arg.Number {
+		return RecordTxResult{}, ErrIdempotencyKeyReused
+	}
+
+	result.FromTrader, err = store.GetTrader(ctx, arg.FromTraderID)
+	if err != nil {
+		return result, err
+	}
+
+	result.ToTrader, err = store.GetTrader(ctx, arg.ToTraderID)
+	if err != nil {
+		return result, err
+	}
+
+	details, err := store.ListDetailsByRecord(ctx, result.Record.ID)
+	if err != nil {
+		return result, err
+	}
+
+	// the legs are told apart by trader and amount, not by the order they were posted in
+	legs := recordLegs{record: result.Record, details: details, used: make(map[int64]bool)}
+	result.FromDetail = legs.pick(arg.FromTraderID, -result.Record.Number)
+	result.ToDetail = legs.pick(arg.ToTraderID, result.Record.Number)
+
+	result.Fee.RuleID, result.Fee.Fee = result.Record.FeeRuleID.Int64, result.Record.Fee
+	if result.Record.Fee > 0 {
+		result.Fee.HouseTrader, err = houseTrader(ctx, store.Queries, result.FromTrader.Symbol)
 		if err != nil {
-			return err
+			return result, err
 		}
 
-		if arg.FromTraderID < arg.ToTraderID {
-			result.FromTrader, result.ToTrader, err = addMoney(ctx, q, arg.FromTraderID, -arg.Number, arg.ToTraderID, arg.Number)
-		} else {
-			result.ToTrader, result.FromTrader, err = addMoney(ctx, q, arg.ToTraderID, arg.Number, arg.FromTraderID, -arg.Number)
+		result.Fee.FeeDetail = legs.pick(arg.FromTraderID, -result.Record.Fee)
+		result.Fee.HouseDetail = legs.pick(result.Fee.HouseTrader.ID, result.Record.Fee)
+	}
+
+	return result, legs.err
+}
+
+// recordLegs picks the details of a record one leg at a time, each detail at most once.
+type recordLegs struct {
+	record  Record
+	details []Detail
+	used    map[int64]bool
+	err     error
+}
+
+func (legs *recordLegs) pick(traderID int64, number int64) Detail {
+	for _, detail := range legs.details {
+		if !legs.used[detail.ID] && detail.TraderID == traderID && detail.Number == number {
+			legs.used[detail.ID] = true
+			return detail
 		}
+	}
 
-		return err
-	})
+	if legs.err == nil {
+		legs.err = fmt.Errorf("record [%d] has no detail of %d for trader [%d]", legs.record.ID, number, traderID)
+	}
+	return Detail{}
+}
 
-	return result, err
+// transferMoney moves money between two traders within an open transaction,
+// charging the sender the transfer fee.
+func transferMoney(ctx context.Context, q *Queries, arg RecordTxParams) (RecordTxResult, error) {
+	return recordMoney(ctx, q, arg, pgtype.Int8{}, true)
 }
 
-func addMoney(
-	ctx context.Context,
-	q *Queries,
-	traderID1 int64,
-	number1 int64,
-	traderID2 int64,
-	number2 int64,
-) (trader1 Trader, trader2 Trader, err error) {
-	trader1, err = q.AddTraderRest(ctx, AddTraderRestParams{
-		ID:     traderID1,
-		Number: number1,
+// settleMoney moves money the sender owes for something other than a transfer,
+// such as a leg of a fill or a captured hold, so no transfer fee is charged.
+// What was held for it covers exactly the number.
+func settleMoney(ctx context.Context, q *Queries, arg RecordTxParams) (RecordTxResult, error) {
+	return recordMoney(ctx, q, arg, pgtype.Int8{}, false)
+}
+
+// recordMoney writes the record and posts its journal entry, with the fee legs in
+// the same entry when withFee is set. A valid reversalOf links the record to the
+// one it compensates.
+func recordMoney(ctx context.Context, q *Queries, arg RecordTxParams, reversalOf pgtype.Int8, withFee bool) (RecordTxResult, error) {
+	var result RecordTxResult
+	var err error
+
+	kind := util.TransferEntry
+	if reversalOf.Valid {
+		kind = util.ReversalEntry
+	}
+
+	if withFee {
+		result.Fee, err = transferFee(ctx, q, arg.FromTraderID, arg.Number)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	result.Record, err = q.CreateRecord(ctx, CreateRecordParams{
+		FromTraderID: arg.FromTraderID,
+		ToTraderID:   arg.ToTraderID,
+		Number:       arg.Number,
+		Membername: pgtype.Text{
+			String: arg.Membername,
+			Valid:  arg.Membername != "",
+		},
+		IdempotencyKey: pgtype.Text{
+			String: arg.IdempotencyKey,
+			Valid:  arg.IdempotencyKey != "",
+		},
+		ReversalOf: reversalOf,
+		Fee:        result.Fee.Fee,
+		FeeRuleID: pgtype.Int8{
+			Int64: result.Fee.RuleID,
+			Valid: result.Fee.RuleID != 0,
+		},
 	})
 	if err != nil {
-		return
+		return result, err
 	}
 
-	trader2, err = q.AddTraderRest(ctx, AddTraderRestParams{
-		ID:     traderID2,
-		Number: number2,
+	postings := []PostingParams{
+		{TraderID: arg.FromTraderID, Number: -arg.Number},
+		{TraderID: arg.ToTraderID, Number: arg.Number},
+	}
+	if result.Fee.Fee > 0 {
+		postings = append(postings,
+			PostingParams{TraderID: arg.FromTraderID, Number: -result.Fee.Fee},
+			PostingParams{TraderID: result.Fee.HouseTrader.ID, Number: result.Fee.Fee},
+		)
+	}
+
+	entry, err := postJournalEntry(ctx, q, PostJournalEntryParams{
+		Kind:     kind,
+		RecordID: pgtype.Int8{Int64: result.Record.ID, Valid: true},
+		Postings: postings,
 	})
-	return
+	if err != nil {
+		return result, err
+	}
+
+	result.FromDetail, result.ToDetail = entry.Details[0], entry.Details[1]
+	result.FromTrader, result.ToTrader = entry.Traders[0], entry.Traders[1]
+	if result.Fee.Fee > 0 {
+		result.Fee.FeeDetail, result.Fee.HouseDetail = entry.Details[2], entry.Details[3]
+		result.Fee.HouseTrader = entry.Traders[3]
+	}
+	return result, nil
+}
+
+// checkFunds rejects a trader whose available funds, rest minus what is held,
+// went below its overdraft limit. It runs after the update, while the trader
+// row is still locked by the transaction.
+func checkFunds(trader Trader) error {
+	if trader.Rest-trader.Held < -trader.OverdraftLimit {
+		return fmt.Errorf("trader [%d]: %w", trader.ID, ErrInsufficientFunds)
+	}
+	return nil
 }
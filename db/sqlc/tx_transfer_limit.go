@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YuanData/allegro-trade/util"
+)
+
+var ErrTransferLimitExceeded = errors.New("transfer limit exceeded")
+
+// TransferLimitError tells which limit a transfer breached and how much the
+// member could still have sent within it.
+type TransferLimitError struct {
+	Holder    string
+	Symbol    string
+	Period    string
+	Limit     int64
+	Sent      int64
+	Remaining int64
+}
+
+func (e *TransferLimitError) Error() string {
+	return fmt.Sprintf("%s limit of %d %s for %s: %d already sent, %d remaining: %s",
+		e.Period, e.Limit, e.Symbol, e.Holder, e.Sent, e.Remaining, ErrTransferLimitExceeded)
+}
+
+func (e *TransferLimitError) Unwrap() error {
+	return ErrTransferLimitExceeded
+}
+
+// checkTransferLimit rejects a transfer of number that takes what the holder of
+// trader sent within a period over the limit applying to their role and KYC
+// level. Records, escrow fundings and withdrawals not rejected all count as
+// sent. Like checkFunds it runs after the outgoing row was written, while
+// trader is locked by the transaction; a member holds one trader per symbol,
+// so the lock keeps concurrent transfers from sharing the same allowance.
+func checkTransferLimit(ctx context.Context, q *Queries, trader Trader, number int64) error {
+	member, err := q.GetMember(ctx, trader.Holder)
+	if err != nil {
+		return err
+	}
+
+	limit, err := q.GetApplicableTransferLimit(ctx, GetApplicableTransferLimitParams{
+		Symbol:   trader.Symbol,
+		Role:     member.Role,
+		KycLevel: member.KycLevel,
+	})
+	if err != nil {
+		if errors.Is(err, ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	now := time.Now()
+	totals, err := q.GetOutgoingTotals(ctx, GetOutgoingTotalsParams{
+		Holder:       trader.Holder,
+		Symbol:       trader.Symbol,
+		DailySince:   now.Add(-util.DailyLimitWindow),
+		MonthlySince: now.Add(-util.MonthlyLimitWindow),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = limitBreach(trader, util.DailyLimitPeriod, limit.DailyLimit, totals.Daily, number); err != nil {
+		return err
+	}
+	return limitBreach(trader, util.MonthlyLimitPeriod, limit.MonthlyLimit, totals.Monthly, number)
+}
+
+// limitBreach checks total, which already includes number, against limit. A
+// zero limit means no limit.
+func limitBreach(trader Trader, period string, limit int64, total int64, number int64) error {
+	if limit == 0 || total <= limit {
+		return nil
+	}
+
+	sent := total - number
+	remaining := limit - sent
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return &TransferLimitError{
+		Holder:    trader.Holder,
+		Symbol:    trader.Symbol,
+		Period:    period,
+		Limit:     limit,
+		Sent:      sent,
+		Remaining: remaining,
+	}
+}
@@ -0,0 +1,199 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var ErrWithdrawalNotPending = errors.New("withdrawal is not pending")
+
+type WithdrawalTxParams struct {
+	TraderID    int64  `json:"trader_id"`
+	Number      int64  `json:"number"`
+	Destination string `json:"destination"`
+	// HoldExpiresTime is when a withdrawal still pending review gets rejected
+	// and its hold released; zero keeps it pending until it is reviewed.
+	HoldExpiresTime time.Time `json:"hold_expires_time"`
+}
+
+type WithdrawalTxResult struct {
+	Withdrawal Withdrawal `json:"withdrawal"`
+	Trader     Trader     `json:"trader"`
+	Hold       Hold       `json:"hold"`
+}
+
+// WithdrawalTx puts a hold on the requested funds and leaves the withdrawal pending
+// review, so the funds cannot be spent twice but have not left the trader yet.
+// The withdrawal counts against the transfer limits of the member from the
+// moment it is requested, unless it gets rejected.
+func (store *SQLStore) WithdrawalTx(ctx context.Context, arg WithdrawalTxParams) (WithdrawalTxResult, error) {
+	var result WithdrawalTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		held, err := placeHold(ctx, q, PlaceHoldParams{
+			TraderID:    arg.TraderID,
+			Number:      arg.Number,
+			Reason:      util.WithdrawalHoldReason,
+			ExpiresTime: arg.HoldExpiresTime,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Hold, result.Trader = held.Hold, held.Trader
+
+		result.Withdrawal, err = q.CreateWithdrawal(ctx, CreateWithdrawalParams{
+			TraderID:    arg.TraderID,
+			Number:      arg.Number,
+			Destination: arg.Destination,
+			HoldID:      held.Hold.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return checkTransferLimit(ctx, q, result.Trader, arg.Number)
+	})
+
+	return result, err
+}
+
+type ReviewWithdrawalTxParams struct {
+	WithdrawalID int64  `json:"withdrawal_id"`
+	Reviewer     string `json:"reviewer"`
+	Approve      bool   `json:"approve"`
+}
+
+type ReviewWithdrawalTxResult struct {
+	Withdrawal Withdrawal `json:"withdrawal"`
+	Trader     Trader     `json:"trader"`
+}
+
+// ReviewWithdrawalTx approves or rejects a pending withdrawal. Approving captures
+// the hold into the omnibus system trader, rejecting releases it. Withdrawals
+// requested before holds existed were debited up front; rejecting one of them
+// credits the trader back instead.
+func (store *SQLStore) ReviewWithdrawalTx(ctx context.Context, arg ReviewWithdrawalTxParams) (ReviewWithdrawalTxResult, error) {
+	var result ReviewWithdrawalTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		withdrawal, err := q.GetWithdrawalForUpdate(ctx, arg.WithdrawalID)
+		if err != nil {
+			return err
+		}
+
+		if withdrawal.Status != util.PendingWithdrawalStatus {
+			return fmt.Errorf("withdrawal [%d] is %s: %w", withdrawal.ID, withdrawal.Status, ErrWithdrawalNotPending)
+		}
+
+		review := ReviewWithdrawalParams{
+			ID:       withdrawal.ID,
+			Status:   util.ApprovedWithdrawalStatus,
+			Reviewer: arg.Reviewer,
+		}
+		if !arg.Approve {
+			review.Status = util.RejectedWithdrawalStatus
+		}
+
+		if withdrawal.HoldID.Valid {
+			result.Trader, review.EntryID, err = settleWithdrawalHold(ctx, q, withdrawal, arg.Approve)
+		} else {
+			result.Trader, review.RefundEntryID, err = settleDebitedWithdrawal(ctx, q, withdrawal, arg.Approve)
+		}
+		if err != nil {
+			return err
+		}
+
+		result.Withdrawal, err = q.ReviewWithdrawal(ctx, review)
+		return err
+	})
+
+	return result, err
+}
+
+func settleWithdrawalHold(ctx context.Context, q *Queries, withdrawal Withdrawal, approve bool) (Trader, pgtype.Int8, error) {
+	var entryID pgtype.Int8
+
+	hold, err := lockActiveHold(ctx, q, withdrawal.HoldID.Int64)
+	if err != nil {
+		return Trader{}, entryID, err
+	}
+
+	if !approve {
+		_, trader, err := releaseHold(ctx, q, hold)
+		return trader, entryID, err
+	}
+
+	trader, err := q.GetTrader(ctx, withdrawal.TraderID)
+	if err != nil {
+		return trader, entryID, err
+	}
+
+	omnibus, err := systemTrader(ctx, q, trader.Symbol)
+	if err != nil {
+		return trader, entryID, err
+	}
+
+	traders, err := lockTraders(ctx, q, trader.ID, omnibus.ID)
+	if err != nil {
+		return trader, entryID, err
+	}
+
+	if err = checkActive(traders[trader.ID]); err != nil {
+		return trader, entryID, err
+	}
+
+	_, _, err = takeHold(ctx, q, hold, withdrawal.Number, util.CapturedHoldStatus)
+	if err != nil {
+		return trader, entryID, err
+	}
+
+	entry, err := postJournalEntry(ctx, q, PostJournalEntryParams{
+		Kind: util.WithdrawalEntry,
+		Postings: []PostingParams{
+			{TraderID: withdrawal.TraderID, Number: -withdrawal.Number},
+			{TraderID: omnibus.ID, Number: withdrawal.Number},
+		},
+	})
+	if err != nil {
+		return trader, entryID, err
+	}
+
+	return entry.Traders[0], pgtype.Int8{Int64: entry.Entry.ID, Valid: true}, nil
+}
+
+func settleDebitedWithdrawal(ctx context.Context, q *Queries, withdrawal Withdrawal, approve bool) (Trader, pgtype.Int8, error) {
+	var refundEntryID pgtype.Int8
+
+	trader, err := q.GetTrader(ctx, withdrawal.TraderID)
+	if err != nil || approve {
+		return trader, refundEntryID, err
+	}
+
+	omnibus, err := systemTrader(ctx, q, trader.Symbol)
+	if err != nil {
+		return trader, refundEntryID, err
+	}
+
+	entry, err := postJournalEntry(ctx, q, PostJournalEntryParams{
+		Kind: util.WithdrawalRefundEntry,
+		Postings: []PostingParams{
+			{TraderID: omnibus.ID, Number: -withdrawal.Number},
+			{TraderID: withdrawal.TraderID, Number: withdrawal.Number},
+		},
+	})
+	if err != nil {
+		return trader, refundEntryID, err
+	}
+
+	if err = checkActive(entry.Traders[1]); err != nil {
+		return trader, refundEntryID, err
+	}
+
+	return entry.Traders[1], pgtype.Int8{Int64: entry.Entry.ID, Valid: true}, nil
+}
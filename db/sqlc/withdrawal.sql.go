@@ -0,0 +1,219 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: withdrawal.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createWithdrawal = `-- name: CreateWithdrawal :one
+INSERT INTO withdrawals (
+  trader_id,
+  number,
+  destination,
+  hold_id
+) VALUES (
+  $1, $2, $3, $4::bigint
+) RETURNING id, trader_id, number, destination, status, entry_id, refund_entry_id, reviewer, reviewed_time, created_time, hold_id
+`
+
+type CreateWithdrawalParams struct {
+	TraderID    int64  `json:"trader_id"`
+	Number      int64  `json:"number"`
+	Destination string `json:"destination"`
+	HoldID      int64  `json:"hold_id"`
+}
+
+func (q *Queries) CreateWithdrawal(ctx context.Context, arg CreateWithdrawalParams) (Withdrawal, error) {
+	row := q.db.QueryRow(ctx, createWithdrawal,
+		arg.TraderID,
+		arg.Number,
+		arg.Destination,
+		arg.HoldID,
+	)
+	var i Withdrawal
+	err := row.Scan(
+		&i.ID,
+		&i.TraderID,
+		&i.Number,
+		&i.Destination,
+		&i.Status,
+		&i.EntryID,
+		&i.RefundEntryID,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+		&i.HoldID,
+	)
+	return i, err
+}
+
+const getWithdrawal = `-- name: GetWithdrawal :one
+SELECT id, trader_id, number, destination, status, entry_id, refund_entry_id, reviewer, reviewed_time, created_time, hold_id FROM withdrawals
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetWithdrawal(ctx context.Context, id int64) (Withdrawal, error) {
+	row := q.db.QueryRow(ctx, getWithdrawal, id)
+	var i Withdrawal
+	err := row.Scan(
+		&i.ID,
+		&i.TraderID,
+		&i.Number,
+		&i.Destination,
+		&i.Status,
+		&i.EntryID,
+		&i.RefundEntryID,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+		&i.HoldID,
+	)
+	return i, err
+}
+
+const getWithdrawalByHoldForUpdate = `-- name: GetWithdrawalByHoldForUpdate :one
+SELECT id, trader_id, number, destination, status, entry_id, refund_entry_id, reviewer, reviewed_time, created_time, hold_id FROM withdrawals
+WHERE hold_id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetWithdrawalByHoldForUpdate(ctx context.Context, holdID pgtype.Int8) (Withdrawal, error) {
+	row := q.db.QueryRow(ctx, getWithdrawalByHoldForUpdate, holdID)
+	var i Withdrawal
+	err := row.Scan(
+		&i.ID,
+		&i.TraderID,
+		&i.Number,
+		&i.Destination,
+		&i.Status,
+		&i.EntryID,
+		&i.RefundEntryID,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+		&i.HoldID,
+	)
+	return i, err
+}
+
+const getWithdrawalForUpdate = `-- name: GetWithdrawalForUpdate :one
+SELECT id, trader_id, number, destination, status, entry_id, refund_entry_id, reviewer, reviewed_time, created_time, hold_id FROM withdrawals
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetWithdrawalForUpdate(ctx context.Context, id int64) (Withdrawal, error) {
+	row := q.db.QueryRow(ctx, getWithdrawalForUpdate, id)
+	var i Withdrawal
+	err := row.Scan(
+		&i.ID,
+		&i.TraderID,
+		&i.Number,
+		&i.Destination,
+		&i.Status,
+		&i.EntryID,
+		&i.RefundEntryID,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+		&i.HoldID,
+	)
+	return i, err
+}
+
+const listWithdrawals = `-- name: ListWithdrawals :many
+SELECT id, trader_id, number, destination, status, entry_id, refund_entry_id, reviewer, reviewed_time, created_time, hold_id FROM withdrawals
+WHERE status = $1
+ORDER BY id
+LIMIT $2
+OFFSET $3
+`
+
+type ListWithdrawalsParams struct {
+	Status string `json:"status"`
+	Limit  int32  `json:"limit"`
+	Offset int32  `json:"offset"`
+}
+
+func (q *Queries) ListWithdrawals(ctx context.Context, arg ListWithdrawalsParams) ([]Withdrawal, error) {
+	rows, err := q.db.Query(ctx, listWithdrawals, arg.Status, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Withdrawal{}
+	for rows.Next() {
+		var i Withdrawal
+		if err := rows.Scan(
+			&i.ID,
+			&i.TraderID,
+			&i.Number,
+			&i.Destination,
+			&i.Status,
+			&i.EntryID,
+			&i.RefundEntryID,
+			&i.Reviewer,
+			&i.ReviewedTime,
+			&i.CreatedTime,
+			&i.HoldID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reviewWithdrawal = `-- name: ReviewWithdrawal :one
+UPDATE withdrawals
+SET
+  status = $1,
+  reviewer = $2::varchar,
+  reviewed_time = now(),
+  entry_id = COALESCE($3, entry_id),
+  refund_entry_id = $4
+WHERE id = $5
+RETURNING id, trader_id, number, destination, status, entry_id, refund_entry_id, reviewer, reviewed_time, created_time, hold_id
+`
+
+type ReviewWithdrawalParams struct {
+	Status        string      `json:"status"`
+	Reviewer      string      `json:"reviewer"`
+	EntryID       pgtype.Int8 `json:"entry_id"`
+	RefundEntryID pgtype.Int8 `json:"refund_entry_id"`
+	ID            int64       `json:"id"`
+}
+
+func (q *Queries) ReviewWithdrawal(ctx context.Context, arg ReviewWithdrawalParams) (Withdrawal, error) {
+	row := q.db.QueryRow(ctx, reviewWithdrawal,
+		arg.Status,
+		arg.Reviewer,
+		arg.EntryID,
+		arg.RefundEntryID,
+		arg.ID,
+	)
+	var i Withdrawal
+	err := row.Scan(
+		&i.ID,
+		&i.TraderID,
+		&i.Number,
+		&i.Destination,
+		&i.Status,
+		&i.EntryID,
+		&i.RefundEntryID,
+		&i.Reviewer,
+		&i.ReviewedTime,
+		&i.CreatedTime,
+		&i.HoldID,
+	)
+	return i, err
+}
@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/money"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// decimals returns the precision amounts of a symbol are written with. An
+// unknown symbol has none, so its amounts show as plain minor units.
+func (server *Server) decimals(symbol string) int32 {
+	registered, _ := server.symbolRegistry.Get(symbol)
+	return registered.Decimals
+}
+
+// traderSymbol returns the symbol a trader holds, for rows that only carry the
+// trader id. Symbols are remembered in seen, when given, so the rows of a list
+// that share traders cost one lookup per trader.
+func (server *Server) traderSymbol(ctx context.Context, seen map[int64]string, traderID int64) (string, error) {
+	if symbol, ok := seen[traderID]; ok {
+		return symbol, nil
+	}
+
+	trader, err := server.store.GetTrader(ctx, traderID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return "", status.Errorf(codes.NotFound, "trader [%d] NotFound err", traderID)
+		}
+		return "", status.Errorf(codes.Internal, "get trader err: %s", err)
+	}
+
+	if seen != nil {
+		seen[traderID] = trader.Symbol
+	}
+	return trader.Symbol, nil
+}
+
+// parseAmount converts a decimal amount of a symbol into minor units. The amount
+// must fit the precision of the symbol exactly, since silently rounding money a
+// member asked to move would move a different amount. An empty value is zero,
+// for the amounts that may be left out.
+func (server *Server) parseAmount(field string, value string, symbol string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	amount, err := money.Parse(value, server.decimals(symbol), money.RoundExact)
+	if err != nil {
+		return 0, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation(field, err)})
+	}
+	return amount, nil
+}
+
+// parseTransferAmount is parseAmount for the number of a transfer, which may not
+// be below the minimum transfer of its symbol.
+func (server *Server) parseTransferAmount(field string, value string, symbol string) (int64, error) {
+	number, err := server.parseAmount(field, value, symbol)
+	if err != nil {
+		return 0, err
+	}
+
+	registered, ok := server.symbolRegistry.Get(symbol)
+	if ok && number < registered.MinTransfer {
+		err = fmt.Errorf("must be at least %s %s", money.Format(registered.MinTransfer, registered.Decimals), symbol)
+		return 0, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation(field, err)})
+	}
+	return number, nil
+}
@@ -1,8 +1,13 @@
 package gapi
 
 import (
+	"time"
+
 	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/matching"
+	"github.com/YuanData/allegro-trade/money"
 	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/pricing"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -13,5 +18,285 @@ func convertMember(member db.Member) *pb.Member {
 		Email:             member.Email,
 		PasswordChangedTime: timestamppb.New(member.PasswordChangedTime),
 		CreatedTime:         timestamppb.New(member.CreatedTime),
+		KycLevel:            member.KycLevel,
+	}
+}
+
+func convertTrader(trader db.Trader, decimals int32) *pb.Trader {
+	return &pb.Trader{
+		Id:             trader.ID,
+		Holder:         trader.Holder,
+		Rest:           money.Format(trader.Rest, decimals),
+		Symbol:         trader.Symbol,
+		CreatedTime:    timestamppb.New(trader.CreatedTime),
+		OverdraftLimit: money.Format(trader.OverdraftLimit, decimals),
+		Held:           money.Format(trader.Held, decimals),
+		Available:      money.Format(trader.Rest-trader.Held, decimals),
+		Status:         trader.Status,
+		StatusReason:   trader.StatusReason,
+	}
+}
+
+func convertRecord(record db.Record, decimals int32) *pb.Record {
+	return &pb.Record{
+		Id:           record.ID,
+		FromTraderId: record.FromTraderID,
+		ToTraderId:   record.ToTraderID,
+		Number:       money.Format(record.Number, decimals),
+		CreatedTime:  timestamppb.New(record.CreatedTime),
+		ReversalOf:   record.ReversalOf.Int64,
+	}
+}
+
+func convertDetail(detail db.Detail, decimals int32) *pb.Detail {
+	return &pb.Detail{
+		Id:          detail.ID,
+		TraderId:    detail.TraderID,
+		Number:      money.Format(detail.Number, decimals),
+		CreatedTime: timestamppb.New(detail.CreatedTime),
+	}
+}
+
+func convertTransaction(transaction db.ListTransactionsRow, decimals int32) *pb.Transaction {
+	return &pb.Transaction{
+		RecordId:       transaction.RecordID,
+		TraderId:       transaction.TraderID,
+		Symbol:         transaction.Symbol,
+		Direction:      transaction.Direction,
+		CounterpartyId: transaction.CounterpartyID,
+		Number:         money.Format(transaction.Number, decimals),
+		Fee:            money.Format(transaction.Fee, decimals),
+		Balance:        money.Format(transaction.Balance, decimals),
+		ReversalOf:     transaction.ReversalOf.Int64,
+		CreatedTime:    timestamppb.New(transaction.CreatedTime),
+	}
+}
+
+// convertOrder writes the quantities of an order with the decimals of its base
+// symbol and its price, per whole base unit, with those of its quote symbol.
+func convertOrder(order db.Order, baseDecimals int32, quoteDecimals int32) *pb.Order {
+	return &pb.Order{
+		Id:          order.ID,
+		Holder:      order.Holder,
+		Pair:        matching.Pair{Base: order.BaseSymbol, Quote: order.QuoteSymbol}.String(),
+		Side:        order.Side,
+		Kind:        order.Kind,
+		Price:       money.Format(order.Price, quoteDecimals),
+		Quantity:    money.Format(order.Quantity, baseDecimals),
+		Filled:      money.Format(order.Filled, baseDecimals),
+		Status:      order.Status,
+		CreatedTime: timestamppb.New(order.CreatedTime),
+	}
+}
+
+func convertFill(fill db.Fill, baseDecimals int32, quoteDecimals int32) *pb.Fill {
+	return &pb.Fill{
+		Id:           fill.ID,
+		MakerOrderId: fill.MakerOrderID,
+		TakerOrderId: fill.TakerOrderID,
+		Price:        money.Format(fill.Price, quoteDecimals),
+		Quantity:     money.Format(fill.Quantity, baseDecimals),
+		CreatedTime:  timestamppb.New(fill.CreatedTime),
+	}
+}
+
+func convertDeposit(deposit db.Deposit, decimals int32) *pb.Deposit {
+	return &pb.Deposit{
+		Id:          deposit.ID,
+		TraderId:    deposit.TraderID,
+		Number:      money.Format(deposit.Number, decimals),
+		Reference:   deposit.Reference,
+		CreatedTime: timestamppb.New(deposit.CreatedTime),
+	}
+}
+
+func convertWithdrawal(withdrawal db.Withdrawal, decimals int32) *pb.Withdrawal {
+	rsp := &pb.Withdrawal{
+		Id:          withdrawal.ID,
+		TraderId:    withdrawal.TraderID,
+		Number:      money.Format(withdrawal.Number, decimals),
+		Destination: withdrawal.Destination,
+		Status:      withdrawal.Status,
+		Reviewer:    withdrawal.Reviewer.String,
+		CreatedTime: timestamppb.New(withdrawal.CreatedTime),
+	}
+	if withdrawal.ReviewedTime.Valid {
+		rsp.ReviewedTime = timestamppb.New(withdrawal.ReviewedTime.Time)
+	}
+	return rsp
+}
+
+func convertTransferReview(review db.TransferReview, decimals int32) *pb.TransferReview {
+	rsp := &pb.TransferReview{
+		Id:           review.ID,
+		FromTraderId: review.FromTraderID,
+		ToTraderId:   review.ToTraderID,
+		Number:       money.Format(review.Number, decimals),
+		Reasons:      review.Reasons,
+		Status:       review.Status,
+		RecordId:     review.RecordID.Int64,
+		Reviewer:     review.Reviewer.String,
+		CreatedTime:  timestamppb.New(review.CreatedTime),
+		EscrowId:     review.EscrowID.Int64,
+	}
+	if review.ReviewedTime.Valid {
+		rsp.ReviewedTime = timestamppb.New(review.ReviewedTime.Time)
+	}
+	if review.EscrowExpiresTime.Valid {
+		rsp.EscrowExpiresTime = timestamppb.New(review.EscrowExpiresTime.Time)
+	}
+	return rsp
+}
+
+func convertKYCSubmission(submission db.KycSubmission) *pb.KYCSubmission {
+	rsp := &pb.KYCSubmission{
+		Id:             submission.ID,
+		Membername:     submission.Membername,
+		Level:          submission.Level,
+		LegalName:      submission.LegalName,
+		DateOfBirth:    submission.DateOfBirth.Time.Format(time.DateOnly),
+		Country:        submission.Country,
+		DocumentType:   submission.DocumentType,
+		DocumentNumber: submission.DocumentNumber,
+		Status:         submission.Status,
+		Reason:         submission.Reason,
+		Reviewer:       submission.Reviewer.String,
+		CreatedTime:    timestamppb.New(submission.CreatedTime),
+	}
+	if submission.ReviewedTime.Valid {
+		rsp.ReviewedTime = timestamppb.New(submission.ReviewedTime.Time)
+	}
+	return rsp
+}
+
+func convertScheduledTransfer(schedule db.ScheduledTransfer, decimals int32) *pb.ScheduledTransfer {
+	rsp := &pb.ScheduledTransfer{
+		Id:                  schedule.ID,
+		Holder:              schedule.Holder,
+		FromTraderId:        schedule.FromTraderID,
+		ToTraderId:          schedule.ToTraderID,
+		Number:              money.Format(schedule.Number, decimals),
+		Cronspec:            schedule.Cronspec,
+		OnInsufficientFunds: schedule.OnInsufficientFunds,
+		Active:              schedule.Active,
+		NextRunTime:         timestamppb.New(schedule.NextRunTime),
+		CreatedTime:         timestamppb.New(schedule.CreatedTime),
+	}
+	if schedule.LastRunTime.Valid {
+		rsp.LastRunTime = timestamppb.New(schedule.LastRunTime.Time)
+	}
+	return rsp
+}
+
+func convertScheduledTransferRun(run db.ScheduledTransferRun) *pb.ScheduledTransferRun {
+	return &pb.ScheduledTransferRun{
+		Id:            run.ID,
+		ScheduleId:    run.ScheduleID,
+		ScheduledTime: timestamppb.New(run.ScheduledTime),
+		Status:        run.Status,
+		RecordId:      run.RecordID.Int64,
+		Error:         run.Error,
+		Attempts:      run.Attempts,
+		CreatedTime:   timestamppb.New(run.CreatedTime),
+	}
+}
+
+func convertEscrow(escrow db.Escrow, decimals int32) *pb.Escrow {
+	rsp := &pb.Escrow{
+		Id:              escrow.ID,
+		Buyer:           escrow.Buyer,
+		Seller:          escrow.Seller,
+		BuyerTraderId:   escrow.BuyerTraderID,
+		SellerTraderId:  escrow.SellerTraderID,
+		Number:          money.Format(escrow.Number, decimals),
+		Status:          escrow.Status,
+		BuyerConfirmed:  escrow.BuyerConfirmed,
+		SellerConfirmed: escrow.SellerConfirmed,
+		Resolver:        escrow.Resolver.String,
+		ExpiresTime:     timestamppb.New(escrow.ExpiresTime),
+		CreatedTime:     timestamppb.New(escrow.CreatedTime),
+	}
+	if escrow.SettledTime.Valid {
+		rsp.SettledTime = timestamppb.New(escrow.SettledTime.Time)
+	}
+	return rsp
+}
+
+// convertEscrowTrader converts the trader an escrow action moved funds for,
+// which is empty when no funds moved.
+func convertEscrowTrader(trader db.Trader, decimals int32) *pb.Trader {
+	if trader.ID == 0 {
+		return nil
+	}
+	return convertTrader(trader, decimals)
+}
+
+func convertFeeBreakdown(fee db.FeeBreakdown, decimals int32) *pb.FeeBreakdown {
+	rsp := &pb.FeeBreakdown{
+		RuleId:        fee.RuleID,
+		Kind:          fee.Kind,
+		RateBps:       fee.RateBps,
+		MonthlyVolume: money.Format(fee.MonthlyVolume, decimals),
+		Fee:           money.Format(fee.Fee, decimals),
+	}
+	if fee.Fee > 0 {
+		rsp.FeeDetail = convertDetail(fee.FeeDetail, decimals)
+		rsp.HouseDetail = convertDetail(fee.HouseDetail, decimals)
+	}
+	return rsp
+}
+
+func convertFeeRule(rule db.FeeRule, tiers []db.FeeTier, decimals int32) *pb.FeeRule {
+	rsp := &pb.FeeRule{
+		Id:          rule.ID,
+		Symbol:      rule.Symbol,
+		Role:        rule.Role,
+		Kind:        rule.Kind,
+		FlatFee:     money.Format(rule.FlatFee, decimals),
+		RateBps:     rule.RateBps,
+		MinFee:      money.Format(rule.MinFee, decimals),
+		MaxFee:      money.Format(rule.MaxFee, decimals),
+		Tiers:       make([]*pb.FeeTier, 0, len(tiers)),
+		CreatedTime: timestamppb.New(rule.CreatedTime),
+	}
+	for _, tier := range tiers {
+		rsp.Tiers = append(rsp.Tiers, &pb.FeeTier{
+			MinVolume: money.Format(tier.MinVolume, decimals),
+			RateBps:   tier.RateBps,
+		})
+	}
+	return rsp
+}
+
+func convertTransferLimit(limit db.TransferLimit, decimals int32) *pb.TransferLimit {
+	return &pb.TransferLimit{
+		Id:           limit.ID,
+		Symbol:       limit.Symbol,
+		Role:         limit.Role,
+		DailyLimit:   money.Format(limit.DailyLimit, decimals),
+		MonthlyLimit: money.Format(limit.MonthlyLimit, decimals),
+		CreatedTime:  timestamppb.New(limit.CreatedTime),
+		KycLevel:     limit.KycLevel,
+	}
+}
+
+func convertSymbol(symbol db.Symbol) *pb.Symbol {
+	return &pb.Symbol{
+		Code:        symbol.Code,
+		DisplayName: symbol.DisplayName,
+		Decimals:    symbol.Decimals,
+		MinTransfer: money.Format(symbol.MinTransfer, symbol.Decimals),
+		Enabled:     symbol.Enabled,
+		CreatedTime: timestamppb.New(symbol.CreatedTime),
+	}
+}
+
+func convertPrice(price pricing.Price) *pb.Price {
+	return &pb.Price{
+		Base:      price.Base,
+		Quote:     price.Quote,
+		Rate:      pricing.FormatRate(price.Rate),
+		Source:    price.Source,
+		PriceTime: timestamppb.New(price.Time),
 	}
 }
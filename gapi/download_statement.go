@@ -0,0 +1,99 @@
+package gapi
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/zerolog/log"
+	"github.com/YuanData/allegro-trade/statement"
+	"github.com/YuanData/allegro-trade/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// DownloadStatement serves GET /v1/download_statement, the HTTP counterpart of
+// ExportStatement. Streaming RPCs cannot go through the in-process gateway, and
+// a statement is better saved as a file than read as a stream of messages.
+func (server *Server) DownloadStatement(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeStatusError(w, status.Errorf(codes.Unimplemented, "method %s not allowed", r.Method))
+		return
+	}
+
+	authPayload, err := server.authorizeHeader(r.Header.Get(authorizationHeader), []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		writeStatusError(w, unauthenticatedError(err))
+		return
+	}
+
+	query := r.URL.Query()
+	traderID, _ := strconv.ParseInt(query.Get("trader_id"), 10, 64)
+	year, _ := strconv.ParseInt(query.Get("year"), 10, 32)
+	month, _ := strconv.ParseInt(query.Get("month"), 10, 32)
+	format := query.Get("format")
+
+	violations := validateStatementRequest(traderID, int32(year), int32(month), format)
+	if violations != nil {
+		writeStatusError(w, invalidArgumentError(violations))
+		return
+	}
+
+	trader, err := server.statementTrader(r.Context(), authPayload, traderID)
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+
+	period := statement.MonthPeriod(int(year), time.Month(month))
+	w.Header().Set("Content-Type", statement.Format(format).ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", statement.FileName(trader.ID, period, statement.Format(format))))
+
+	counter := &countingWriter{writer: w}
+	writer := bufio.NewWriterSize(counter, statementChunkSize)
+	_, err = server.statements.Write(r.Context(), writer, statement.Format(format), trader, period)
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		if counter.written == 0 {
+			writeStatusError(w, status.Errorf(codes.Internal, "export statement err: %s", err))
+			return
+		}
+
+		// part of the file is out already, cut the connection so the client
+		// cannot take what it got for a whole statement
+		log.Error().Err(err).Int64("trader_id", trader.ID).Msg("download statement err")
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// writeStatusError writes a status error the way the gateway writes the errors
+// of RPCs.
+func writeStatusError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	body, marshalErr := protojson.Marshal(st.Proto())
+	if marshalErr != nil {
+		http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	w.Write(body)
+}
+
+type countingWriter struct {
+	writer  http.ResponseWriter
+	written int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.written += int64(n)
+	return n, err
+}
@@ -1,6 +1,10 @@
 package gapi
 
 import (
+	"fmt"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/money"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -28,3 +32,37 @@ func invalidArgumentError(violations []*errdetails.BadRequest_FieldViolation) er
 func unauthenticatedError(err error) error {
 	return status.Errorf(codes.Unauthenticated, "Unauthenticated: %s", err)
 }
+
+// transferLimitError reports a breached transfer limit, with the limit, what was
+// already sent and the remaining allowance as details a client can act on.
+func transferLimitError(limitErr *db.TransferLimitError, decimals int32) error {
+	limit := money.Format(limitErr.Limit, decimals)
+	remaining := money.Format(limitErr.Remaining, decimals)
+	statusExhausted := status.New(codes.ResourceExhausted, fmt.Sprintf(
+		"%s transfer limit of %s %s exceeded, %s remaining", limitErr.Period, limit, limitErr.Symbol, remaining))
+
+	statusDetails, err := statusExhausted.WithDetails(
+		&errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailure_Violation{{
+				Subject:     fmt.Sprintf("member:%s/symbol:%s", limitErr.Holder, limitErr.Symbol),
+				Description: fmt.Sprintf("%s transfer limit", limitErr.Period),
+			}},
+		},
+		&errdetails.ErrorInfo{
+			Reason: "TRANSFER_LIMIT_EXCEEDED",
+			Domain: "allegro-trade",
+			Metadata: map[string]string{
+				"period":    limitErr.Period,
+				"symbol":    limitErr.Symbol,
+				"limit":     limit,
+				"sent":      money.Format(limitErr.Sent, decimals),
+				"remaining": remaining,
+			},
+		},
+	)
+	if err != nil {
+		return statusExhausted.Err()
+	}
+
+	return statusDetails.Err()
+}
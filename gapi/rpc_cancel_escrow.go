@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) CancelEscrow(ctx context.Context, req *pb.CancelEscrowRequest) (*pb.CancelEscrowResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCancelEscrowRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.CancelEscrowTx(ctx, db.EscrowActionParams{
+		EscrowID:   req.GetId(),
+		Membername: authPayload.Membername,
+	})
+	if err != nil {
+		return nil, escrowError("cancel", err)
+	}
+
+	symbol, err := server.traderSymbol(ctx, nil, result.Escrow.EscrowTraderID)
+	if err != nil {
+		return nil, err
+	}
+	decimals := server.decimals(symbol)
+
+	rsp := &pb.CancelEscrowResponse{
+		Escrow: convertEscrow(result.Escrow, decimals),
+		Trader: convertEscrowTrader(result.Trader, decimals),
+	}
+	return rsp, nil
+}
+
+func validateCancelEscrowRequest(req *pb.CancelEscrowRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
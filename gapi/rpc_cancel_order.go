@@ -0,0 +1,60 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/matching"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.CancelOrderResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCancelOrderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	order, err := server.store.GetOrder(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "order NotFound err")
+		}
+		return nil, status.Errorf(codes.Internal, "get order err: %s", err)
+	}
+
+	if authPayload.Role != util.PriestRole && order.Holder != authPayload.Membername {
+		return nil, status.Errorf(codes.PermissionDenied, "order not under member")
+	}
+
+	order, err = server.engine.CancelOrder(ctx, order)
+	if err != nil {
+		if errors.Is(err, matching.ErrOrderNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "order is no longer open")
+		}
+		return nil, status.Errorf(codes.Internal, "cancel order err: %s", err)
+	}
+
+	rsp := &pb.CancelOrderResponse{
+		Order: convertOrder(order, server.decimals(order.BaseSymbol), server.decimals(order.QuoteSymbol)),
+	}
+	return rsp, nil
+}
+
+func validateCancelOrderRequest(req *pb.CancelOrderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
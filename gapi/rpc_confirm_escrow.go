@@ -0,0 +1,70 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ConfirmEscrow(ctx context.Context, req *pb.ConfirmEscrowRequest) (*pb.ConfirmEscrowResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateConfirmEscrowRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.ConfirmEscrowTx(ctx, db.EscrowActionParams{
+		EscrowID:   req.GetId(),
+		Membername: authPayload.Membername,
+	})
+	if err != nil {
+		return nil, escrowError("confirm", err)
+	}
+
+	symbol, err := server.traderSymbol(ctx, nil, result.Escrow.EscrowTraderID)
+	if err != nil {
+		return nil, err
+	}
+	decimals := server.decimals(symbol)
+
+	rsp := &pb.ConfirmEscrowResponse{
+		Escrow: convertEscrow(result.Escrow, decimals),
+		Trader: convertEscrowTrader(result.Trader, decimals),
+	}
+	return rsp, nil
+}
+
+func validateConfirmEscrowRequest(req *pb.ConfirmEscrowRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
+
+// escrowError maps the errors of an escrow action to status codes.
+func escrowError(action string, err error) error {
+	switch {
+	case errors.Is(err, db.ErrRecordNotFound):
+		return status.Errorf(codes.NotFound, "escrow NotFound err")
+	case errors.Is(err, db.ErrNotEscrowParty):
+		return status.Errorf(codes.PermissionDenied, "%s escrow err: %s", action, err)
+	case errors.Is(err, db.ErrEscrowNotOpen),
+		errors.Is(err, db.ErrEscrowNotDisputed),
+		errors.Is(err, db.ErrEscrowSellerConfirmed),
+		errors.Is(err, db.ErrTraderNotActive):
+		return status.Errorf(codes.FailedPrecondition, "%s escrow err: %s", action, err)
+	}
+	return status.Errorf(codes.Internal, "%s escrow err: %s", action, err)
+}
@@ -0,0 +1,125 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateBatchTransfer(ctx context.Context, req *pb.CreateBatchTransferRequest) (*pb.CreateBatchTransferResponse, error) {
+	authPayload, err := server.authorizeKYCMember(ctx, []string{util.PriestRole, util.PrayerRole}, util.TransferKYCLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateBatchTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromTrader, err := server.validTrader(ctx, req.GetFromTraderId(), req.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromTrader.Holder != authPayload.Membername {
+		return nil, status.Errorf(codes.PermissionDenied, "from trader not under member")
+	}
+
+	arg := db.BatchRecordTxParams{
+		FromTraderID: req.GetFromTraderId(),
+		Legs:         make([]db.BatchLegParams, 0, len(req.GetLegs())),
+		Membername:   authPayload.Membername,
+	}
+	for i, leg := range req.GetLegs() {
+		number, err := server.parseTransferAmount(fmt.Sprintf("legs[%d].number", i), leg.GetNumber(), req.GetSymbol())
+		if err != nil {
+			return nil, err
+		}
+
+		arg.Legs = append(arg.Legs, db.BatchLegParams{
+			ToTraderID: leg.GetToTraderId(),
+			Number:     number,
+		})
+	}
+
+	result, err := server.store.BatchRecordTx(ctx, arg)
+	if err != nil && !errors.Is(err, db.ErrTransferHeld) {
+		var legErr *db.BatchLegError
+		if errors.As(err, &legErr) && errors.Is(err, db.ErrSymbolMismatch) {
+			field := fmt.Sprintf("legs[%d].to_trader_id", legErr.Index)
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation(field, legErr.Err)})
+		}
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "trader NotFound err")
+		}
+		var limitErr *db.TransferLimitError
+		if errors.As(err, &limitErr) {
+			return nil, transferLimitError(limitErr, server.decimals(req.GetSymbol()))
+		}
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrTraderNotActive) || errors.Is(err, db.ErrTransferRejected) {
+			return nil, status.Errorf(codes.FailedPrecondition, "create batch transfer err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "create batch transfer err: %s", err)
+	}
+
+	decimals := server.decimals(req.GetSymbol())
+	rsp := &pb.CreateBatchTransferResponse{
+		FromTrader: convertTrader(result.FromTrader, decimals),
+		Legs:       make([]*pb.BatchTransferLegResult, 0, len(result.Legs)),
+	}
+	for _, leg := range result.Legs {
+		// a leg held for review has no record yet
+		if leg.Review.ID != 0 {
+			rsp.Legs = append(rsp.Legs, &pb.BatchTransferLegResult{
+				ToTrader: convertTrader(leg.ToTrader, decimals),
+				Review:   convertTransferReview(leg.Review, decimals),
+			})
+			continue
+		}
+
+		rsp.Legs = append(rsp.Legs, &pb.BatchTransferLegResult{
+			Record:     convertRecord(leg.Record, decimals),
+			ToTrader:   convertTrader(leg.ToTrader, decimals),
+			FromDetail: convertDetail(leg.FromDetail, decimals),
+			ToDetail:   convertDetail(leg.ToDetail, decimals),
+			Fee:        convertFeeBreakdown(leg.Fee, decimals),
+		})
+	}
+	return rsp, nil
+}
+
+func validateCreateBatchTransferRequest(req *pb.CreateBatchTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetFromTraderId()); err != nil {
+		violations = append(violations, fieldViolation("from_trader_id", err))
+	}
+
+	if err := vld.ValidateSymbol(req.GetSymbol()); err != nil {
+		violations = append(violations, fieldViolation("symbol", err))
+	}
+
+	if err := vld.ValidateBatchLegs(len(req.GetLegs())); err != nil {
+		violations = append(violations, fieldViolation("legs", err))
+		return violations
+	}
+
+	for i, leg := range req.GetLegs() {
+		if err := vld.ValidateId(leg.GetToTraderId()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("legs[%d].to_trader_id", i), err))
+		}
+
+		if err := vld.ValidateAmount(leg.GetNumber()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("legs[%d].number", i), err))
+		}
+	}
+
+	return violations
+}
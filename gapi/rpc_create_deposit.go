@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateDeposit(ctx context.Context, req *pb.CreateDepositRequest) (*pb.CreateDepositResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateDepositRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	trader, err := server.store.GetTrader(ctx, req.GetTraderId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "trader NotFound err")
+		}
+		return nil, status.Errorf(codes.Internal, "get trader err: %s", err)
+	}
+
+	number, err := server.parseAmount("number", req.GetNumber(), trader.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.DepositTx(ctx, db.DepositTxParams{
+		TraderID:  req.GetTraderId(),
+		Number:    number,
+		Reference: req.GetReference(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "trader NotFound err")
+		}
+		if errors.Is(err, db.ErrTraderNotActive) {
+			return nil, status.Errorf(codes.FailedPrecondition, "create deposit err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "create deposit err: %s", err)
+	}
+
+	rsp := &pb.CreateDepositResponse{
+		Deposit: convertDeposit(result.Deposit, server.decimals(trader.Symbol)),
+		Trader:  convertTrader(result.Trader, server.decimals(trader.Symbol)),
+	}
+	return rsp, nil
+}
+
+func validateCreateDepositRequest(req *pb.CreateDepositRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetTraderId()); err != nil {
+		violations = append(violations, fieldViolation("trader_id", err))
+	}
+
+	if err := vld.ValidateAmount(req.GetNumber()); err != nil {
+		violations = append(violations, fieldViolation("number", err))
+	}
+
+	if err := vld.ValidateReference(req.GetReference()); err != nil {
+		violations = append(violations, fieldViolation("reference", err))
+	}
+
+	return violations
+}
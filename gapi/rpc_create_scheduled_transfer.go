@@ -0,0 +1,96 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateScheduledTransfer(ctx context.Context, req *pb.CreateScheduledTransferRequest) (*pb.CreateScheduledTransferResponse, error) {
+	authPayload, err := server.authorizeKYCMember(ctx, []string{util.PriestRole, util.PrayerRole}, util.TransferKYCLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateScheduledTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	number, err := server.parseTransferAmount("number", req.GetNumber(), req.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	fromTrader, err := server.validTrader(ctx, req.GetFromTraderId(), req.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromTrader.Holder != authPayload.Membername {
+		return nil, status.Errorf(codes.PermissionDenied, "from trader not under member")
+	}
+
+	_, err = server.validTrader(ctx, req.GetToTraderId(), req.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	nextRunTime, err := util.NextRunTime(req.GetCronspec(), time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parse cronspec err: %s", err)
+	}
+
+	schedule, err := server.store.CreateScheduledTransfer(ctx, db.CreateScheduledTransferParams{
+		Holder:              authPayload.Membername,
+		FromTraderID:        req.GetFromTraderId(),
+		ToTraderID:          req.GetToTraderId(),
+		Number:              number,
+		Cronspec:            req.GetCronspec(),
+		OnInsufficientFunds: req.GetOnInsufficientFunds(),
+		NextRunTime:         nextRunTime,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "create scheduled transfer err: %s", err)
+	}
+
+	rsp := &pb.CreateScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(schedule, server.decimals(req.GetSymbol())),
+	}
+	return rsp, nil
+}
+
+func validateCreateScheduledTransferRequest(req *pb.CreateScheduledTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetFromTraderId()); err != nil {
+		violations = append(violations, fieldViolation("from_trader_id", err))
+	}
+
+	if err := vld.ValidateId(req.GetToTraderId()); err != nil {
+		violations = append(violations, fieldViolation("to_trader_id", err))
+	}
+
+	if err := vld.ValidateAmount(req.GetNumber()); err != nil {
+		violations = append(violations, fieldViolation("number", err))
+	}
+
+	if err := vld.ValidateSymbol(req.GetSymbol()); err != nil {
+		violations = append(violations, fieldViolation("symbol", err))
+	}
+
+	if err := vld.ValidateCronspec(req.GetCronspec()); err != nil {
+		violations = append(violations, fieldViolation("cronspec", err))
+	}
+
+	if err := vld.ValidateFundsPolicy(req.GetOnInsufficientFunds()); err != nil {
+		violations = append(violations, fieldViolation("on_insufficient_funds", err))
+	}
+
+	return violations
+}
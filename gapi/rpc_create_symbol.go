@@ -0,0 +1,74 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/money"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateSymbol(ctx context.Context, req *pb.CreateSymbolRequest) (*pb.CreateSymbolResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreateSymbolRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// the symbol is not registered yet, so its amounts are read with the
+	// decimals it is created with
+	minTransfer, err := money.Parse(req.GetMinTransfer(), req.GetDecimals(), money.RoundExact)
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("min_transfer", err)})
+	}
+
+	result, err := server.store.CreateSymbolTx(ctx, db.CreateSymbolParams{
+		Code:        req.GetCode(),
+		DisplayName: req.GetDisplayName(),
+		Decimals:    req.GetDecimals(),
+		MinTransfer: minTransfer,
+		Enabled:     req.GetEnabled(),
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "symbol already exists: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "create symbol err: %s", err)
+	}
+
+	server.symbolRegistry.Invalidate()
+
+	rsp := &pb.CreateSymbolResponse{
+		Symbol: convertSymbol(result.Symbol),
+	}
+	return rsp, nil
+}
+
+func validateCreateSymbolRequest(req *pb.CreateSymbolRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateSymbolCode(req.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+
+	if err := vld.ValidateSymbolName(req.GetDisplayName()); err != nil {
+		violations = append(violations, fieldViolation("display_name", err))
+	}
+
+	if err := vld.ValidateDecimals(req.GetDecimals()); err != nil {
+		violations = append(violations, fieldViolation("decimals", err))
+	}
+
+	if err := vld.ValidateAmount(req.GetMinTransfer()); err != nil {
+		violations = append(violations, fieldViolation("min_transfer", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateTrader(ctx context.Context, req *pb.CreateTraderRequest) (*pb.CreateTraderResponse, error) {
+	authPayload, err := server.authorizeKYCMember(ctx, []string{util.PriestRole, util.PrayerRole}, util.TraderKYCLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateTraderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.CreateTraderParams{
+		Holder: authPayload.Membername,
+		Symbol: req.GetSymbol(),
+		Rest:   0,
+	}
+
+	trader, err := server.store.CreateTrader(ctx, arg)
+	if err != nil {
+		switch db.ErrorCode(err) {
+		case db.UniqueViolation:
+			return nil, status.Errorf(codes.AlreadyExists, "trader already exists: %s", err)
+		case db.ForeignKeyViolation:
+			return nil, status.Errorf(codes.FailedPrecondition, "create trader err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "create trader err: %s", err)
+	}
+
+	rsp := &pb.CreateTraderResponse{
+		Trader: convertTrader(trader, server.decimals(trader.Symbol)),
+	}
+	return rsp, nil
+}
+
+func validateCreateTraderRequest(req *pb.CreateTraderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateSymbol(req.GetSymbol()); err != nil {
+		violations = append(violations, fieldViolation("symbol", err))
+	}
+
+	return violations
+}
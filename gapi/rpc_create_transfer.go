@@ -0,0 +1,132 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
+	authPayload, err := server.authorizeKYCMember(ctx, []string{util.PriestRole, util.PrayerRole}, util.TransferKYCLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	mtdata := server.extractMetadata(ctx)
+
+	violations := validateCreateTransferRequest(req)
+	if mtdata.IdempotencyKey != "" {
+		if err := vld.ValidateIdempotencyKey(mtdata.IdempotencyKey); err != nil {
+			violations = append(violations, fieldViolation(idempotencyKeyHeader, err))
+		}
+	}
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	number, err := server.parseTransferAmount("number", req.GetNumber(), req.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	fromTrader, err := server.validTrader(ctx, req.GetFromTraderId(), req.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	if fromTrader.Holder != authPayload.Membername {
+		return nil, status.Errorf(codes.PermissionDenied, "from trader not under member")
+	}
+
+	_, err = server.validTrader(ctx, req.GetToTraderId(), req.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.RecordTx(ctx, db.RecordTxParams{
+		FromTraderID:   req.GetFromTraderId(),
+		ToTraderID:     req.GetToTraderId(),
+		Number:         number,
+		Membername:     authPayload.Membername,
+		IdempotencyKey: mtdata.IdempotencyKey,
+	})
+	if errors.Is(err, db.ErrTransferHeld) {
+		decimals := server.decimals(req.GetSymbol())
+		rsp := &pb.CreateTransferResponse{
+			FromTrader: convertTrader(result.FromTrader, decimals),
+			Review:     convertTransferReview(result.Review, decimals),
+		}
+		return rsp, nil
+	}
+	if err != nil {
+		var limitErr *db.TransferLimitError
+		if errors.As(err, &limitErr) {
+			return nil, transferLimitError(limitErr, server.decimals(req.GetSymbol()))
+		}
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrTraderNotActive) || errors.Is(err, db.ErrTransferRejected) {
+			return nil, status.Errorf(codes.FailedPrecondition, "create transfer err: %s", err)
+		}
+		if errors.Is(err, db.ErrIdempotencyKeyReused) {
+			return nil, status.Errorf(codes.AlreadyExists, "create transfer err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "create transfer err: %s", err)
+	}
+
+	decimals := server.decimals(req.GetSymbol())
+	rsp := &pb.CreateTransferResponse{
+		Record:     convertRecord(result.Record, decimals),
+		FromTrader: convertTrader(result.FromTrader, decimals),
+		ToTrader:   convertTrader(result.ToTrader, decimals),
+		FromDetail: convertDetail(result.FromDetail, decimals),
+		ToDetail:   convertDetail(result.ToDetail, decimals),
+		Fee:        convertFeeBreakdown(result.Fee, decimals),
+	}
+	return rsp, nil
+}
+
+func (server *Server) validTrader(ctx context.Context, traderID int64, symbol string) (db.Trader, error) {
+	trader, err := server.store.GetTrader(ctx, traderID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return trader, status.Errorf(codes.NotFound, "trader [%d] NotFound err", traderID)
+		}
+		return trader, status.Errorf(codes.Internal, "get trader err: %s", err)
+	}
+
+	if trader.Symbol != symbol {
+		return trader, status.Errorf(codes.InvalidArgument, "trader [%d] symbol mismatch: %s vs %s", trader.ID, trader.Symbol, symbol)
+	}
+
+	if trader.Status != util.ActiveTraderStatus {
+		return trader, status.Errorf(codes.FailedPrecondition, "trader [%d] is %s", trader.ID, trader.Status)
+	}
+
+	return trader, nil
+}
+
+func validateCreateTransferRequest(req *pb.CreateTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetFromTraderId()); err != nil {
+		violations = append(violations, fieldViolation("from_trader_id", err))
+	}
+
+	if err := vld.ValidateId(req.GetToTraderId()); err != nil {
+		violations = append(violations, fieldViolation("to_trader_id", err))
+	}
+
+	if err := vld.ValidateAmount(req.GetNumber()); err != nil {
+		violations = append(violations, fieldViolation("number", err))
+	}
+
+	if err := vld.ValidateSymbol(req.GetSymbol()); err != nil {
+		violations = append(violations, fieldViolation("symbol", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,87 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateWithdrawal(ctx context.Context, req *pb.CreateWithdrawalRequest) (*pb.CreateWithdrawalResponse, error) {
+	authPayload, err := server.authorizeKYCMember(ctx, []string{util.PriestRole, util.PrayerRole}, util.WithdrawalKYCLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateCreateWithdrawalRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	trader, err := server.store.GetTrader(ctx, req.GetTraderId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "trader NotFound err")
+		}
+		return nil, status.Errorf(codes.Internal, "get trader err: %s", err)
+	}
+
+	if trader.Holder != authPayload.Membername {
+		return nil, status.Errorf(codes.PermissionDenied, "trader not under member")
+	}
+
+	number, err := server.parseAmount("number", req.GetNumber(), trader.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.WithdrawalTxParams{
+		TraderID:    req.GetTraderId(),
+		Number:      number,
+		Destination: req.GetDestination(),
+	}
+	if server.config.WithdrawalHoldTTL > 0 {
+		arg.HoldExpiresTime = time.Now().Add(server.config.WithdrawalHoldTTL)
+	}
+
+	result, err := server.store.WithdrawalTx(ctx, arg)
+	if err != nil {
+		var limitErr *db.TransferLimitError
+		if errors.As(err, &limitErr) {
+			return nil, transferLimitError(limitErr, server.decimals(trader.Symbol))
+		}
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrTraderNotActive) {
+			return nil, status.Errorf(codes.FailedPrecondition, "create withdrawal err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "create withdrawal err: %s", err)
+	}
+
+	rsp := &pb.CreateWithdrawalResponse{
+		Withdrawal: convertWithdrawal(result.Withdrawal, server.decimals(trader.Symbol)),
+		Trader:     convertTrader(result.Trader, server.decimals(trader.Symbol)),
+	}
+	return rsp, nil
+}
+
+func validateCreateWithdrawalRequest(req *pb.CreateWithdrawalRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetTraderId()); err != nil {
+		violations = append(violations, fieldViolation("trader_id", err))
+	}
+
+	if err := vld.ValidateAmount(req.GetNumber()); err != nil {
+		violations = append(violations, fieldViolation("number", err))
+	}
+
+	if err := vld.ValidateDestination(req.GetDestination()); err != nil {
+		violations = append(violations, fieldViolation("destination", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,44 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeleteScheduledTransfer(ctx context.Context, req *pb.DeleteScheduledTransferRequest) (*pb.DeleteScheduledTransferResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateDeleteScheduledTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	schedule, err := server.memberScheduledTransfer(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.store.DeleteScheduledTransfer(ctx, schedule.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete scheduled transfer err: %s", err)
+	}
+
+	return &pb.DeleteScheduledTransferResponse{}, nil
+}
+
+func validateDeleteScheduledTransferRequest(req *pb.DeleteScheduledTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,39 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) DeleteTransferLimit(ctx context.Context, req *pb.DeleteTransferLimitRequest) (*pb.DeleteTransferLimitResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateDeleteTransferLimitRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	err = server.store.DeleteTransferLimit(ctx, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "delete transfer limit err: %s", err)
+	}
+
+	return &pb.DeleteTransferLimitResponse{}, nil
+}
+
+func validateDeleteTransferLimitRequest(req *pb.DeleteTransferLimitRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,53 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/statement"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) EmailStatement(ctx context.Context, req *pb.EmailStatementRequest) (*pb.EmailStatementResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateStatementRequest(req.GetTraderId(), req.GetYear(), req.GetMonth(), req.GetFormat())
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	trader, err := server.statementTrader(ctx, authPayload, req.GetTraderId())
+	if err != nil {
+		return nil, err
+	}
+
+	taskPayload := &worker.PayloadEmailStatement{
+		TraderID: trader.ID,
+		Year:     int(req.GetYear()),
+		Month:    int(req.GetMonth()),
+		Format:   req.GetFormat(),
+	}
+	opts := []asynq.Option{
+		asynq.MaxRetry(3),
+		asynq.Queue(worker.QueueDefault),
+	}
+
+	err = server.taskDistributor.DistributeTaskEmailStatement(ctx, taskPayload, opts...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "distribute email statement err: %s", err)
+	}
+
+	period := statement.MonthPeriod(taskPayload.Year, time.Month(taskPayload.Month))
+	rsp := &pb.EmailStatementResponse{
+		FileName: statement.FileName(trader.ID, period, statement.Format(req.GetFormat())),
+	}
+	return rsp, nil
+}
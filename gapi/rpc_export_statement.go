@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"bufio"
+	"time"
+
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/statement"
+	"github.com/YuanData/allegro-trade/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const statementChunkSize = 32 << 10
+
+func (server *Server) ExportStatement(req *pb.ExportStatementRequest, stream pb.AllegroTrade_ExportStatementServer) error {
+	ctx := stream.Context()
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return unauthenticatedError(err)
+	}
+
+	violations := validateStatementRequest(req.GetTraderId(), req.GetYear(), req.GetMonth(), req.GetFormat())
+	if violations != nil {
+		return invalidArgumentError(violations)
+	}
+
+	trader, err := server.statementTrader(ctx, authPayload, req.GetTraderId())
+	if err != nil {
+		return err
+	}
+
+	// chunks are only sent once full, so a long statement is not sent line by line
+	writer := bufio.NewWriterSize(chunkWriter{stream: stream}, statementChunkSize)
+	period := statement.MonthPeriod(int(req.GetYear()), time.Month(req.GetMonth()))
+	_, err = server.statements.Write(ctx, writer, statement.Format(req.GetFormat()), trader, period)
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "export statement err: %s", err)
+	}
+	return nil
+}
+
+// chunkWriter sends everything written to it as the chunks of a statement stream.
+type chunkWriter struct {
+	stream pb.AllegroTrade_ExportStatementServer
+}
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&pb.ExportStatementResponse{Chunk: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/pricing"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetQuote(ctx context.Context, req *pb.GetQuoteRequest) (*pb.GetQuoteResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetQuoteRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	var price pricing.Price
+	if req.At != nil {
+		price, err = server.prices.GetPriceAt(ctx, req.GetBase(), req.GetQuote(), req.GetAt().AsTime())
+	} else {
+		price, err = server.prices.GetPrice(ctx, req.GetBase(), req.GetQuote())
+	}
+	if err != nil {
+		if errors.Is(err, pricing.ErrPriceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "price NotFound err")
+		}
+		return nil, status.Errorf(codes.Internal, "get price err: %s", err)
+	}
+
+	rsp := &pb.GetQuoteResponse{
+		Price: convertPrice(price),
+	}
+	return rsp, nil
+}
+
+func validateGetQuoteRequest(req *pb.GetQuoteRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateSymbolCode(req.GetBase()); err != nil {
+		violations = append(violations, fieldViolation("base", err))
+	}
+
+	if err := vld.ValidateSymbolCode(req.GetQuote()); err != nil {
+		violations = append(violations, fieldViolation("quote", err))
+	} else if req.GetQuote() == req.GetBase() {
+		violations = append(violations, fieldViolation("quote", fmt.Errorf("must differ from base")))
+	}
+
+	return violations
+}
@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/token"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetScheduledTransfer(ctx context.Context, req *pb.GetScheduledTransferRequest) (*pb.GetScheduledTransferResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetScheduledTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	schedule, err := server.memberScheduledTransfer(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	symbol, err := server.traderSymbol(ctx, nil, schedule.FromTraderID)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(schedule, server.decimals(symbol)),
+	}
+	return rsp, nil
+}
+
+// memberScheduledTransfer gets a scheduled transfer that the member holds, or any
+// scheduled transfer for a priest.
+func (server *Server) memberScheduledTransfer(ctx context.Context, authPayload *token.Payload, id int64) (db.ScheduledTransfer, error) {
+	schedule, err := server.store.GetScheduledTransfer(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return schedule, status.Errorf(codes.NotFound, "scheduled transfer NotFound err")
+		}
+		return schedule, status.Errorf(codes.Internal, "get scheduled transfer err: %s", err)
+	}
+
+	if authPayload.Role != util.PriestRole && schedule.Holder != authPayload.Membername {
+		return schedule, status.Errorf(codes.PermissionDenied, "scheduled transfer not under member")
+	}
+
+	return schedule, nil
+}
+
+func validateGetScheduledTransferRequest(req *pb.GetScheduledTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetTrader(ctx context.Context, req *pb.GetTraderRequest) (*pb.GetTraderResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetTraderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	trader, err := server.store.GetTrader(ctx, req.GetId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "trader NotFound err")
+		}
+		return nil, status.Errorf(codes.Internal, "get trader err: %s", err)
+	}
+
+	if authPayload.Role != util.PriestRole && trader.Holder != authPayload.Membername {
+		return nil, status.Errorf(codes.PermissionDenied, "trader not under member")
+	}
+
+	rsp := &pb.GetTraderResponse{
+		Trader: convertTrader(trader, server.decimals(trader.Symbol)),
+	}
+	return rsp, nil
+}
+
+func validateGetTraderRequest(req *pb.GetTraderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,85 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pagination"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListDetails(ctx context.Context, req *pb.ListDetailsRequest) (*pb.ListDetailsResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListDetailsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	trader, err := server.store.GetTrader(ctx, req.GetTraderId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "trader NotFound err")
+		}
+		return nil, status.Errorf(codes.Internal, "get trader err: %s", err)
+	}
+
+	if authPayload.Role != util.PriestRole && trader.Holder != authPayload.Membername {
+		return nil, status.Errorf(codes.PermissionDenied, "trader not under member")
+	}
+
+	scope := strconv.FormatInt(trader.ID, 10)
+	afterID, err := server.pageTokens.Decode(req.GetPageToken(), "details", scope)
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("page_token", err)})
+	}
+
+	lmt := pagination.PageLmt(req.GetPageLmt())
+	details, err := server.store.ListDetails(ctx, db.ListDetailsParams{
+		TraderID: trader.ID,
+		AfterID:  afterID,
+		Limit:    lmt + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list details err: %s", err)
+	}
+
+	details, more := pagination.Page(details, lmt)
+	decimals := server.decimals(trader.Symbol)
+	rsp := &pb.ListDetailsResponse{
+		Details: make([]*pb.Detail, 0, len(details)),
+	}
+	for _, detail := range details {
+		rsp.Details = append(rsp.Details, convertDetail(detail, decimals))
+	}
+
+	if more {
+		rsp.NextPageToken, err = server.pageTokens.Encode("details", scope, details[len(details)-1].ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "page token err: %s", err)
+		}
+	}
+	return rsp, nil
+}
+
+func validateListDetailsRequest(req *pb.ListDetailsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetTraderId()); err != nil {
+		violations = append(violations, fieldViolation("trader_id", err))
+	}
+
+	if err := vld.ValidateCursorPageLmt(req.GetPageLmt()); err != nil {
+		violations = append(violations, fieldViolation("page_lmt", err))
+	}
+
+	return violations
+}
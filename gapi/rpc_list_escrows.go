@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListEscrows(ctx context.Context, req *pb.ListEscrowsRequest) (*pb.ListEscrowsResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListEscrowsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	escrows, err := server.store.ListEscrows(ctx, db.ListEscrowsParams{
+		Membername: authPayload.Membername,
+		Limit:      req.GetPageLmt(),
+		Offset:     (req.GetPageNum() - 1) * req.GetPageLmt(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list escrows err: %s", err)
+	}
+
+	rsp := &pb.ListEscrowsResponse{
+		Escrows: make([]*pb.Escrow, 0, len(escrows)),
+	}
+	symbols := make(map[int64]string)
+	for _, escrow := range escrows {
+		symbol, err := server.traderSymbol(ctx, symbols, escrow.EscrowTraderID)
+		if err != nil {
+			return nil, err
+		}
+		rsp.Escrows = append(rsp.Escrows, convertEscrow(escrow, server.decimals(symbol)))
+	}
+	return rsp, nil
+}
+
+func validateListEscrowsRequest(req *pb.ListEscrowsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidatePageNum(req.GetPageNum()); err != nil {
+		violations = append(violations, fieldViolation("page_num", err))
+	}
+
+	if err := vld.ValidatePageLmt(req.GetPageLmt()); err != nil {
+		violations = append(violations, fieldViolation("page_lmt", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,57 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListFeeRules(ctx context.Context, req *pb.ListFeeRulesRequest) (*pb.ListFeeRulesResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListFeeRulesRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rules, err := server.store.ListFeeRules(ctx, db.ListFeeRulesParams{
+		Limit:  req.GetPageLmt(),
+		Offset: (req.GetPageNum() - 1) * req.GetPageLmt(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list fee rules err: %s", err)
+	}
+
+	rsp := &pb.ListFeeRulesResponse{
+		Rules: make([]*pb.FeeRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		tiers, err := server.store.ListFeeTiers(ctx, rule.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "list fee tiers err: %s", err)
+		}
+		rsp.Rules = append(rsp.Rules, convertFeeRule(rule, tiers, server.decimals(rule.Symbol)))
+	}
+	return rsp, nil
+}
+
+func validateListFeeRulesRequest(req *pb.ListFeeRulesRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidatePageNum(req.GetPageNum()); err != nil {
+		violations = append(violations, fieldViolation("page_num", err))
+	}
+
+	if err := vld.ValidatePageLmt(req.GetPageLmt()); err != nil {
+		violations = append(violations, fieldViolation("page_lmt", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,58 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListKYCSubmissions(ctx context.Context, req *pb.ListKYCSubmissionsRequest) (*pb.ListKYCSubmissionsResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListKYCSubmissionsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	submissions, err := server.store.ListKYCSubmissions(ctx, db.ListKYCSubmissionsParams{
+		Status: req.GetStatus(),
+		Limit:  req.GetPageLmt(),
+		Offset: (req.GetPageNum() - 1) * req.GetPageLmt(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list kyc submissions err: %s", err)
+	}
+
+	rsp := &pb.ListKYCSubmissionsResponse{
+		Submissions: make([]*pb.KYCSubmission, 0, len(submissions)),
+	}
+	for _, submission := range submissions {
+		rsp.Submissions = append(rsp.Submissions, convertKYCSubmission(submission))
+	}
+	return rsp, nil
+}
+
+func validateListKYCSubmissionsRequest(req *pb.ListKYCSubmissionsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateReviewStatus(req.GetStatus()); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+
+	if err := vld.ValidatePageNum(req.GetPageNum()); err != nil {
+		violations = append(violations, fieldViolation("page_num", err))
+	}
+
+	if err := vld.ValidatePageLmt(req.GetPageLmt()); err != nil {
+		violations = append(violations, fieldViolation("page_lmt", err))
+	}
+
+	return violations
+}
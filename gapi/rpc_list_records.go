@@ -0,0 +1,85 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pagination"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListRecords(ctx context.Context, req *pb.ListRecordsRequest) (*pb.ListRecordsResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListRecordsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	trader, err := server.store.GetTrader(ctx, req.GetTraderId())
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "trader NotFound err")
+		}
+		return nil, status.Errorf(codes.Internal, "get trader err: %s", err)
+	}
+
+	if authPayload.Role != util.PriestRole && trader.Holder != authPayload.Membername {
+		return nil, status.Errorf(codes.PermissionDenied, "trader not under member")
+	}
+
+	scope := strconv.FormatInt(trader.ID, 10)
+	afterID, err := server.pageTokens.Decode(req.GetPageToken(), "records", scope)
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("page_token", err)})
+	}
+
+	lmt := pagination.PageLmt(req.GetPageLmt())
+	records, err := server.store.ListRecords(ctx, db.ListRecordsParams{
+		TraderID: trader.ID,
+		AfterID:  afterID,
+		Limit:    lmt + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list records err: %s", err)
+	}
+
+	records, more := pagination.Page(records, lmt)
+	decimals := server.decimals(trader.Symbol)
+	rsp := &pb.ListRecordsResponse{
+		Records: make([]*pb.Record, 0, len(records)),
+	}
+	for _, record := range records {
+		rsp.Records = append(rsp.Records, convertRecord(record, decimals))
+	}
+
+	if more {
+		rsp.NextPageToken, err = server.pageTokens.Encode("records", scope, records[len(records)-1].ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "page token err: %s", err)
+		}
+	}
+	return rsp, nil
+}
+
+func validateListRecordsRequest(req *pb.ListRecordsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetTraderId()); err != nil {
+		violations = append(violations, fieldViolation("trader_id", err))
+	}
+
+	if err := vld.ValidateCursorPageLmt(req.GetPageLmt()); err != nil {
+		violations = append(violations, fieldViolation("page_lmt", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListScheduledTransferRuns(ctx context.Context, req *pb.ListScheduledTransferRunsRequest) (*pb.ListScheduledTransferRunsResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListScheduledTransferRunsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	schedule, err := server.memberScheduledTransfer(ctx, authPayload, req.GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
+
+	runs, err := server.store.ListScheduledTransferRuns(ctx, db.ListScheduledTransferRunsParams{
+		ScheduleID: schedule.ID,
+		Limit:      req.GetPageLmt(),
+		Offset:     (req.GetPageNum() - 1) * req.GetPageLmt(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list scheduled transfer runs err: %s", err)
+	}
+
+	rsp := &pb.ListScheduledTransferRunsResponse{
+		Runs: make([]*pb.ScheduledTransferRun, 0, len(runs)),
+	}
+	for _, run := range runs {
+		rsp.Runs = append(rsp.Runs, convertScheduledTransferRun(run))
+	}
+	return rsp, nil
+}
+
+func validateListScheduledTransferRunsRequest(req *pb.ListScheduledTransferRunsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetScheduleId()); err != nil {
+		violations = append(violations, fieldViolation("schedule_id", err))
+	}
+
+	if err := vld.ValidatePageNum(req.GetPageNum()); err != nil {
+		violations = append(violations, fieldViolation("page_num", err))
+	}
+
+	if err := vld.ValidatePageLmt(req.GetPageLmt()); err != nil {
+		violations = append(violations, fieldViolation("page_lmt", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListScheduledTransfers(ctx context.Context, req *pb.ListScheduledTransfersRequest) (*pb.ListScheduledTransfersResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListScheduledTransfersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	schedules, err := server.store.ListScheduledTransfers(ctx, db.ListScheduledTransfersParams{
+		Holder: authPayload.Membername,
+		Limit:  req.GetPageLmt(),
+		Offset: (req.GetPageNum() - 1) * req.GetPageLmt(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list scheduled transfers err: %s", err)
+	}
+
+	rsp := &pb.ListScheduledTransfersResponse{
+		ScheduledTransfers: make([]*pb.ScheduledTransfer, 0, len(schedules)),
+	}
+	symbols := make(map[int64]string)
+	for _, schedule := range schedules {
+		symbol, err := server.traderSymbol(ctx, symbols, schedule.FromTraderID)
+		if err != nil {
+			return nil, err
+		}
+		rsp.ScheduledTransfers = append(rsp.ScheduledTransfers, convertScheduledTransfer(schedule, server.decimals(symbol)))
+	}
+	return rsp, nil
+}
+
+func validateListScheduledTransfersRequest(req *pb.ListScheduledTransfersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidatePageNum(req.GetPageNum()); err != nil {
+		violations = append(violations, fieldViolation("page_num", err))
+	}
+
+	if err := vld.ValidatePageLmt(req.GetPageLmt()); err != nil {
+		violations = append(violations, fieldViolation("page_lmt", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,30 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListSymbols(ctx context.Context, req *pb.ListSymbolsRequest) (*pb.ListSymbolsResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	symbols, err := server.store.ListSymbols(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list symbols err: %s", err)
+	}
+
+	rsp := &pb.ListSymbolsResponse{
+		Symbols: make([]*pb.Symbol, 0, len(symbols)),
+	}
+	for _, symbol := range symbols {
+		rsp.Symbols = append(rsp.Symbols, convertSymbol(symbol))
+	}
+	return rsp, nil
+}
@@ -0,0 +1,65 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pagination"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListTraders(ctx context.Context, req *pb.ListTradersRequest) (*pb.ListTradersResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListTradersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	afterID, err := server.pageTokens.Decode(req.GetPageToken(), "traders", authPayload.Membername)
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("page_token", err)})
+	}
+
+	lmt := pagination.PageLmt(req.GetPageLmt())
+	traders, err := server.store.ListTraders(ctx, db.ListTradersParams{
+		Holder:  authPayload.Membername,
+		AfterID: afterID,
+		Limit:   lmt + 1,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list traders err: %s", err)
+	}
+
+	traders, more := pagination.Page(traders, lmt)
+	rsp := &pb.ListTradersResponse{
+		Traders: make([]*pb.Trader, 0, len(traders)),
+	}
+	for _, trader := range traders {
+		rsp.Traders = append(rsp.Traders, convertTrader(trader, server.decimals(trader.Symbol)))
+	}
+
+	if more {
+		rsp.NextPageToken, err = server.pageTokens.Encode("traders", authPayload.Membername, traders[len(traders)-1].ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "page token err: %s", err)
+		}
+	}
+	return rsp, nil
+}
+
+func validateListTradersRequest(req *pb.ListTradersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateCursorPageLmt(req.GetPageLmt()); err != nil {
+		violations = append(violations, fieldViolation("page_lmt", err))
+	}
+
+	return violations
+}
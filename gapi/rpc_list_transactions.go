@@ -0,0 +1,223 @@
+package gapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pagination"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func (server *Server) ListTransactions(ctx context.Context, req *pb.ListTransactionsRequest) (*pb.ListTransactionsResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListTransactionsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.ListTransactionsParams{
+		Holder: pgtype.Text{
+			String: authPayload.Membername,
+			Valid:  true,
+		},
+		CounterpartyID: pgtype.Int8{
+			Int64: req.GetCounterpartyId(),
+			Valid: req.CounterpartyId != nil,
+		},
+		Direction: pgtype.Text{
+			String: req.GetDirection(),
+			Valid:  req.Direction != nil,
+		},
+		Symbol: pgtype.Text{
+			String: req.GetSymbol(),
+			Valid:  req.Symbol != nil,
+		},
+		CreatedFrom: pgtype.Timestamptz{
+			Time:  req.GetCreatedFrom().AsTime(),
+			Valid: req.CreatedFrom != nil,
+		},
+		CreatedTo: pgtype.Timestamptz{
+			Time:  req.GetCreatedTo().AsTime(),
+			Valid: req.CreatedTo != nil,
+		},
+		SortBy:     req.GetSortBy(),
+		Descending: req.GetDescending(),
+	}
+	if arg.SortBy == "" {
+		arg.SortBy = util.CreatedTimeSort
+	}
+
+	symbol := req.GetSymbol()
+	if req.TraderId != nil {
+		trader, err := server.store.GetTrader(ctx, req.GetTraderId())
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "trader NotFound err")
+			}
+			return nil, status.Errorf(codes.Internal, "get trader err: %s", err)
+		}
+
+		if authPayload.Role != util.PriestRole && trader.Holder != authPayload.Membername {
+			return nil, status.Errorf(codes.PermissionDenied, "trader not under member")
+		}
+
+		// priests may look into the history of any trader
+		arg.Holder.Valid = false
+		arg.TraderID = pgtype.Int8{
+			Int64: trader.ID,
+			Valid: true,
+		}
+		symbol = trader.Symbol
+	}
+
+	if req.MinNumber != nil || req.MaxNumber != nil {
+		// amounts only compare within one symbol
+		if symbol == "" {
+			err := fmt.Errorf("must be given to filter by amount")
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("symbol", err)})
+		}
+
+		if req.MinNumber != nil {
+			number, err := server.parseAmount("min_number", req.GetMinNumber(), symbol)
+			if err != nil {
+				return nil, err
+			}
+			arg.MinNumber = pgtype.Int8{Int64: number, Valid: true}
+		}
+
+		if req.MaxNumber != nil {
+			number, err := server.parseAmount("max_number", req.GetMaxNumber(), symbol)
+			if err != nil {
+				return nil, err
+			}
+			arg.MaxNumber = pgtype.Int8{Int64: number, Valid: true}
+		}
+
+		if arg.MinNumber.Valid && arg.MaxNumber.Valid && arg.MinNumber.Int64 > arg.MaxNumber.Int64 {
+			err := fmt.Errorf("must not be below min_number")
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("max_number", err)})
+		}
+	}
+
+	scope, err := transactionsScope(authPayload.Membername, req)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "page token err: %s", err)
+	}
+
+	arg.AfterNumber, arg.AfterID, err = server.pageTokens.DecodeKeyset(req.GetPageToken(), "transactions", scope)
+	if err != nil {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("page_token", err)})
+	}
+
+	lmt := pagination.PageLmt(req.GetPageLmt())
+	arg.Limit = lmt + 1
+	transactions, err := server.store.ListTransactions(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list transactions err: %s", err)
+	}
+
+	transactions, more := pagination.Page(transactions, lmt)
+	rsp := &pb.ListTransactionsResponse{
+		Transactions: make([]*pb.Transaction, 0, len(transactions)),
+	}
+	for _, transaction := range transactions {
+		rsp.Transactions = append(rsp.Transactions, convertTransaction(transaction, server.decimals(transaction.Symbol)))
+	}
+
+	if more {
+		last := transactions[len(transactions)-1]
+		rsp.NextPageToken, err = server.pageTokens.EncodeKeyset("transactions", scope, last.Number, last.RecordID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "page token err: %s", err)
+		}
+	}
+	return rsp, nil
+}
+
+// transactionsScope binds page tokens to the member and to every filter and sort
+// option of the request, so a token cannot continue a differently filtered listing.
+func transactionsScope(membername string, req *pb.ListTransactionsRequest) (string, error) {
+	filter := proto.Clone(req).(*pb.ListTransactionsRequest)
+	filter.PageLmt = 0
+	filter.PageToken = ""
+
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(membername))
+	hash.Write([]byte{0})
+	hash.Write(encoded)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func validateListTransactionsRequest(req *pb.ListTransactionsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.TraderId != nil {
+		if err := vld.ValidateId(req.GetTraderId()); err != nil {
+			violations = append(violations, fieldViolation("trader_id", err))
+		}
+	}
+
+	if req.CounterpartyId != nil {
+		if err := vld.ValidateId(req.GetCounterpartyId()); err != nil {
+			violations = append(violations, fieldViolation("counterparty_id", err))
+		}
+	}
+
+	if req.Direction != nil {
+		if err := vld.ValidateDirection(req.GetDirection()); err != nil {
+			violations = append(violations, fieldViolation("direction", err))
+		}
+	}
+
+	if req.MinNumber != nil {
+		if err := vld.ValidateNonNegativeAmount(req.GetMinNumber()); err != nil {
+			violations = append(violations, fieldViolation("min_number", err))
+		}
+	}
+
+	if req.MaxNumber != nil {
+		if err := vld.ValidateAmount(req.GetMaxNumber()); err != nil {
+			violations = append(violations, fieldViolation("max_number", err))
+		}
+	}
+
+	if req.Symbol != nil {
+		if err := vld.ValidateSymbolCode(req.GetSymbol()); err != nil {
+			violations = append(violations, fieldViolation("symbol", err))
+		}
+	}
+
+	if req.CreatedFrom != nil && req.CreatedTo != nil && !req.GetCreatedFrom().AsTime().Before(req.GetCreatedTo().AsTime()) {
+		violations = append(violations, fieldViolation("created_to", fmt.Errorf("must be after created_from")))
+	}
+
+	if req.SortBy != "" {
+		if err := vld.ValidateTransactionSort(req.GetSortBy()); err != nil {
+			violations = append(violations, fieldViolation("sort_by", err))
+		}
+	}
+
+	if err := vld.ValidateCursorPageLmt(req.GetPageLmt()); err != nil {
+		violations = append(violations, fieldViolation("page_lmt", err))
+	}
+
+	return violations
+}
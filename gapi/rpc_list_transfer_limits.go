@@ -0,0 +1,53 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListTransferLimits(ctx context.Context, req *pb.ListTransferLimitsRequest) (*pb.ListTransferLimitsResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListTransferLimitsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	limits, err := server.store.ListTransferLimits(ctx, db.ListTransferLimitsParams{
+		Limit:  req.GetPageLmt(),
+		Offset: (req.GetPageNum() - 1) * req.GetPageLmt(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list transfer limits err: %s", err)
+	}
+
+	rsp := &pb.ListTransferLimitsResponse{
+		Limits: make([]*pb.TransferLimit, 0, len(limits)),
+	}
+	for _, limit := range limits {
+		rsp.Limits = append(rsp.Limits, convertTransferLimit(limit, server.decimals(limit.Symbol)))
+	}
+	return rsp, nil
+}
+
+func validateListTransferLimitsRequest(req *pb.ListTransferLimitsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidatePageNum(req.GetPageNum()); err != nil {
+		violations = append(violations, fieldViolation("page_num", err))
+	}
+
+	if err := vld.ValidatePageLmt(req.GetPageLmt()); err != nil {
+		violations = append(violations, fieldViolation("page_lmt", err))
+	}
+
+	return violations
+}
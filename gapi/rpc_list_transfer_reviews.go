@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListTransferReviews(ctx context.Context, req *pb.ListTransferReviewsRequest) (*pb.ListTransferReviewsResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListTransferReviewsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	reviews, err := server.store.ListTransferReviews(ctx, db.ListTransferReviewsParams{
+		Status: req.GetStatus(),
+		Limit:  req.GetPageLmt(),
+		Offset: (req.GetPageNum() - 1) * req.GetPageLmt(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list transfer reviews err: %s", err)
+	}
+
+	rsp := &pb.ListTransferReviewsResponse{
+		Reviews: make([]*pb.TransferReview, 0, len(reviews)),
+	}
+	symbols := make(map[int64]string)
+	for _, review := range reviews {
+		symbol, err := server.traderSymbol(ctx, symbols, review.FromTraderID)
+		if err != nil {
+			return nil, err
+		}
+		rsp.Reviews = append(rsp.Reviews, convertTransferReview(review, server.decimals(symbol)))
+	}
+	return rsp, nil
+}
+
+func validateListTransferReviewsRequest(req *pb.ListTransferReviewsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateReviewStatus(req.GetStatus()); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+
+	if err := vld.ValidatePageNum(req.GetPageNum()); err != nil {
+		violations = append(violations, fieldViolation("page_num", err))
+	}
+
+	if err := vld.ValidatePageLmt(req.GetPageLmt()); err != nil {
+		violations = append(violations, fieldViolation("page_lmt", err))
+	}
+
+	return violations
+}
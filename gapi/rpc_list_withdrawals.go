@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListWithdrawals(ctx context.Context, req *pb.ListWithdrawalsRequest) (*pb.ListWithdrawalsResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListWithdrawalsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	withdrawals, err := server.store.ListWithdrawals(ctx, db.ListWithdrawalsParams{
+		Status: req.GetStatus(),
+		Limit:  req.GetPageLmt(),
+		Offset: (req.GetPageNum() - 1) * req.GetPageLmt(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list withdrawals err: %s", err)
+	}
+
+	rsp := &pb.ListWithdrawalsResponse{
+		Withdrawals: make([]*pb.Withdrawal, 0, len(withdrawals)),
+	}
+	symbols := make(map[int64]string)
+	for _, withdrawal := range withdrawals {
+		symbol, err := server.traderSymbol(ctx, symbols, withdrawal.TraderID)
+		if err != nil {
+			return nil, err
+		}
+		rsp.Withdrawals = append(rsp.Withdrawals, convertWithdrawal(withdrawal, server.decimals(symbol)))
+	}
+	return rsp, nil
+}
+
+func validateListWithdrawalsRequest(req *pb.ListWithdrawalsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateWithdrawalStatus(req.GetStatus()); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+
+	if err := vld.ValidatePageNum(req.GetPageNum()); err != nil {
+		violations = append(violations, fieldViolation("page_num", err))
+	}
+
+	if err := vld.ValidatePageLmt(req.GetPageLmt()); err != nil {
+		violations = append(violations, fieldViolation("page_lmt", err))
+	}
+
+	return violations
+}
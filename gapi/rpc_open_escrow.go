@@ -0,0 +1,104 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) OpenEscrow(ctx context.Context, req *pb.OpenEscrowRequest) (*pb.OpenEscrowResponse, error) {
+	authPayload, err := server.authorizeKYCMember(ctx, []string{util.PriestRole, util.PrayerRole}, util.TransferKYCLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateOpenEscrowRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	number, err := server.parseAmount("number", req.GetNumber(), req.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	buyerTrader, err := server.validTrader(ctx, req.GetBuyerTraderId(), req.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	if buyerTrader.Holder != authPayload.Membername {
+		return nil, status.Errorf(codes.PermissionDenied, "buyer trader not under member")
+	}
+
+	_, err = server.validTrader(ctx, req.GetSellerTraderId(), req.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.OpenEscrowTx(ctx, db.OpenEscrowTxParams{
+		BuyerTraderID:  req.GetBuyerTraderId(),
+		SellerTraderID: req.GetSellerTraderId(),
+		Number:         number,
+		ExpiresTime:    time.Now().Add(req.GetTimeout().AsDuration()),
+	})
+	if errors.Is(err, db.ErrTransferHeld) {
+		decimals := server.decimals(req.GetSymbol())
+		rsp := &pb.OpenEscrowResponse{
+			BuyerTrader: convertTrader(result.Trader, decimals),
+			Review:      convertTransferReview(result.Review, decimals),
+		}
+		return rsp, nil
+	}
+	if err != nil {
+		var limitErr *db.TransferLimitError
+		if errors.As(err, &limitErr) {
+			return nil, transferLimitError(limitErr, server.decimals(req.GetSymbol()))
+		}
+		if errors.Is(err, db.ErrSameEscrowParty) {
+			return nil, status.Errorf(codes.InvalidArgument, "open escrow err: %s", err)
+		}
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrTraderNotActive) || errors.Is(err, db.ErrTransferRejected) {
+			return nil, status.Errorf(codes.FailedPrecondition, "open escrow err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "open escrow err: %s", err)
+	}
+
+	rsp := &pb.OpenEscrowResponse{
+		Escrow:      convertEscrow(result.Escrow, server.decimals(req.GetSymbol())),
+		BuyerTrader: convertTrader(result.Trader, server.decimals(req.GetSymbol())),
+	}
+	return rsp, nil
+}
+
+func validateOpenEscrowRequest(req *pb.OpenEscrowRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetBuyerTraderId()); err != nil {
+		violations = append(violations, fieldViolation("buyer_trader_id", err))
+	}
+
+	if err := vld.ValidateId(req.GetSellerTraderId()); err != nil {
+		violations = append(violations, fieldViolation("seller_trader_id", err))
+	}
+
+	if err := vld.ValidateAmount(req.GetNumber()); err != nil {
+		violations = append(violations, fieldViolation("number", err))
+	}
+
+	if err := vld.ValidateSymbol(req.GetSymbol()); err != nil {
+		violations = append(violations, fieldViolation("symbol", err))
+	}
+
+	if err := vld.ValidateEscrowTimeout(req.GetTimeout().AsDuration()); err != nil {
+		violations = append(violations, fieldViolation("timeout", err))
+	}
+
+	return violations
+}
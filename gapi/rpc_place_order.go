@@ -0,0 +1,97 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/matching"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) PlaceOrder(ctx context.Context, req *pb.PlaceOrderRequest) (*pb.PlaceOrderResponse, error) {
+	authPayload, err := server.authorizeKYCMember(ctx, []string{util.PriestRole, util.PrayerRole}, util.TransferKYCLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validatePlaceOrderRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	pair, _ := matching.ParsePair(req.GetPair())
+
+	// a price is in the quote symbol per whole unit of the base symbol
+	price, err := server.parseAmount("price", req.GetPrice(), pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := server.parseAmount("quantity", req.GetQuantity(), pair.Base)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.engine.PlaceOrder(ctx, matching.PlaceOrderParams{
+		Holder:   authPayload.Membername,
+		Pair:     pair,
+		Side:     req.GetSide(),
+		Kind:     req.GetKind(),
+		Price:    price,
+		Quantity: quantity,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "member has no trader for pair %s: %s", pair, err)
+		}
+		if errors.Is(err, db.ErrOrderTooSmall) {
+			return nil, status.Errorf(codes.InvalidArgument, "place order err: %s", err)
+		}
+		if errors.Is(err, db.ErrInsufficientFunds) || errors.Is(err, db.ErrTraderNotActive) {
+			return nil, status.Errorf(codes.FailedPrecondition, "place order err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "place order err: %s", err)
+	}
+
+	baseDecimals, quoteDecimals := server.decimals(pair.Base), server.decimals(pair.Quote)
+	rsp := &pb.PlaceOrderResponse{
+		Order: convertOrder(result.Order, baseDecimals, quoteDecimals),
+		Fills: make([]*pb.Fill, 0, len(result.Fills)),
+	}
+	for _, fill := range result.Fills {
+		rsp.Fills = append(rsp.Fills, convertFill(fill, baseDecimals, quoteDecimals))
+	}
+	return rsp, nil
+}
+
+func validatePlaceOrderRequest(req *pb.PlaceOrderRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if _, err := matching.ParsePair(req.GetPair()); err != nil {
+		violations = append(violations, fieldViolation("pair", err))
+	}
+
+	if err := vld.ValidateSide(req.GetSide()); err != nil {
+		violations = append(violations, fieldViolation("side", err))
+	}
+
+	if err := vld.ValidateKind(req.GetKind()); err != nil {
+		violations = append(violations, fieldViolation("kind", err))
+	}
+
+	if req.GetKind() == util.LimitKind {
+		if err := vld.ValidateAmount(req.GetPrice()); err != nil {
+			violations = append(violations, fieldViolation("price", err))
+		}
+	}
+
+	if err := vld.ValidateAmount(req.GetQuantity()); err != nil {
+		violations = append(violations, fieldViolation("quantity", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,52 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ResolveEscrow(ctx context.Context, req *pb.ResolveEscrowRequest) (*pb.ResolveEscrowResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateResolveEscrowRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.ResolveEscrowTx(ctx, db.ResolveEscrowTxParams{
+		EscrowID: req.GetId(),
+		Resolver: authPayload.Membername,
+		Release:  req.GetRelease(),
+	})
+	if err != nil {
+		return nil, escrowError("resolve", err)
+	}
+
+	symbol, err := server.traderSymbol(ctx, nil, result.Escrow.EscrowTraderID)
+	if err != nil {
+		return nil, err
+	}
+	decimals := server.decimals(symbol)
+
+	rsp := &pb.ResolveEscrowResponse{
+		Escrow: convertEscrow(result.Escrow, decimals),
+		Trader: convertEscrowTrader(result.Trader, decimals),
+	}
+	return rsp, nil
+}
+
+func validateResolveEscrowRequest(req *pb.ResolveEscrowRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
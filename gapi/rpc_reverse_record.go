@@ -0,0 +1,94 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ReverseRecord(ctx context.Context, req *pb.ReverseRecordRequest) (*pb.ReverseRecordResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateReverseRecordRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	var number int64
+	if req.GetNumber() != "" {
+		record, err := server.store.GetRecord(ctx, req.GetRecordId())
+		if err != nil {
+			if errors.Is(err, db.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "record NotFound err")
+			}
+			return nil, status.Errorf(codes.Internal, "get record err: %s", err)
+		}
+
+		symbol, err := server.traderSymbol(ctx, nil, record.FromTraderID)
+		if err != nil {
+			return nil, err
+		}
+
+		number, err = server.parseAmount("number", req.GetNumber(), symbol)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := server.store.ReverseRecordTx(ctx, db.ReverseRecordTxParams{
+		RecordID:   req.GetRecordId(),
+		Number:     number,
+		Membername: authPayload.Membername,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "record NotFound err")
+		}
+		if errors.Is(err, db.ErrReversalExceeded) {
+			return nil, status.Errorf(codes.InvalidArgument, "reverse record err: %s", err)
+		}
+		if errors.Is(err, db.ErrRecordAlreadyReversed) ||
+			errors.Is(err, db.ErrReverseReversal) ||
+			errors.Is(err, db.ErrInsufficientFunds) ||
+			errors.Is(err, db.ErrTraderNotActive) {
+			return nil, status.Errorf(codes.FailedPrecondition, "reverse record err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "reverse record err: %s", err)
+	}
+
+	decimals := server.decimals(result.FromTrader.Symbol)
+	rsp := &pb.ReverseRecordResponse{
+		Original:   convertRecord(result.Original, decimals),
+		Record:     convertRecord(result.Record, decimals),
+		FromTrader: convertTrader(result.FromTrader, decimals),
+		ToTrader:   convertTrader(result.ToTrader, decimals),
+		FromDetail: convertDetail(result.FromDetail, decimals),
+		ToDetail:   convertDetail(result.ToDetail, decimals),
+	}
+	return rsp, nil
+}
+
+func validateReverseRecordRequest(req *pb.ReverseRecordRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetRecordId()); err != nil {
+		violations = append(violations, fieldViolation("record_id", err))
+	}
+
+	// no number reverses whatever is left of the record
+	if req.GetNumber() != "" {
+		if err := vld.ValidateAmount(req.GetNumber()); err != nil {
+			violations = append(violations, fieldViolation("number", err))
+		}
+	}
+
+	return violations
+}
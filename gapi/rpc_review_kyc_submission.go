@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ReviewKYCSubmission(ctx context.Context, req *pb.ReviewKYCSubmissionRequest) (*pb.ReviewKYCSubmissionResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateReviewKYCSubmissionRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.ReviewKYCSubmissionTx(ctx, db.ReviewKYCSubmissionTxParams{
+		SubmissionID: req.GetId(),
+		Reviewer:     authPayload.Membername,
+		Approve:      req.GetApprove(),
+		Reason:       req.GetReason(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "kyc submission NotFound err")
+		}
+		if errors.Is(err, db.ErrKYCSubmissionNotPending) {
+			return nil, status.Errorf(codes.FailedPrecondition, "review kyc submission err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "review kyc submission err: %s", err)
+	}
+
+	rsp := &pb.ReviewKYCSubmissionResponse{
+		Submission: convertKYCSubmission(result.Submission),
+		Member:     convertMember(result.Member),
+	}
+	return rsp, nil
+}
+
+func validateReviewKYCSubmissionRequest(req *pb.ReviewKYCSubmissionRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	// a rejected member is owed an explanation
+	if !req.GetApprove() && req.GetReason() == "" {
+		violations = append(violations, fieldViolation("reason", fmt.Errorf("must be given to reject")))
+	} else if err := vld.ValidateString(req.GetReason(), 0, 256); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,68 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ReviewTransfer(ctx context.Context, req *pb.ReviewTransferRequest) (*pb.ReviewTransferResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateReviewTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.ReviewTransferTx(ctx, db.ReviewTransferTxParams{
+		ReviewID: req.GetId(),
+		Reviewer: authPayload.Membername,
+		Approve:  req.GetApprove(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "transfer review NotFound err")
+		}
+		var limitErr *db.TransferLimitError
+		if errors.As(err, &limitErr) {
+			return nil, transferLimitError(limitErr, server.decimals(limitErr.Symbol))
+		}
+		if errors.Is(err, db.ErrTransferReviewNotPending) ||
+			errors.Is(err, db.ErrInsufficientFunds) ||
+			errors.Is(err, db.ErrTraderNotActive) {
+			return nil, status.Errorf(codes.FailedPrecondition, "review transfer err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "review transfer err: %s", err)
+	}
+
+	decimals := server.decimals(result.FromTrader.Symbol)
+	rsp := &pb.ReviewTransferResponse{
+		Review:     convertTransferReview(result.Review, decimals),
+		FromTrader: convertTrader(result.FromTrader, decimals),
+	}
+	if result.Review.RecordID.Valid {
+		rsp.Record = convertRecord(result.Record.Record, decimals)
+	}
+	if result.Review.EscrowID.Valid {
+		rsp.Escrow = convertEscrow(result.Escrow, decimals)
+	}
+	return rsp, nil
+}
+
+func validateReviewTransferRequest(req *pb.ReviewTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
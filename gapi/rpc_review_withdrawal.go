@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ReviewWithdrawal(ctx context.Context, req *pb.ReviewWithdrawalRequest) (*pb.ReviewWithdrawalResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateReviewWithdrawalRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.ReviewWithdrawalTx(ctx, db.ReviewWithdrawalTxParams{
+		WithdrawalID: req.GetId(),
+		Reviewer:     authPayload.Membername,
+		Approve:      req.GetApprove(),
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "withdrawal NotFound err")
+		}
+		if errors.Is(err, db.ErrWithdrawalNotPending) || errors.Is(err, db.ErrTraderNotActive) {
+			return nil, status.Errorf(codes.FailedPrecondition, "review withdrawal err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "review withdrawal err: %s", err)
+	}
+
+	rsp := &pb.ReviewWithdrawalResponse{
+		Withdrawal: convertWithdrawal(result.Withdrawal, server.decimals(result.Trader.Symbol)),
+		Trader:     convertTrader(result.Trader, server.decimals(result.Trader.Symbol)),
+	}
+	return rsp, nil
+}
+
+func validateReviewWithdrawalRequest(req *pb.ReviewWithdrawalRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,132 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetFeeRule(ctx context.Context, req *pb.SetFeeRuleRequest) (*pb.SetFeeRuleResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSetFeeRuleRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	symbol := req.GetSymbol()
+
+	flatFee, err := server.parseAmount("flat_fee", req.GetFlatFee(), symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	minFee, err := server.parseAmount("min_fee", req.GetMinFee(), symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	maxFee, err := server.parseAmount("max_fee", req.GetMaxFee(), symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxFee > 0 && maxFee < minFee {
+		return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("max_fee", fmt.Errorf("must not be below min_fee")),
+		})
+	}
+
+	arg := db.SetFeeRuleTxParams{
+		UpsertFeeRuleParams: db.UpsertFeeRuleParams{
+			Symbol:  symbol,
+			Role:    req.GetRole(),
+			Kind:    req.GetKind(),
+			FlatFee: flatFee,
+			RateBps: req.GetRateBps(),
+			MinFee:  minFee,
+			MaxFee:  maxFee,
+		},
+		Tiers: make([]db.FeeTierParams, 0, len(req.GetTiers())),
+	}
+	for i, tier := range req.GetTiers() {
+		minVolume, err := server.parseAmount(fmt.Sprintf("tiers[%d].min_volume", i), tier.GetMinVolume(), symbol)
+		if err != nil {
+			return nil, err
+		}
+
+		arg.Tiers = append(arg.Tiers, db.FeeTierParams{
+			MinVolume: minVolume,
+			RateBps:   tier.GetRateBps(),
+		})
+	}
+
+	result, err := server.store.SetFeeRuleTx(ctx, arg)
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return nil, status.Errorf(codes.InvalidArgument, "set fee rule err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "set fee rule err: %s", err)
+	}
+
+	rsp := &pb.SetFeeRuleResponse{
+		Rule: convertFeeRule(result.Rule, result.Tiers, server.decimals(symbol)),
+	}
+	return rsp, nil
+}
+
+func validateSetFeeRuleRequest(req *pb.SetFeeRuleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateSymbol(req.GetSymbol()); err != nil {
+		violations = append(violations, fieldViolation("symbol", err))
+	}
+
+	if err := vld.ValidateFeeRole(req.GetRole()); err != nil {
+		violations = append(violations, fieldViolation("role", err))
+	}
+
+	if err := vld.ValidateFeeKind(req.GetKind()); err != nil {
+		violations = append(violations, fieldViolation("kind", err))
+	}
+
+	if err := vld.ValidateNonNegativeAmount(req.GetFlatFee()); err != nil {
+		violations = append(violations, fieldViolation("flat_fee", err))
+	}
+
+	if err := vld.ValidateRateBps(req.GetRateBps()); err != nil {
+		violations = append(violations, fieldViolation("rate_bps", err))
+	}
+
+	if err := vld.ValidateNonNegativeAmount(req.GetMinFee()); err != nil {
+		violations = append(violations, fieldViolation("min_fee", err))
+	}
+
+	if err := vld.ValidateNonNegativeAmount(req.GetMaxFee()); err != nil {
+		violations = append(violations, fieldViolation("max_fee", err))
+	}
+
+	if req.GetKind() != util.TieredFee && len(req.GetTiers()) > 0 {
+		violations = append(violations, fieldViolation("tiers", fmt.Errorf("are only allowed for %s fees", util.TieredFee)))
+	}
+
+	for i, tier := range req.GetTiers() {
+		if err := vld.ValidateNonNegativeAmount(tier.GetMinVolume()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("tiers[%d].min_volume", i), err))
+		}
+
+		if err := vld.ValidateRateBps(tier.GetRateBps()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("tiers[%d].rate_bps", i), err))
+		}
+	}
+
+	return violations
+}
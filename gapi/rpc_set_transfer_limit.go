@@ -0,0 +1,79 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetTransferLimit(ctx context.Context, req *pb.SetTransferLimitRequest) (*pb.SetTransferLimitResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSetTransferLimitRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	symbol := req.GetSymbol()
+
+	dailyLimit, err := server.parseAmount("daily_limit", req.GetDailyLimit(), symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	monthlyLimit, err := server.parseAmount("monthly_limit", req.GetMonthlyLimit(), symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := server.store.UpsertTransferLimit(ctx, db.UpsertTransferLimitParams{
+		Symbol:       symbol,
+		Role:         req.GetRole(),
+		KycLevel:     req.GetKycLevel(),
+		DailyLimit:   dailyLimit,
+		MonthlyLimit: monthlyLimit,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "set transfer limit err: %s", err)
+	}
+
+	rsp := &pb.SetTransferLimitResponse{
+		Limit: convertTransferLimit(limit, server.decimals(symbol)),
+	}
+	return rsp, nil
+}
+
+func validateSetTransferLimitRequest(req *pb.SetTransferLimitRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateSymbol(req.GetSymbol()); err != nil {
+		violations = append(violations, fieldViolation("symbol", err))
+	}
+
+	if err := vld.ValidateLimitRole(req.GetRole()); err != nil {
+		violations = append(violations, fieldViolation("role", err))
+	}
+
+	if req.GetKycLevel() != "" {
+		if err := vld.ValidateKYCLevel(req.GetKycLevel()); err != nil {
+			violations = append(violations, fieldViolation("kyc_level", err))
+		}
+	}
+
+	if err := vld.ValidateNonNegativeAmount(req.GetDailyLimit()); err != nil {
+		violations = append(violations, fieldViolation("daily_limit", err))
+	}
+
+	if err := vld.ValidateNonNegativeAmount(req.GetMonthlyLimit()); err != nil {
+		violations = append(violations, fieldViolation("monthly_limit", err))
+	}
+
+	return violations
+}
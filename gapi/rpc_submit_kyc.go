@@ -0,0 +1,87 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"github.com/jackc/pgx/v5/pgtype"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SubmitKYC(ctx context.Context, req *pb.SubmitKYCRequest) (*pb.SubmitKYCResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole, util.PrayerRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSubmitKYCRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	member, err := server.store.GetMember(ctx, authPayload.Membername)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get member err: %s", err)
+	}
+
+	if util.MeetsKYCLevel(member.KycLevel, req.GetLevel()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "member already has %s kyc", member.KycLevel)
+	}
+
+	// validated above
+	dateOfBirth, _ := vld.ValidateDateOfBirth(req.GetDateOfBirth())
+
+	submission, err := server.store.CreateKYCSubmission(ctx, db.CreateKYCSubmissionParams{
+		Membername:     authPayload.Membername,
+		Level:          req.GetLevel(),
+		LegalName:      req.GetLegalName(),
+		DateOfBirth:    pgtype.Date{Time: dateOfBirth, Valid: true},
+		Country:        req.GetCountry(),
+		DocumentType:   req.GetDocumentType(),
+		DocumentNumber: req.GetDocumentNumber(),
+	})
+	if err != nil {
+		if db.ErrorCode(err) == db.UniqueViolation {
+			return nil, status.Errorf(codes.AlreadyExists, "member has a pending kyc submission")
+		}
+		return nil, status.Errorf(codes.Internal, "create kyc submission err: %s", err)
+	}
+
+	rsp := &pb.SubmitKYCResponse{
+		Submission: convertKYCSubmission(submission),
+	}
+	return rsp, nil
+}
+
+func validateSubmitKYCRequest(req *pb.SubmitKYCRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateSubmittedKYCLevel(req.GetLevel()); err != nil {
+		violations = append(violations, fieldViolation("level", err))
+	}
+
+	if err := vld.ValidateLegalName(req.GetLegalName()); err != nil {
+		violations = append(violations, fieldViolation("legal_name", err))
+	}
+
+	if _, err := vld.ValidateDateOfBirth(req.GetDateOfBirth()); err != nil {
+		violations = append(violations, fieldViolation("date_of_birth", err))
+	}
+
+	if err := vld.ValidateCountryCode(req.GetCountry()); err != nil {
+		violations = append(violations, fieldViolation("country", err))
+	}
+
+	if err := vld.ValidateDocumentType(req.GetDocumentType()); err != nil {
+		violations = append(violations, fieldViolation("document_type", err))
+	}
+
+	if err := vld.ValidateDocumentNumber(req.GetDocumentNumber()); err != nil {
+		violations = append(violations, fieldViolation("document_number", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,64 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateOverdraftLimit(ctx context.Context, req *pb.UpdateOverdraftLimitRequest) (*pb.UpdateOverdraftLimitResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdateOverdraftLimitRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	symbol, err := server.traderSymbol(ctx, nil, req.GetTraderId())
+	if err != nil {
+		return nil, err
+	}
+
+	overdraftLimit, err := server.parseAmount("overdraft_limit", req.GetOverdraftLimit(), symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	trader, err := server.store.UpdateTraderOverdraftLimit(ctx, db.UpdateTraderOverdraftLimitParams{
+		ID:             req.GetTraderId(),
+		OverdraftLimit: overdraftLimit,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "trader NotFound err")
+		}
+		return nil, status.Errorf(codes.Internal, "update overdraft limit err: %s", err)
+	}
+
+	rsp := &pb.UpdateOverdraftLimitResponse{
+		Trader: convertTrader(trader, server.decimals(trader.Symbol)),
+	}
+	return rsp, nil
+}
+
+func validateUpdateOverdraftLimitRequest(req *pb.UpdateOverdraftLimitRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetTraderId()); err != nil {
+		violations = append(violations, fieldViolation("trader_id", err))
+	}
+
+	if err := vld.ValidateNonNegativeAmount(req.GetOverdraftLimit()); err != nil {
+		violations = append(violations, fieldViolation("overdraft_limit", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,118 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateScheduledTransfer(ctx context.Context, req *pb.UpdateScheduledTransferRequest) (*pb.UpdateScheduledTransferResponse, error) {
+	authPayload, err := server.authorizeKYCMember(ctx, []string{util.PriestRole, util.PrayerRole}, util.TransferKYCLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := validateUpdateScheduledTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	schedule, err := server.memberScheduledTransfer(ctx, authPayload, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	symbol, err := server.traderSymbol(ctx, nil, schedule.FromTraderID)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.UpdateScheduledTransferParams{
+		ID: schedule.ID,
+		Cronspec: pgtype.Text{
+			String: req.GetCronspec(),
+			Valid:  req.Cronspec != nil,
+		},
+		OnInsufficientFunds: pgtype.Text{
+			String: req.GetOnInsufficientFunds(),
+			Valid:  req.OnInsufficientFunds != nil,
+		},
+		Active: pgtype.Bool{
+			Bool:  req.GetActive(),
+			Valid: req.Active != nil,
+		},
+	}
+
+	if req.Number != nil {
+		number, err := server.parseTransferAmount("number", req.GetNumber(), symbol)
+		if err != nil {
+			return nil, err
+		}
+		arg.Number = pgtype.Int8{
+			Int64: number,
+			Valid: true,
+		}
+	}
+
+	// a new cronspec or a resumed schedule starts counting from now, so runs
+	// missed while paused are not made up
+	if req.Cronspec != nil || (req.GetActive() && !schedule.Active) {
+		cronspec := schedule.Cronspec
+		if req.Cronspec != nil {
+			cronspec = req.GetCronspec()
+		}
+
+		nextRunTime, err := util.NextRunTime(cronspec, time.Now())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "parse cronspec err: %s", err)
+		}
+		arg.NextRunTime = pgtype.Timestamptz{
+			Time:  nextRunTime,
+			Valid: true,
+		}
+	}
+
+	schedule, err = server.store.UpdateScheduledTransfer(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "update scheduled transfer err: %s", err)
+	}
+
+	rsp := &pb.UpdateScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(schedule, server.decimals(symbol)),
+	}
+	return rsp, nil
+}
+
+func validateUpdateScheduledTransferRequest(req *pb.UpdateScheduledTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if req.Number != nil {
+		if err := vld.ValidateAmount(req.GetNumber()); err != nil {
+			violations = append(violations, fieldViolation("number", err))
+		}
+	}
+
+	if req.Cronspec != nil {
+		if err := vld.ValidateCronspec(req.GetCronspec()); err != nil {
+			violations = append(violations, fieldViolation("cronspec", err))
+		}
+	}
+
+	if req.OnInsufficientFunds != nil {
+		if err := vld.ValidateFundsPolicy(req.GetOnInsufficientFunds()); err != nil {
+			violations = append(violations, fieldViolation("on_insufficient_funds", err))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,81 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateSymbol(ctx context.Context, req *pb.UpdateSymbolRequest) (*pb.UpdateSymbolResponse, error) {
+	_, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdateSymbolRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	minTransfer, err := server.parseAmount("min_transfer", req.GetMinTransfer(), req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	symbol, err := server.store.UpdateSymbol(ctx, db.UpdateSymbolParams{
+		Code: req.GetCode(),
+		DisplayName: pgtype.Text{
+			String: req.GetDisplayName(),
+			Valid:  req.DisplayName != nil,
+		},
+		MinTransfer: pgtype.Int8{
+			Int64: minTransfer,
+			Valid: req.MinTransfer != nil,
+		},
+		Enabled: pgtype.Bool{
+			Bool:  req.GetEnabled(),
+			Valid: req.Enabled != nil,
+		},
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "symbol not found err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "update symbol err: %s", err)
+	}
+
+	server.symbolRegistry.Invalidate()
+
+	rsp := &pb.UpdateSymbolResponse{
+		Symbol: convertSymbol(symbol),
+	}
+	return rsp, nil
+}
+
+func validateUpdateSymbolRequest(req *pb.UpdateSymbolRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateSymbolCode(req.GetCode()); err != nil {
+		violations = append(violations, fieldViolation("code", err))
+	}
+
+	if req.DisplayName != nil {
+		if err := vld.ValidateSymbolName(req.GetDisplayName()); err != nil {
+			violations = append(violations, fieldViolation("display_name", err))
+		}
+	}
+
+	if req.MinTransfer != nil {
+		if err := vld.ValidateAmount(req.GetMinTransfer()); err != nil {
+			violations = append(violations, fieldViolation("min_transfer", err))
+		}
+	}
+
+	return violations
+}
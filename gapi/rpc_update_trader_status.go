@@ -0,0 +1,66 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateTraderStatus(ctx context.Context, req *pb.UpdateTraderStatusRequest) (*pb.UpdateTraderStatusResponse, error) {
+	authPayload, err := server.authorizeMember(ctx, []string{util.PriestRole})
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdateTraderStatusRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	result, err := server.store.UpdateTraderStatusTx(ctx, db.UpdateTraderStatusTxParams{
+		TraderID:   req.GetTraderId(),
+		Status:     req.GetStatus(),
+		Reason:     req.GetReason(),
+		Membername: authPayload.Membername,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "trader NotFound err")
+		}
+		if errors.Is(err, db.ErrSameTraderStatus) ||
+			errors.Is(err, db.ErrTraderStatusFlow) ||
+			errors.Is(err, db.ErrTraderNotEmpty) ||
+			errors.Is(err, db.ErrTraderInUse) {
+			return nil, status.Errorf(codes.FailedPrecondition, "update trader status err: %s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "update trader status err: %s", err)
+	}
+
+	rsp := &pb.UpdateTraderStatusResponse{
+		Trader: convertTrader(result.Trader, server.decimals(result.Trader.Symbol)),
+	}
+	return rsp, nil
+}
+
+func validateUpdateTraderStatusRequest(req *pb.UpdateTraderStatusRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(req.GetTraderId()); err != nil {
+		violations = append(violations, fieldViolation("trader_id", err))
+	}
+
+	if err := vld.ValidateTraderStatus(req.GetStatus()); err != nil {
+		violations = append(violations, fieldViolation("status", err))
+	}
+
+	if err := vld.ValidateStatusReason(req.GetReason()); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
@@ -4,7 +4,12 @@ import (
 	"fmt"
 
 	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/matching"
+	"github.com/YuanData/allegro-trade/pagination"
 	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/pricing"
+	"github.com/YuanData/allegro-trade/statement"
+	"github.com/YuanData/allegro-trade/symbols"
 	"github.com/YuanData/allegro-trade/token"
 	"github.com/YuanData/allegro-trade/util"
 	"github.com/YuanData/allegro-trade/worker"
@@ -16,19 +21,34 @@ type Server struct {
 	store           db.Store
 	tokenAuthzr      token.Authzr
 	taskDistributor worker.TaskDistributor
+	engine          *matching.Engine
+	symbolRegistry  *symbols.Registry
+	pageTokens      *pagination.TokenSigner
+	statements      *statement.Generator
+	prices          pricing.Oracle
 }
 
-func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) (*Server, error) {
+func NewServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor, engine *matching.Engine, symbolRegistry *symbols.Registry, prices pricing.Oracle) (*Server, error) {
 	tokenAuthzr, err := token.NewJWTAuthzr(config.TokenSecretKey)
 	if err != nil {
 		return nil, fmt.Errorf("token authzr err: %w", err)
 	}
 
+	pageTokens, err := pagination.NewTokenSigner(config.TokenSecretKey)
+	if err != nil {
+		return nil, fmt.Errorf("page token signer err: %w", err)
+	}
+
 	server := &Server{
 		config:          config,
 		store:           store,
 		tokenAuthzr:      tokenAuthzr,
 		taskDistributor: taskDistributor,
+		engine:          engine,
+		symbolRegistry:  symbolRegistry,
+		pageTokens:      pageTokens,
+		statements:      statement.NewGenerator(store),
+		prices:          prices,
 	}
 
 	return server, nil
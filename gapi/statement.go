@@ -0,0 +1,47 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/token"
+	"github.com/YuanData/allegro-trade/util"
+	"github.com/YuanData/allegro-trade/vld"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statementTrader returns the trader a statement is asked for. Statements are
+// read by the holder of the trader and by priests.
+func (server *Server) statementTrader(ctx context.Context, authPayload *token.Payload, traderID int64) (db.Trader, error) {
+	trader, err := server.store.GetTrader(ctx, traderID)
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return trader, status.Errorf(codes.NotFound, "trader NotFound err")
+		}
+		return trader, status.Errorf(codes.Internal, "get trader err: %s", err)
+	}
+
+	if authPayload.Role != util.PriestRole && trader.Holder != authPayload.Membername {
+		return trader, status.Errorf(codes.PermissionDenied, "trader not under member")
+	}
+	return trader, nil
+}
+
+func validateStatementRequest(traderID int64, year int32, month int32, format string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := vld.ValidateId(traderID); err != nil {
+		violations = append(violations, fieldViolation("trader_id", err))
+	}
+
+	if err := vld.ValidateStatementMonth(year, month); err != nil {
+		violations = append(violations, fieldViolation("month", err))
+	}
+
+	if err := vld.ValidateStatementFormat(format); err != nil {
+		violations = append(violations, fieldViolation("format", err))
+	}
+
+	return violations
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"net"
 	"net/http"
 	"os"
@@ -18,7 +19,11 @@ import (
 	db "github.com/YuanData/allegro-trade/db/sqlc"
 	"github.com/YuanData/allegro-trade/gapi"
 	"github.com/YuanData/allegro-trade/mail"
+	"github.com/YuanData/allegro-trade/matching"
 	"github.com/YuanData/allegro-trade/pb"
+	"github.com/YuanData/allegro-trade/pricing"
+	"github.com/YuanData/allegro-trade/screening"
+	"github.com/YuanData/allegro-trade/symbols"
 	"github.com/YuanData/allegro-trade/util"
 	"github.com/YuanData/allegro-trade/worker"
 	"google.golang.org/grpc"
@@ -41,18 +46,70 @@ func main() {
 		log.Fatal().Err(err).Msg("sql open err")
 	}
 
-	runDBMigration(config.MigrationURL, config.DBSource)
+	if len(os.Args) > 1 && os.Args[1] == "reconcile" {
+		// reconciling records no transfers, so it needs no screener
+		runReconcile(db.NewStore(connPool, nil))
+		return
+	}
 
-	store := db.NewStore(connPool)
+	runDBMigration(config.MigrationURL, config.DBSource)
 
 	redisOpt := asynq.RedisClientOpt{
 		Addr: config.RedisAddress,
 	}
 
+	// the screening thresholds are converted with the decimals of their symbols,
+	// so the symbols are loaded before the store is built
+	symbolRegistry := symbols.NewRegistry(db.New(connPool), config.SymbolCacheTTL)
+	err = symbolRegistry.Load(context.Background())
+	if err != nil {
+		log.Fatal().Err(err).Msg("load symbols err")
+	}
+	util.SetSymbolLookup(symbolRegistry.IsEnabled)
+
+	screener, err := screening.NewRuleEngine(screening.Rules{
+		LargeAmounts:    config.ScreeningLargeAmounts,
+		RapidCount:      config.ScreeningRapidCount,
+		RapidWindow:     config.ScreeningRapidWindow,
+		NewCounterparty: config.ScreeningNewCounterparty,
+		PasswordWindow:  config.ScreeningPasswordWindow,
+	}, symbolRegistry.Decimals)
+	if err != nil {
+		log.Fatal().Err(err).Msg("screening rules err")
+	}
+
+	store := db.NewStore(connPool, screener)
+
+	engine := matching.NewEngine(store, config.OrderHoldTTL)
+	err = engine.Load(context.Background())
+	if err != nil {
+		log.Fatal().Err(err).Msg("load order books err")
+	}
+
+	prices, err := newPriceOracle(config, store)
+	if err != nil {
+		log.Fatal().Err(err).Msg("price oracle err")
+	}
+
 	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
-	go runTaskProcessor(config, redisOpt, store)
-	go runGatewayServer(config, store, taskDistributor)
-	runGrpcServer(config, store, taskDistributor)
+	go runTaskProcessor(config, redisOpt, store, taskDistributor)
+	go runTaskScheduler(config, redisOpt)
+	go runGatewayServer(config, store, taskDistributor, engine, symbolRegistry, prices)
+	runGrpcServer(config, store, taskDistributor, engine, symbolRegistry, prices)
+}
+
+// newPriceOracle serves prices from the file PRICE_FILE names, or from the
+// prices table when it names none, with the last known prices cached.
+func newPriceOracle(config util.Config, store db.Store) (pricing.Oracle, error) {
+	var oracle pricing.Oracle = pricing.NewStoreOracle(store)
+	if config.PriceFile != "" {
+		fileOracle, err := pricing.NewFileOracle(config.PriceFile)
+		if err != nil {
+			return nil, err
+		}
+		oracle = fileOracle
+	}
+	return pricing.NewCache(oracle, config.PriceCacheTTL), nil
 }
 
 func runDBMigration(migrationURL string, dbSource string) {
@@ -68,9 +125,9 @@ func runDBMigration(migrationURL string, dbSource string) {
 	log.Info().Msg("Successfully completed DB migration")
 }
 
-func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store db.Store) {
+func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store db.Store, taskDistributor worker.TaskDistributor) {
 	mailer := mail.NewGmailSender(config.EmailSenderName, config.EmailSenderAddress, config.EmailSenderPassword)
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer)
+	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer, taskDistributor)
 	log.Info().Msg("start task processor")
 	err := taskProcessor.Start()
 	if err != nil {
@@ -78,8 +135,81 @@ func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store d
 	}
 }
 
-func runGrpcServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
-	server, err := gapi.NewServer(config, store, taskDistributor)
+func runTaskScheduler(config util.Config, redisOpt asynq.RedisClientOpt) {
+	taskScheduler := worker.NewRedisTaskScheduler(redisOpt)
+
+	if config.ReconcileSchedule != "" {
+		err := taskScheduler.ScheduleTaskReconcileLedger(config.ReconcileSchedule, &worker.PayloadReconcileLedger{
+			AlertEmail: config.ReconcileAlertEmail,
+		}, asynq.Queue(worker.QueueDefault))
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to schedule reconcile task")
+		}
+	}
+
+	if config.HoldExpirySchedule != "" {
+		err := taskScheduler.ScheduleTaskReleaseExpiredHolds(config.HoldExpirySchedule, &worker.PayloadReleaseExpiredHolds{
+			BatchSize: 100,
+		}, asynq.Queue(worker.QueueDefault))
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to schedule hold expiry task")
+		}
+	}
+
+	if config.ScheduledTransferSchedule != "" {
+		err := taskScheduler.ScheduleTaskDispatchScheduledTransfers(config.ScheduledTransferSchedule, &worker.PayloadDispatchScheduledTransfers{
+			BatchSize: 100,
+		}, asynq.Queue(worker.QueueDefault))
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to schedule scheduled transfer dispatch task")
+		}
+	}
+
+	if config.EscrowExpirySchedule != "" {
+		err := taskScheduler.ScheduleTaskExpireEscrows(config.EscrowExpirySchedule, &worker.PayloadExpireEscrows{
+			BatchSize: 100,
+		}, asynq.Queue(worker.QueueDefault))
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to schedule escrow expiry task")
+		}
+	}
+
+	if config.BalanceSnapshotSchedule != "" {
+		err := taskScheduler.ScheduleTaskSnapshotBalances(config.BalanceSnapshotSchedule, &worker.PayloadSnapshotBalances{
+			BatchSize: 500,
+		}, asynq.Queue(worker.QueueDefault))
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to schedule balance snapshot task")
+		}
+	}
+
+	log.Info().Msg("start task scheduler")
+	err := taskScheduler.Start()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to start task scheduler")
+	}
+}
+
+// runReconcile prints the reconcile report as JSON and exits non-zero on any drift.
+func runReconcile(store db.Store) {
+	report, err := store.Reconcile(context.Background())
+	if err != nil {
+		log.Fatal().Err(err).Msg("reconcile err")
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(report); err != nil {
+		log.Fatal().Err(err).Msg("encode report err")
+	}
+
+	if !report.OK() {
+		os.Exit(1)
+	}
+}
+
+func runGrpcServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor, engine *matching.Engine, symbolRegistry *symbols.Registry, prices pricing.Oracle) {
+	server, err := gapi.NewServer(config, store, taskDistributor, engine, symbolRegistry, prices)
 	if err != nil {
 		log.Fatal().Err(err).Msg("server err")
 	}
@@ -101,8 +231,8 @@ func runGrpcServer(config util.Config, store db.Store, taskDistributor worker.Ta
 	}
 }
 
-func runGatewayServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
-	server, err := gapi.NewServer(config, store, taskDistributor)
+func runGatewayServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor, engine *matching.Engine, symbolRegistry *symbols.Registry, prices pricing.Oracle) {
+	server, err := gapi.NewServer(config, store, taskDistributor, engine, symbolRegistry, prices)
 	if err != nil {
 		log.Fatal().Err(err).Msg("server err")
 	}
@@ -116,7 +246,7 @@ func runGatewayServer(config util.Config, store db.Store, taskDistributor worker
 		},
 	})
 
-	grpcMux := runtime.NewServeMux(jsonOption)
+	grpcMux := runtime.NewServeMux(jsonOption, runtime.WithIncomingHeaderMatcher(gapi.HeaderMatcher))
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -127,6 +257,7 @@ func runGatewayServer(config util.Config, store db.Store, taskDistributor worker
 	}
 
 	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/download_statement", server.DownloadStatement)
 	mux.Handle("/", grpcMux)
 
 	listener, err := net.Listen("tcp", config.HTTPServerAddress)
@@ -142,8 +273,8 @@ func runGatewayServer(config util.Config, store db.Store, taskDistributor worker
 	}
 }
 
-func runGinServer(config util.Config, store db.Store) {
-	server, err := api.NewServer(config, store)
+func runGinServer(config util.Config, store db.Store, symbolRegistry *symbols.Registry) {
+	server, err := api.NewServer(config, store, symbolRegistry.Decimals)
 	if err != nil {
 		log.Fatal().Err(err).Msg("server err")
 	}
@@ -0,0 +1,135 @@
+package matching
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/YuanData/allegro-trade/util"
+)
+
+// Order is the in-memory view of a resting limit order.
+type Order struct {
+	ID        int64
+	Holder    string
+	Side      string
+	Price     int64
+	Remaining int64
+}
+
+type priceLevel struct {
+	price  int64
+	orders []*Order
+}
+
+// OrderBook keeps the resting limit orders of one pair in price-time priority:
+// better prices first, and within a price level the oldest order first.
+type OrderBook struct {
+	mu     sync.Mutex
+	pair   Pair
+	bids   []*priceLevel
+	asks   []*priceLevel
+	orders map[int64]*Order
+}
+
+func NewOrderBook(pair Pair) *OrderBook {
+	return &OrderBook{
+		pair:   pair,
+		orders: make(map[int64]*Order),
+	}
+}
+
+// Add rests a limit order at the back of its price level.
+func (book *OrderBook) Add(order *Order) {
+	levels := book.levels(order.Side)
+	i := sort.Search(len(*levels), func(i int) bool {
+		return !better(order.Side, (*levels)[i].price, order.Price)
+	})
+
+	if i < len(*levels) && (*levels)[i].price == order.Price {
+		(*levels)[i].orders = append((*levels)[i].orders, order)
+	} else {
+		level := &priceLevel{price: order.Price, orders: []*Order{order}}
+		*levels = append(*levels, nil)
+		copy((*levels)[i+1:], (*levels)[i:])
+		(*levels)[i] = level
+	}
+
+	book.orders[order.ID] = order
+}
+
+// Remove drops a resting order from the book and reports whether it was there.
+func (book *OrderBook) Remove(orderID int64) bool {
+	order, ok := book.orders[orderID]
+	if !ok {
+		return false
+	}
+
+	levels := book.levels(order.Side)
+	for i, level := range *levels {
+		if level.price != order.Price {
+			continue
+		}
+		for j, o := range level.orders {
+			if o.ID == orderID {
+				level.orders = append(level.orders[:j], level.orders[j+1:]...)
+				break
+			}
+		}
+		if len(level.orders) == 0 {
+			*levels = append((*levels)[:i], (*levels)[i+1:]...)
+		}
+		break
+	}
+
+	delete(book.orders, orderID)
+	return true
+}
+
+// Best returns the resting order that an incoming order on the given side
+// would trade against first, or nil when that side of the book is empty.
+func (book *OrderBook) Best(takerSide string) *Order {
+	levels := book.asks
+	if takerSide == util.SellSide {
+		levels = book.bids
+	}
+	if len(levels) == 0 {
+		return nil
+	}
+	return levels[0].orders[0]
+}
+
+// Fill reduces a resting order by the traded quantity and drops it once it is done.
+func (book *OrderBook) Fill(orderID int64, quantity int64) {
+	order, ok := book.orders[orderID]
+	if !ok {
+		return
+	}
+
+	order.Remaining -= quantity
+	if order.Remaining <= 0 {
+		book.Remove(orderID)
+	}
+}
+
+func (book *OrderBook) levels(side string) *[]*priceLevel {
+	if side == util.BuySide {
+		return &book.bids
+	}
+	return &book.asks
+}
+
+// better reports whether price a has priority over price b for resting orders on side.
+func better(side string, a int64, b int64) bool {
+	if side == util.BuySide {
+		return a > b
+	}
+	return a < b
+}
+
+// crosses reports whether a taker limit price can trade against a maker price.
+func crosses(takerSide string, takerPrice int64, makerPrice int64) bool {
+	if takerSide == util.BuySide {
+		return makerPrice <= takerPrice
+	}
+	return makerPrice >= takerPrice
+}
@@ -0,0 +1,71 @@
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func TestOrderBookPriceTimePriority(t *testing.T) {
+	book := NewOrderBook(Pair{Base: util.BTC, Quote: util.ETH})
+
+	book.Add(&Order{ID: 1, Side: util.SellSide, Price: 12, Remaining: 5})
+	book.Add(&Order{ID: 2, Side: util.SellSide, Price: 10, Remaining: 5})
+	book.Add(&Order{ID: 3, Side: util.SellSide, Price: 10, Remaining: 5})
+	book.Add(&Order{ID: 4, Side: util.BuySide, Price: 8, Remaining: 5})
+	book.Add(&Order{ID: 5, Side: util.BuySide, Price: 9, Remaining: 5})
+
+	require.Equal(t, int64(2), book.Best(util.BuySide).ID)
+	require.Equal(t, int64(5), book.Best(util.SellSide).ID)
+
+	book.Fill(2, 5)
+	require.Equal(t, int64(3), book.Best(util.BuySide).ID)
+
+	book.Fill(3, 2)
+	require.Equal(t, int64(3), book.Best(util.BuySide).ID)
+	require.Equal(t, int64(3), book.Best(util.BuySide).Remaining)
+
+	book.Fill(3, 3)
+	require.Equal(t, int64(1), book.Best(util.BuySide).ID)
+}
+
+func TestOrderBookRemove(t *testing.T) {
+	book := NewOrderBook(Pair{Base: util.BTC, Quote: util.ETH})
+
+	book.Add(&Order{ID: 1, Side: util.BuySide, Price: 10, Remaining: 5})
+	book.Add(&Order{ID: 2, Side: util.BuySide, Price: 10, Remaining: 5})
+
+	require.True(t, book.Remove(1))
+	require.False(t, book.Remove(1))
+	require.Equal(t, int64(2), book.Best(util.SellSide).ID)
+
+	require.True(t, book.Remove(2))
+	require.Nil(t, book.Best(util.SellSide))
+}
+
+func TestCrosses(t *testing.T) {
+	require.True(t, crosses(util.BuySide, 10, 10))
+	require.True(t, crosses(util.BuySide, 10, 9))
+	require.False(t, crosses(util.BuySide, 10, 11))
+
+	require.True(t, crosses(util.SellSide, 10, 10))
+	require.True(t, crosses(util.SellSide, 10, 11))
+	require.False(t, crosses(util.SellSide, 10, 9))
+}
+
+func TestParsePair(t *testing.T) {
+	pair, err := ParsePair("BTC/ETH")
+	require.NoError(t, err)
+	require.Equal(t, Pair{Base: util.BTC, Quote: util.ETH}, pair)
+	require.Equal(t, "BTC/ETH", pair.String())
+
+	_, err = ParsePair("BTC")
+	require.Error(t, err)
+
+	_, err = ParsePair("BTC/BTC")
+	require.Error(t, err)
+
+	_, err = ParsePair("BTC/XYZ")
+	require.Error(t, err)
+}
@@ -0,0 +1,237 @@
+package matching
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+var ErrOrderNotFound = errors.New("order not found in book")
+
+type PlaceOrderParams struct {
+	Holder   string
+	Pair     Pair
+	Side     string
+	Kind     string
+	Price    int64
+	Quantity int64
+}
+
+type PlaceOrderResult struct {
+	Order db.Order
+	Fills []db.Fill
+}
+
+// Engine matches incoming orders against one in-memory order book per pair.
+// Every fill is settled through the store before the book is updated, so the
+// books never get ahead of what has been committed to the database. The hold
+// of a resting order expires after holdTTL, which cancels the order; a zero
+// holdTTL keeps orders open until they are filled or cancelled.
+type Engine struct {
+	store   db.Store
+	holdTTL time.Duration
+	mu      sync.Mutex
+	books   map[Pair]*OrderBook
+}
+
+func NewEngine(store db.Store, holdTTL time.Duration) *Engine {
+	return &Engine{
+		store:   store,
+		holdTTL: holdTTL,
+		books:   make(map[Pair]*OrderBook),
+	}
+}
+
+// Load rebuilds the order books from the open orders stored in the database.
+func (engine *Engine) Load(ctx context.Context) error {
+	orders, err := engine.store.ListOpenOrders(ctx)
+	if err != nil {
+		return fmt.Errorf("list open orders err: %w", err)
+	}
+
+	for _, order := range orders {
+		if order.Kind != util.LimitKind {
+			_, err = engine.store.CancelOrderTx(ctx, order.ID)
+			if err != nil {
+				return fmt.Errorf("cancel stale order [%d] err: %w", order.ID, err)
+			}
+			continue
+		}
+
+		book := engine.book(Pair{Base: order.BaseSymbol, Quote: order.QuoteSymbol})
+		book.Add(&Order{
+			ID:        order.ID,
+			Holder:    order.Holder,
+			Side:      order.Side,
+			Price:     order.Price,
+			Remaining: order.Quantity - order.Filled,
+		})
+	}
+
+	return nil
+}
+
+// PlaceOrder stores a new order, holding the funds it may spend, and matches it
+// against the opposite side of its book. Limit orders that are not completely
+// filled rest in the book, while the unfilled part of a market order is cancelled.
+// An order never trades against a resting order of the same member: once it
+// reaches one, whatever is left of the incoming order is cancelled instead.
+// A resting order that can no longer be settled, say because its trader no
+// longer has the funds, is cancelled and matching goes on with the next one.
+// What is left of an incoming order that is worth nothing at the price of the
+// resting order is cancelled rather than left in the book.
+func (engine *Engine) PlaceOrder(ctx context.Context, arg PlaceOrderParams) (PlaceOrderResult, error) {
+	var result PlaceOrderResult
+
+	baseTrader, err := engine.store.GetTraderByHolderSymbol(ctx, db.GetTraderByHolderSymbolParams{
+		Holder: arg.Holder,
+		Symbol: arg.Pair.Base,
+	})
+	if err != nil {
+		return result, fmt.Errorf("get %s trader err: %w", arg.Pair.Base, err)
+	}
+
+	quoteTrader, err := engine.store.GetTraderByHolderSymbol(ctx, db.GetTraderByHolderSymbolParams{
+		Holder: arg.Holder,
+		Symbol: arg.Pair.Quote,
+	})
+	if err != nil {
+		return result, fmt.Errorf("get %s trader err: %w", arg.Pair.Quote, err)
+	}
+
+	price := arg.Price
+	if arg.Kind == util.MarketKind {
+		price = 0
+	}
+
+	book := engine.book(arg.Pair)
+	book.mu.Lock()
+	defer book.mu.Unlock()
+
+	orderArg := db.CreateOrderTxParams{
+		CreateOrderParams: db.CreateOrderParams{
+			Holder:        arg.Holder,
+			BaseTraderID:  baseTrader.ID,
+			QuoteTraderID: quoteTrader.ID,
+			BaseSymbol:    arg.Pair.Base,
+			QuoteSymbol:   arg.Pair.Quote,
+			Side:          arg.Side,
+			Kind:          arg.Kind,
+			Price:         price,
+			Quantity:      arg.Quantity,
+		},
+	}
+	if engine.holdTTL > 0 {
+		orderArg.HoldExpiresTime = time.Now().Add(engine.holdTTL)
+	}
+
+	result.Order, err = engine.store.CreateOrderTx(ctx, orderArg)
+	if err != nil {
+		return result, fmt.Errorf("create order err: %w", err)
+	}
+
+	taker := &Order{
+		ID:        result.Order.ID,
+		Holder:    arg.Holder,
+		Side:      arg.Side,
+		Price:     price,
+		Remaining: arg.Quantity,
+	}
+
+	cancelRest := false
+	for taker.Remaining > 0 {
+		maker := book.Best(taker.Side)
+		if maker == nil {
+			break
+		}
+		if arg.Kind == util.LimitKind && !crosses(taker.Side, taker.Price, maker.Price) {
+			break
+		}
+		if maker.Holder == taker.Holder {
+			cancelRest = true
+			break
+		}
+
+		quantity := taker.Remaining
+		if maker.Remaining < quantity {
+			quantity = maker.Remaining
+		}
+
+		fillResult, err := engine.store.FillTx(ctx, db.FillTxParams{
+			MakerOrderID: maker.ID,
+			TakerOrderID: taker.ID,
+			Price:        maker.Price,
+			Quantity:     quantity,
+		})
+		if err != nil {
+			var orderErr *db.OrderError
+			if errors.As(err, &orderErr) && orderErr.OrderID == maker.ID {
+				book.Remove(maker.ID)
+				engine.cancelAfterFailure(ctx, maker.ID)
+				continue
+			}
+			if errors.As(err, &orderErr) && errors.Is(err, db.ErrOrderTooSmall) {
+				cancelRest = true
+				break
+			}
+
+			engine.cancelAfterFailure(ctx, taker.ID)
+			return result, fmt.Errorf("settle fill err: %w", err)
+		}
+
+		book.Fill(maker.ID, quantity)
+		taker.Remaining -= quantity
+		result.Order = fillResult.TakerOrder
+		result.Fills = append(result.Fills, fillResult.Fill)
+	}
+
+	if taker.Remaining > 0 {
+		if arg.Kind == util.LimitKind && !cancelRest {
+			book.Add(taker)
+		} else {
+			result.Order, err = engine.store.CancelOrderTx(ctx, taker.ID)
+			if err != nil {
+				return result, fmt.Errorf("cancel order remainder err: %w", err)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// CancelOrder removes a resting order from its book, marks it as cancelled and
+// releases its hold.
+func (engine *Engine) CancelOrder(ctx context.Context, order db.Order) (db.Order, error) {
+	book := engine.book(Pair{Base: order.BaseSymbol, Quote: order.QuoteSymbol})
+	book.mu.Lock()
+	defer book.mu.Unlock()
+
+	if !book.Remove(order.ID) {
+		return order, ErrOrderNotFound
+	}
+
+	return engine.store.CancelOrderTx(ctx, order.ID)
+}
+
+// cancelAfterFailure makes sure an order that could not be settled does not stay
+// open in the database without being in the book.
+func (engine *Engine) cancelAfterFailure(ctx context.Context, orderID int64) {
+	_, _ = engine.store.CancelOrderTx(ctx, orderID)
+}
+
+func (engine *Engine) book(pair Pair) *OrderBook {
+	engine.mu.Lock()
+	defer engine.mu.Unlock()
+
+	book, ok := engine.books[pair]
+	if !ok {
+		book = NewOrderBook(pair)
+		engine.books[pair] = book
+	}
+	return book
+}
@@ -0,0 +1,227 @@
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/YuanData/allegro-trade/db/mock"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func TestEnginePlaceOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	engine := NewEngine(store, time.Hour)
+	pair := Pair{Base: util.BTC, Quote: util.ETH}
+
+	maker := db.Order{
+		ID:          1,
+		Holder:      "maker",
+		BaseSymbol:  util.BTC,
+		QuoteSymbol: util.ETH,
+		Side:        util.SellSide,
+		Kind:        util.LimitKind,
+		Price:       10,
+		Quantity:    3,
+		Status:      util.OpenOrderStatus,
+	}
+	store.EXPECT().ListOpenOrders(gomock.Any()).Times(1).Return([]db.Order{maker}, nil)
+	require.NoError(t, engine.Load(context.Background()))
+
+	baseTrader := db.Trader{ID: 10, Holder: "taker", Symbol: util.BTC}
+	quoteTrader := db.Trader{ID: 11, Holder: "taker", Symbol: util.ETH}
+	store.EXPECT().GetTraderByHolderSymbol(gomock.Any(), gomock.Eq(db.GetTraderByHolderSymbolParams{Holder: "taker", Symbol: util.BTC})).
+		Times(1).Return(baseTrader, nil)
+	store.EXPECT().GetTraderByHolderSymbol(gomock.Any(), gomock.Eq(db.GetTraderByHolderSymbolParams{Holder: "taker", Symbol: util.ETH})).
+		Times(1).Return(quoteTrader, nil)
+
+	taker := db.Order{ID: 2, Holder: "taker", Side: util.BuySide, Kind: util.LimitKind, Price: 11, Quantity: 5, Status: util.OpenOrderStatus}
+	store.EXPECT().CreateOrderTx(gomock.Any(), gomock.Any()).Times(1).Return(taker, nil)
+
+	filledTaker := taker
+	filledTaker.Filled = 3
+	store.EXPECT().FillTx(gomock.Any(), gomock.Eq(db.FillTxParams{
+		MakerOrderID: maker.ID,
+		TakerOrderID: taker.ID,
+		Price:        maker.Price,
+		Quantity:     3,
+	})).Times(1).Return(db.FillTxResult{Fill: db.Fill{ID: 1}, TakerOrder: filledTaker}, nil)
+
+	result, err := engine.PlaceOrder(context.Background(), PlaceOrderParams{
+		Holder:   "taker",
+		Pair:     pair,
+		Side:     util.BuySide,
+		Kind:     util.LimitKind,
+		Price:    11,
+		Quantity: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Fills, 1)
+	require.Equal(t, int64(3), result.Order.Filled)
+
+	book := engine.book(pair)
+	require.Nil(t, book.Best(util.BuySide))
+	require.Equal(t, taker.ID, book.Best(util.SellSide).ID)
+	require.Equal(t, int64(2), book.Best(util.SellSide).Remaining)
+}
+
+func TestEngineMarketOrderRemainderCancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	engine := NewEngine(store, time.Hour)
+
+	store.EXPECT().GetTraderByHolderSymbol(gomock.Any(), gomock.Any()).Times(2).Return(db.Trader{ID: 1}, nil)
+
+	order := db.Order{ID: 7, Side: util.SellSide, Kind: util.MarketKind, Quantity: 5, Status: util.OpenOrderStatus}
+	store.EXPECT().CreateOrderTx(gomock.Any(), gomock.Any()).Times(1).Return(order, nil)
+	store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(0)
+
+	cancelled := order
+	cancelled.Status = util.CancelledOrderStatus
+	store.EXPECT().CancelOrderTx(gomock.Any(), gomock.Eq(order.ID)).Times(1).Return(cancelled, nil)
+
+	result, err := engine.PlaceOrder(context.Background(), PlaceOrderParams{
+		Holder:   "taker",
+		Pair:     Pair{Base: util.BTC, Quote: util.ETH},
+		Side:     util.SellSide,
+		Kind:     util.MarketKind,
+		Quantity: 5,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.Fills)
+	require.Equal(t, util.CancelledOrderStatus, result.Order.Status)
+}
+
+func TestEngineCancelsMakerThatCannotSettle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	engine := NewEngine(store, time.Hour)
+	pair := Pair{Base: util.BTC, Quote: util.ETH}
+
+	broke := db.Order{ID: 1, Holder: "broke", BaseSymbol: util.BTC, QuoteSymbol: util.ETH, Side: util.SellSide, Kind: util.LimitKind, Price: 10, Quantity: 3, Status: util.OpenOrderStatus}
+	maker := db.Order{ID: 2, Holder: "maker", BaseSymbol: util.BTC, QuoteSymbol: util.ETH, Side: util.SellSide, Kind: util.LimitKind, Price: 10, Quantity: 3, Status: util.OpenOrderStatus}
+	store.EXPECT().ListOpenOrders(gomock.Any()).Times(1).Return([]db.Order{broke, maker}, nil)
+	require.NoError(t, engine.Load(context.Background()))
+
+	store.EXPECT().GetTraderByHolderSymbol(gomock.Any(), gomock.Any()).Times(2).Return(db.Trader{ID: 10}, nil)
+
+	taker := db.Order{ID: 3, Holder: "taker", Side: util.BuySide, Kind: util.LimitKind, Price: 10, Quantity: 3, Status: util.OpenOrderStatus}
+	store.EXPECT().CreateOrderTx(gomock.Any(), gomock.Any()).Times(1).Return(taker, nil)
+
+	filledTaker := taker
+	filledTaker.Filled = 3
+	gomock.InOrder(
+		store.EXPECT().FillTx(gomock.Any(), gomock.Eq(db.FillTxParams{MakerOrderID: broke.ID, TakerOrderID: taker.ID, Price: 10, Quantity: 3})).
+			Times(1).Return(db.FillTxResult{}, &db.OrderError{OrderID: broke.ID, Err: db.ErrInsufficientFunds}),
+		store.EXPECT().CancelOrderTx(gomock.Any(), gomock.Eq(broke.ID)).Times(1).Return(db.Order{}, nil),
+		store.EXPECT().FillTx(gomock.Any(), gomock.Eq(db.FillTxParams{MakerOrderID: maker.ID, TakerOrderID: taker.ID, Price: 10, Quantity: 3})).
+			Times(1).Return(db.FillTxResult{Fill: db.Fill{ID: 1}, TakerOrder: filledTaker}, nil),
+	)
+
+	result, err := engine.PlaceOrder(context.Background(), PlaceOrderParams{
+		Holder:   "taker",
+		Pair:     pair,
+		Side:     util.BuySide,
+		Kind:     util.LimitKind,
+		Price:    10,
+		Quantity: 3,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Fills, 1)
+
+	book := engine.book(pair)
+	require.Nil(t, book.Best(util.BuySide))
+	require.Nil(t, book.Best(util.SellSide))
+}
+
+func TestEnginePreventsSelfTrade(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	engine := NewEngine(store, time.Hour)
+	pair := Pair{Base: util.BTC, Quote: util.ETH}
+
+	resting := db.Order{ID: 1, Holder: "member", BaseSymbol: util.BTC, QuoteSymbol: util.ETH, Side: util.SellSide, Kind: util.LimitKind, Price: 10, Quantity: 3, Status: util.OpenOrderStatus}
+	store.EXPECT().ListOpenOrders(gomock.Any()).Times(1).Return([]db.Order{resting}, nil)
+	require.NoError(t, engine.Load(context.Background()))
+
+	store.EXPECT().GetTraderByHolderSymbol(gomock.Any(), gomock.Any()).Times(2).Return(db.Trader{ID: 10}, nil)
+
+	order := db.Order{ID: 2, Holder: "member", Side: util.BuySide, Kind: util.LimitKind, Price: 11, Quantity: 3, Status: util.OpenOrderStatus}
+	store.EXPECT().CreateOrderTx(gomock.Any(), gomock.Any()).Times(1).Return(order, nil)
+	store.EXPECT().FillTx(gomock.Any(), gomock.Any()).Times(0)
+
+	cancelled := order
+	cancelled.Status = util.CancelledOrderStatus
+	store.EXPECT().CancelOrderTx(gomock.Any(), gomock.Eq(order.ID)).Times(1).Return(cancelled, nil)
+
+	result, err := engine.PlaceOrder(context.Background(), PlaceOrderParams{
+		Holder:   "member",
+		Pair:     pair,
+		Side:     util.BuySide,
+		Kind:     util.LimitKind,
+		Price:    11,
+		Quantity: 3,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.Fills)
+	require.Equal(t, util.CancelledOrderStatus, result.Order.Status)
+
+	// the resting order is untouched and the book is not left crossed
+	book := engine.book(pair)
+	require.Equal(t, resting.ID, book.Best(util.BuySide).ID)
+	require.Nil(t, book.Best(util.SellSide))
+}
+
+func TestEngineCancelsTakerRestTooSmall(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	engine := NewEngine(store, time.Hour)
+	pair := Pair{Base: util.BTC, Quote: util.ETH}
+
+	maker := db.Order{ID: 1, Holder: "maker", BaseSymbol: util.BTC, QuoteSymbol: util.ETH, Side: util.SellSide, Kind: util.LimitKind, Price: 10, Quantity: 3, Status: util.OpenOrderStatus}
+	store.EXPECT().ListOpenOrders(gomock.Any()).Times(1).Return([]db.Order{maker}, nil)
+	require.NoError(t, engine.Load(context.Background()))
+
+	store.EXPECT().GetTraderByHolderSymbol(gomock.Any(), gomock.Any()).Times(2).Return(db.Trader{ID: 10}, nil)
+
+	taker := db.Order{ID: 2, Holder: "taker", Side: util.BuySide, Kind: util.LimitKind, Price: 10, Quantity: 1, Status: util.OpenOrderStatus}
+	store.EXPECT().CreateOrderTx(gomock.Any(), gomock.Any()).Times(1).Return(taker, nil)
+	store.EXPECT().FillTx(gomock.Any(), gomock.Any()).
+		Times(1).Return(db.FillTxResult{}, &db.OrderError{OrderID: taker.ID, Err: db.ErrOrderTooSmall})
+
+	cancelled := taker
+	cancelled.Status = util.CancelledOrderStatus
+	store.EXPECT().CancelOrderTx(gomock.Any(), gomock.Eq(taker.ID)).Times(1).Return(cancelled, nil)
+
+	result, err := engine.PlaceOrder(context.Background(), PlaceOrderParams{
+		Holder:   "taker",
+		Pair:     pair,
+		Side:     util.BuySide,
+		Kind:     util.LimitKind,
+		Price:    10,
+		Quantity: 1,
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.Fills)
+	require.Equal(t, util.CancelledOrderStatus, result.Order.Status)
+
+	// the maker keeps its place and the taker does not rest in the book
+	book := engine.book(pair)
+	require.Equal(t, maker.ID, book.Best(util.BuySide).ID)
+	require.Equal(t, int64(3), book.Best(util.BuySide).Remaining)
+	require.Nil(t, book.Best(util.SellSide))
+}
@@ -0,0 +1,35 @@
+package matching
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/YuanData/allegro-trade/util"
+)
+
+// Pair identifies a market such as BTC/ETH, where BTC is the base symbol
+// being bought or sold and ETH is the quote symbol it is priced in.
+type Pair struct {
+	Base  string
+	Quote string
+}
+
+func ParsePair(value string) (Pair, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) != 2 {
+		return Pair{}, fmt.Errorf("pair should be formatted as BASE/QUOTE")
+	}
+
+	pair := Pair{Base: parts[0], Quote: parts[1]}
+	if !util.IsSupportedSymbol(pair.Base) || !util.IsSupportedSymbol(pair.Quote) {
+		return Pair{}, fmt.Errorf("pair has an unsupported symbol")
+	}
+	if pair.Base == pair.Quote {
+		return Pair{}, fmt.Errorf("pair should have two different symbols")
+	}
+	return pair, nil
+}
+
+func (pair Pair) String() string {
+	return pair.Base + "/" + pair.Quote
+}
@@ -0,0 +1,125 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/YuanData/allegro-trade/util"
+)
+
+var (
+	ErrInvalidAmount = errors.New("amount should be a decimal number such as 12.5")
+	ErrTooPrecise    = errors.New("amount has more decimal places than the symbol allows")
+	ErrOverflow      = errors.New("amount is too large")
+	ErrInvalidScale  = fmt.Errorf("decimals should be between 0 and %d", util.MaxSymbolDecimals)
+)
+
+var isDecimal = regexp.MustCompile(`^-?(\d+\.?\d*|\.\d+)$`).MatchString
+
+// Rounding decides what happens to the digits beyond a symbol's precision.
+type Rounding int
+
+const (
+	// RoundExact rejects an amount that cannot be stored without rounding.
+	RoundExact Rounding = iota
+	// RoundDown drops the extra digits, rounding toward zero.
+	RoundDown
+	// RoundUp rounds away from zero whenever an extra digit is not zero.
+	RoundUp
+	// RoundHalfEven rounds to the nearest minor unit and ties to the even one.
+	RoundHalfEven
+)
+
+// Parse converts a decimal string into the minor units the ledger stores for a
+// symbol with the given number of decimals, so "1.5" BTC with 8 decimals is
+// 150000000. The rounding applies to any digits beyond the decimals.
+func Parse(value string, decimals int32, rounding Rounding) (int64, error) {
+	if decimals < 0 || decimals > util.MaxSymbolDecimals {
+		return 0, ErrInvalidScale
+	}
+	if !isDecimal(value) {
+		return 0, ErrInvalidAmount
+	}
+
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(value, "-")
+
+	whole, fraction, _ := strings.Cut(value, ".")
+	extra := ""
+	if len(fraction) > int(decimals) {
+		fraction, extra = fraction[:decimals], fraction[decimals:]
+	}
+	fraction += strings.Repeat("0", int(decimals)-len(fraction))
+
+	minor, ok := new(big.Int).SetString(whole+fraction, 10)
+	if !ok {
+		return 0, ErrInvalidAmount
+	}
+
+	if roundsUp(minor, extra, rounding) {
+		minor.Add(minor, big.NewInt(1))
+	} else if rounding == RoundExact && strings.Trim(extra, "0") != "" {
+		return 0, ErrTooPrecise
+	}
+
+	if negative {
+		minor.Neg(minor)
+	}
+	if !minor.IsInt64() {
+		return 0, ErrOverflow
+	}
+	return minor.Int64(), nil
+}
+
+// roundsUp reports whether the magnitude in minor units goes up by one for the
+// dropped digits in extra.
+func roundsUp(minor *big.Int, extra string, rounding Rounding) bool {
+	rest := strings.TrimRight(extra, "0")
+	if rest == "" {
+		return false
+	}
+
+	switch rounding {
+	case RoundUp:
+		return true
+	case RoundHalfEven:
+		switch {
+		case rest[0] > '5':
+			return true
+		case rest[0] < '5':
+			return false
+		case len(rest) > 1:
+			return true
+		}
+		// exactly half way
+		return minor.Bit(0) == 1
+	}
+	return false
+}
+
+// Format renders minor units of a symbol with the given number of decimals as a
+// decimal string, without trailing zeros in the fraction.
+func Format(amount int64, decimals int32) string {
+	if decimals <= 0 {
+		return fmt.Sprintf("%d", amount)
+	}
+
+	sign := ""
+	magnitude := uint64(amount)
+	if amount < 0 {
+		sign = "-"
+		magnitude = -magnitude
+	}
+
+	digits := fmt.Sprintf("%0*d", decimals+1, magnitude)
+	whole, fraction := digits[:len(digits)-int(decimals)], digits[len(digits)-int(decimals):]
+
+	fraction = strings.TrimRight(fraction, "0")
+	if fraction == "" {
+		return sign + whole
+	}
+	return sign + whole + "." + fraction
+}
@@ -0,0 +1,78 @@
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		decimals int32
+		rounding Rounding
+		amount   int64
+		err      error
+	}{
+		{name: "Whole", value: "12", decimals: 8, amount: 1200000000},
+		{name: "Fraction", value: "1.5", decimals: 8, amount: 150000000},
+		{name: "LeadingDot", value: ".25", decimals: 2, amount: 25},
+		{name: "TrailingZeros", value: "1.2300", decimals: 2, amount: 123},
+		{name: "Negative", value: "-0.01", decimals: 2, amount: -1},
+		{name: "NoDecimals", value: "42", decimals: 0, amount: 42},
+		{name: "TooPrecise", value: "1.234", decimals: 2, err: ErrTooPrecise},
+		{name: "RoundDown", value: "1.239", decimals: 2, rounding: RoundDown, amount: 123},
+		{name: "RoundUp", value: "1.231", decimals: 2, rounding: RoundUp, amount: 124},
+		{name: "RoundUpNegative", value: "-1.231", decimals: 2, rounding: RoundUp, amount: -124},
+		{name: "HalfEvenDown", value: "1.225", decimals: 2, rounding: RoundHalfEven, amount: 122},
+		{name: "HalfEvenUp", value: "1.235", decimals: 2, rounding: RoundHalfEven, amount: 124},
+		{name: "HalfEvenAboveHalf", value: "1.2251", decimals: 2, rounding: RoundHalfEven, amount: 123},
+		{name: "MaxInt64", value: "9.223372036854775807", decimals: 18, amount: math.MaxInt64},
+		{name: "MinInt64", value: "-9.223372036854775808", decimals: 18, amount: math.MinInt64},
+		{name: "Overflow", value: "9.223372036854775808", decimals: 18, err: ErrOverflow},
+		{name: "OverflowAfterRounding", value: "9.2233720368547758071", decimals: 18, rounding: RoundUp, err: ErrOverflow},
+		{name: "Empty", value: "", decimals: 2, err: ErrInvalidAmount},
+		{name: "Dot", value: ".", decimals: 2, err: ErrInvalidAmount},
+		{name: "Exponent", value: "1e5", decimals: 2, err: ErrInvalidAmount},
+		{name: "Plus", value: "+1", decimals: 2, err: ErrInvalidAmount},
+		{name: "Comma", value: "1,000", decimals: 2, err: ErrInvalidAmount},
+		{name: "InvalidScale", value: "1", decimals: 19, err: ErrInvalidScale},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			amount, err := Parse(tc.value, tc.decimals, tc.rounding)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.amount, amount)
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	require.Equal(t, "1.5", Format(150000000, 8))
+	require.Equal(t, "12", Format(1200000000, 8))
+	require.Equal(t, "0.00000001", Format(1, 8))
+	require.Equal(t, "-0.01", Format(-1, 2))
+	require.Equal(t, "0", Format(0, 18))
+	require.Equal(t, "42", Format(42, 0))
+	require.Equal(t, "9.223372036854775807", Format(math.MaxInt64, 18))
+	require.Equal(t, "-9.223372036854775808", Format(math.MinInt64, 18))
+}
+
+func TestFormatParseRoundTrip(t *testing.T) {
+	for _, amount := range []int64{0, 1, -1, 100, 123456789, math.MaxInt64, math.MinInt64} {
+		for _, decimals := range []int32{0, 2, 6, 8, 18} {
+			parsed, err := Parse(Format(amount, decimals), decimals, RoundExact)
+			require.NoError(t, err)
+			require.Equal(t, amount, parsed)
+		}
+	}
+}
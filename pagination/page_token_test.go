@@ -0,0 +1,81 @@
+package pagination
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func TestPageToken(t *testing.T) {
+	signer, err := NewTokenSigner(util.RandomString(32))
+	require.NoError(t, err)
+
+	token, err := signer.Encode("traders", "alice", 42)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+
+	afterID, err := signer.Decode(token, "traders", "alice")
+	require.NoError(t, err)
+	require.Equal(t, int64(42), afterID)
+
+	afterID, err = signer.Decode("", "traders", "alice")
+	require.NoError(t, err)
+	require.Zero(t, afterID)
+}
+
+func TestKeysetPageToken(t *testing.T) {
+	signer, err := NewTokenSigner(util.RandomString(32))
+	require.NoError(t, err)
+
+	token, err := signer.EncodeKeyset("transactions", "alice", -7, 42)
+	require.NoError(t, err)
+
+	afterKey, afterID, err := signer.DecodeKeyset(token, "transactions", "alice")
+	require.NoError(t, err)
+	require.Equal(t, int64(-7), afterKey)
+	require.Equal(t, int64(42), afterID)
+
+	_, err = signer.Decode(token, "traders", "alice")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestPageTokenRejected(t *testing.T) {
+	signer, err := NewTokenSigner(util.RandomString(32))
+	require.NoError(t, err)
+
+	token, err := signer.Encode("traders", "alice", 42)
+	require.NoError(t, err)
+
+	_, err = signer.Decode(token, "records", "alice")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+
+	_, err = signer.Decode(token, "traders", "bob")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+
+	encoded, signature, _ := strings.Cut(token, ".")
+	_, err = signer.Decode(encoded+"x."+signature, "traders", "alice")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+
+	_, err = signer.Decode("not-a-token", "traders", "alice")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+
+	other, err := NewTokenSigner(util.RandomString(32))
+	require.NoError(t, err)
+	_, err = other.Decode(token, "traders", "alice")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestPage(t *testing.T) {
+	rows, more := Page([]int{1, 2, 3}, 2)
+	require.Equal(t, []int{1, 2}, rows)
+	require.True(t, more)
+
+	rows, more = Page([]int{1, 2}, 2)
+	require.Equal(t, []int{1, 2}, rows)
+	require.False(t, more)
+
+	require.Equal(t, int32(DefaultPageLmt), PageLmt(0))
+	require.Equal(t, int32(25), PageLmt(25))
+}
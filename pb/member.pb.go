@@ -31,6 +31,7 @@ type Member struct {
 	Email               string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 	PasswordChangedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=password_changed_time,json=passwordChangedTime,proto3" json:"password_changed_time,omitempty"`
 	CreatedTime         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	KycLevel            string                 `protobuf:"bytes,6,opt,name=kyc_level,json=kycLevel,proto3" json:"kyc_level,omitempty"`
 }
 
 func (x *Member) Reset() {
@@ -100,13 +101,20 @@ func (x *Member) GetCreatedTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Member) GetKycLevel() string {
+	if x != nil {
+		return x.KycLevel
+	}
+	return ""
+}
+
 var File_member_proto protoreflect.FileDescriptor
 
 var file_member_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
 	0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xee, 0x01, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e,
+	0x6f, 0x74, 0x6f, 0x22, 0x8b, 0x02, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e,
 	0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f,
 	0x0a, 0x0b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x72, 0x65, 0x18, 0x02, 0x20,
@@ -121,10 +129,12 @@ var file_member_proto_rawDesc = []byte{
 	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x59, 0x75, 0x61, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2f, 0x61, 0x6c, 0x6c, 0x65,
-	0x67, 0x72, 0x6f, 0x2d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x79, 0x63, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x79, 0x63, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x59, 0x75, 0x61, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2f, 0x61, 0x6c, 0x6c, 0x65, 0x67, 0x72, 0x6f,
+	0x2d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -0,0 +1,454 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: rpc_create_batch_transfer.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BatchTransferLeg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ToTraderId int64  `protobuf:"varint,1,opt,name=to_trader_id,json=toTraderId,proto3" json:"to_trader_id,omitempty"`
+	Number     string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *BatchTransferLeg) Reset() {
+	*x = BatchTransferLeg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_batch_transfer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchTransferLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTransferLeg) ProtoMessage() {}
+
+func (x *BatchTransferLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_batch_transfer_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTransferLeg.ProtoReflect.Descriptor instead.
+func (*BatchTransferLeg) Descriptor() ([]byte, []int) {
+	return file_rpc_create_batch_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchTransferLeg) GetToTraderId() int64 {
+	if x != nil {
+		return x.ToTraderId
+	}
+	return 0
+}
+
+func (x *BatchTransferLeg) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type BatchTransferLegResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record     *Record         `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	ToTrader   *Trader         `protobuf:"bytes,2,opt,name=to_trader,json=toTrader,proto3" json:"to_trader,omitempty"`
+	FromDetail *Detail         `protobuf:"bytes,3,opt,name=from_detail,json=fromDetail,proto3" json:"from_detail,omitempty"`
+	ToDetail   *Detail         `protobuf:"bytes,4,opt,name=to_detail,json=toDetail,proto3" json:"to_detail,omitempty"`
+	Fee        *FeeBreakdown   `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Review     *TransferReview `protobuf:"bytes,6,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *BatchTransferLegResult) Reset() {
+	*x = BatchTransferLegResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_batch_transfer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchTransferLegResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTransferLegResult) ProtoMessage() {}
+
+func (x *BatchTransferLegResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_batch_transfer_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTransferLegResult.ProtoReflect.Descriptor instead.
+func (*BatchTransferLegResult) Descriptor() ([]byte, []int) {
+	return file_rpc_create_batch_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BatchTransferLegResult) GetRecord() *Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *BatchTransferLegResult) GetToTrader() *Trader {
+	if x != nil {
+		return x.ToTrader
+	}
+	return nil
+}
+
+func (x *BatchTransferLegResult) GetFromDetail() *Detail {
+	if x != nil {
+		return x.FromDetail
+	}
+	return nil
+}
+
+func (x *BatchTransferLegResult) GetToDetail() *Detail {
+	if x != nil {
+		return x.ToDetail
+	}
+	return nil
+}
+
+func (x *BatchTransferLegResult) GetFee() *FeeBreakdown {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *BatchTransferLegResult) GetReview() *TransferReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type CreateBatchTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromTraderId int64               `protobuf:"varint,1,opt,name=from_trader_id,json=fromTraderId,proto3" json:"from_trader_id,omitempty"`
+	Symbol       string              `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Legs         []*BatchTransferLeg `protobuf:"bytes,3,rep,name=legs,proto3" json:"legs,omitempty"`
+}
+
+func (x *CreateBatchTransferRequest) Reset() {
+	*x = CreateBatchTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_batch_transfer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBatchTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBatchTransferRequest) ProtoMessage() {}
+
+func (x *CreateBatchTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_batch_transfer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBatchTransferRequest.ProtoReflect.Descriptor instead.
+func (*CreateBatchTransferRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_batch_transfer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateBatchTransferRequest) GetFromTraderId() int64 {
+	if x != nil {
+		return x.FromTraderId
+	}
+	return 0
+}
+
+func (x *CreateBatchTransferRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CreateBatchTransferRequest) GetLegs() []*BatchTransferLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+type CreateBatchTransferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromTrader *Trader                   `protobuf:"bytes,1,opt,name=from_trader,json=fromTrader,proto3" json:"from_trader,omitempty"`
+	Legs       []*BatchTransferLegResult `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
+}
+
+func (x *CreateBatchTransferResponse) Reset() {
+	*x = CreateBatchTransferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_create_batch_transfer_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBatchTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBatchTransferResponse) ProtoMessage() {}
+
+func (x *CreateBatchTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_batch_transfer_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBatchTransferResponse.ProtoReflect.Descriptor instead.
+func (*CreateBatchTransferResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_batch_transfer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateBatchTransferResponse) GetFromTrader() *Trader {
+	if x != nil {
+		return x.FromTrader
+	}
+	return nil
+}
+
+func (x *CreateBatchTransferResponse) GetLegs() []*BatchTransferLegResult {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+var File_rpc_create_batch_transfer_proto protoreflect.FileDescriptor
+
+var file_rpc_create_batch_transfer_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x72, 0x70, 0x63, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x09, 0x66, 0x65, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x4c, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x67, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x8b, 0x02, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x4c, 0x65, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x22, 0x0a, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x27, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x08, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x0b, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x08, 0x74, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x22, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x62, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x03,
+	0x66, 0x65, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x22,
+	0x84, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x04,
+	0x6c, 0x65, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x67,
+	0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x22, 0x7a, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x4c, 0x65, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x04, 0x6c, 0x65,
+	0x67, 0x73, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x59, 0x75, 0x61, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2f, 0x61, 0x6c, 0x6c, 0x65, 0x67, 0x72,
+	0x6f, 0x2d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_rpc_create_batch_transfer_proto_rawDescOnce sync.Once
+	file_rpc_create_batch_transfer_proto_rawDescData = file_rpc_create_batch_transfer_proto_rawDesc
+)
+
+func file_rpc_create_batch_transfer_proto_rawDescGZIP() []byte {
+	file_rpc_create_batch_transfer_proto_rawDescOnce.Do(func() {
+		file_rpc_create_batch_transfer_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_create_batch_transfer_proto_rawDescData)
+	})
+	return file_rpc_create_batch_transfer_proto_rawDescData
+}
+
+var file_rpc_create_batch_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpc_create_batch_transfer_proto_goTypes = []interface{}{
+	(*BatchTransferLeg)(nil),            // 0: pb.BatchTransferLeg
+	(*BatchTransferLegResult)(nil),      // 1: pb.BatchTransferLegResult
+	(*CreateBatchTransferRequest)(nil),  // 2: pb.CreateBatchTransferRequest
+	(*CreateBatchTransferResponse)(nil), // 3: pb.CreateBatchTransferResponse
+	(*Record)(nil),                      // 4: pb.Record
+	(*Trader)(nil),                      // 5: pb.Trader
+	(*Detail)(nil),                      // 6: pb.Detail
+	(*FeeBreakdown)(nil),                // 7: pb.FeeBreakdown
+	(*TransferReview)(nil),              // 8: pb.TransferReview
+}
+var file_rpc_create_batch_transfer_proto_depIdxs = []int32{
+	4, // 0: pb.BatchTransferLegResult.record:type_name -> pb.Record
+	5, // 1: pb.BatchTransferLegResult.to_trader:type_name -> pb.Trader
+	6, // 2: pb.BatchTransferLegResult.from_detail:type_name -> pb.Detail
+	6, // 3: pb.BatchTransferLegResult.to_detail:type_name -> pb.Detail
+	7, // 4: pb.BatchTransferLegResult.fee:type_name -> pb.FeeBreakdown
+	8, // 5: pb.BatchTransferLegResult.review:type_name -> pb.TransferReview
+	0, // 6: pb.CreateBatchTransferRequest.legs:type_name -> pb.BatchTransferLeg
+	5, // 7: pb.CreateBatchTransferResponse.from_trader:type_name -> pb.Trader
+	1, // 8: pb.CreateBatchTransferResponse.legs:type_name -> pb.BatchTransferLegResult
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_batch_transfer_proto_init() }
+func file_rpc_create_batch_transfer_proto_init() {
+	if File_rpc_create_batch_transfer_proto != nil {
+		return
+	}
+	file_trader_proto_init()
+	file_transfer_review_proto_init()
+	file_record_proto_init()
+	file_fee_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_create_batch_transfer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchTransferLeg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_create_batch_transfer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchTransferLegResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_create_batch_transfer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBatchTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_create_batch_transfer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBatchTransferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_create_batch_transfer_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_batch_transfer_proto_goTypes,
+		DependencyIndexes: file_rpc_create_batch_transfer_proto_depIdxs,
+		MessageInfos:      file_rpc_create_batch_transfer_proto_msgTypes,
+	}.Build()
+	File_rpc_create_batch_transfer_proto = out.File
+	file_rpc_create_batch_transfer_proto_rawDesc = nil
+	file_rpc_create_batch_transfer_proto_goTypes = nil
+	file_rpc_create_batch_transfer_proto_depIdxs = nil
+}
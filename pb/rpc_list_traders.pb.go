@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: rpc_list_traders.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListTradersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageLmt   int32  `protobuf:"varint,2,opt,name=page_lmt,json=pageLmt,proto3" json:"page_lmt,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListTradersRequest) Reset() {
+	*x = ListTradersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_traders_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTradersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradersRequest) ProtoMessage() {}
+
+func (x *ListTradersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_traders_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradersRequest.ProtoReflect.Descriptor instead.
+func (*ListTradersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_traders_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListTradersRequest) GetPageLmt() int32 {
+	if x != nil {
+		return x.PageLmt
+	}
+	return 0
+}
+
+func (x *ListTradersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTradersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Traders       []*Trader `protobuf:"bytes,1,rep,name=traders,proto3" json:"traders,omitempty"`
+	NextPageToken string    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListTradersResponse) Reset() {
+	*x = ListTradersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_list_traders_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTradersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTradersResponse) ProtoMessage() {}
+
+func (x *ListTradersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_traders_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTradersResponse.ProtoReflect.Descriptor instead.
+func (*ListTradersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_traders_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTradersResponse) GetTraders() []*Trader {
+	if x != nil {
+		return x.Traders
+	}
+	return nil
+}
+
+func (x *ListTradersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_rpc_list_traders_proto protoreflect.FileDescriptor
+
+var file_rpc_list_traders_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0c, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x54, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x6d, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x70, 0x61, 0x67, 0x65, 0x4c, 0x6d, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
+	0x22, 0x63, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x59, 0x75, 0x61, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2f, 0x61, 0x6c, 0x6c,
+	0x65, 0x67, 0x72, 0x6f, 0x2d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_list_traders_proto_rawDescOnce sync.Once
+	file_rpc_list_traders_proto_rawDescData = file_rpc_list_traders_proto_rawDesc
+)
+
+func file_rpc_list_traders_proto_rawDescGZIP() []byte {
+	file_rpc_list_traders_proto_rawDescOnce.Do(func() {
+		file_rpc_list_traders_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_list_traders_proto_rawDescData)
+	})
+	return file_rpc_list_traders_proto_rawDescData
+}
+
+var file_rpc_list_traders_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_traders_proto_goTypes = []interface{}{
+	(*ListTradersRequest)(nil),  // 0: pb.ListTradersRequest
+	(*ListTradersResponse)(nil), // 1: pb.ListTradersResponse
+	(*Trader)(nil),              // 2: pb.Trader
+}
+var file_rpc_list_traders_proto_depIdxs = []int32{
+	2, // 0: pb.ListTradersResponse.traders:type_name -> pb.Trader
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_traders_proto_init() }
+func file_rpc_list_traders_proto_init() {
+	if File_rpc_list_traders_proto != nil {
+		return
+	}
+	file_trader_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_list_traders_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTradersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_list_traders_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTradersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_list_traders_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_traders_proto_goTypes,
+		DependencyIndexes: file_rpc_list_traders_proto_depIdxs,
+		MessageInfos:      file_rpc_list_traders_proto_msgTypes,
+	}.Build()
+	File_rpc_list_traders_proto = out.File
+	file_rpc_list_traders_proto_rawDesc = nil
+	file_rpc_list_traders_proto_goTypes = nil
+	file_rpc_list_traders_proto_depIdxs = nil
+}
@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: rpc_open_escrow.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OpenEscrowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuyerTraderId  int64                `protobuf:"varint,1,opt,name=buyer_trader_id,json=buyerTraderId,proto3" json:"buyer_trader_id,omitempty"`
+	SellerTraderId int64                `protobuf:"varint,2,opt,name=seller_trader_id,json=sellerTraderId,proto3" json:"seller_trader_id,omitempty"`
+	Number         string               `protobuf:"bytes,3,opt,name=number,proto3" json:"number,omitempty"`
+	Symbol         string               `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Timeout        *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *OpenEscrowRequest) Reset() {
+	*x = OpenEscrowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_open_escrow_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenEscrowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenEscrowRequest) ProtoMessage() {}
+
+func (x *OpenEscrowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_open_escrow_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenEscrowRequest.ProtoReflect.Descriptor instead.
+func (*OpenEscrowRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_open_escrow_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OpenEscrowRequest) GetBuyerTraderId() int64 {
+	if x != nil {
+		return x.BuyerTraderId
+	}
+	return 0
+}
+
+func (x *OpenEscrowRequest) GetSellerTraderId() int64 {
+	if x != nil {
+		return x.SellerTraderId
+	}
+	return 0
+}
+
+func (x *OpenEscrowRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *OpenEscrowRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OpenEscrowRequest) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type OpenEscrowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Escrow      *Escrow         `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow,omitempty"`
+	BuyerTrader *Trader         `protobuf:"bytes,2,opt,name=buyer_trader,json=buyerTrader,proto3" json:"buyer_trader,omitempty"`
+	Review      *TransferReview `protobuf:"bytes,3,opt,name=review,proto3" json:"review,omitempty"`
+}
+
+func (x *OpenEscrowResponse) Reset() {
+	*x = OpenEscrowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_open_escrow_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenEscrowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenEscrowResponse) ProtoMessage() {}
+
+func (x *OpenEscrowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_open_escrow_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenEscrowResponse.ProtoReflect.Descriptor instead.
+func (*OpenEscrowResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_open_escrow_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OpenEscrowResponse) GetEscrow() *Escrow {
+	if x != nil {
+		return x.Escrow
+	}
+	return nil
+}
+
+func (x *OpenEscrowResponse) GetBuyerTrader() *Trader {
+	if x != nil {
+		return x.BuyerTrader
+	}
+	return nil
+}
+
+func (x *OpenEscrowResponse) GetReview() *TransferReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+var File_rpc_open_escrow_proto protoreflect.FileDescriptor
+
+var file_rpc_open_escrow_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x72, 0x70, 0x63, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x65, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xca,
+	0x01, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62,
+	0x75, 0x79, 0x65, 0x72, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10,
+	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x12,
+	0x4f, 0x70, 0x65, 0x6e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x06,
+	0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x2d, 0x0a, 0x0c, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x75, 0x79, 0x65, 0x72, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x59, 0x75, 0x61, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2f, 0x61, 0x6c, 0x6c, 0x65, 0x67, 0x72, 0x6f,
+	0x2d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_rpc_open_escrow_proto_rawDescOnce sync.Once
+	file_rpc_open_escrow_proto_rawDescData = file_rpc_open_escrow_proto_rawDesc
+)
+
+func file_rpc_open_escrow_proto_rawDescGZIP() []byte {
+	file_rpc_open_escrow_proto_rawDescOnce.Do(func() {
+		file_rpc_open_escrow_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_open_escrow_proto_rawDescData)
+	})
+	return file_rpc_open_escrow_proto_rawDescData
+}
+
+var file_rpc_open_escrow_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_open_escrow_proto_goTypes = []interface{}{
+	(*OpenEscrowRequest)(nil),   // 0: pb.OpenEscrowRequest
+	(*OpenEscrowResponse)(nil),  // 1: pb.OpenEscrowResponse
+	(*durationpb.Duration)(nil), // 2: google.protobuf.Duration
+	(*Escrow)(nil),              // 3: pb.Escrow
+	(*Trader)(nil),              // 4: pb.Trader
+	(*TransferReview)(nil),      // 5: pb.TransferReview
+}
+var file_rpc_open_escrow_proto_depIdxs = []int32{
+	2, // 0: pb.OpenEscrowRequest.timeout:type_name -> google.protobuf.Duration
+	3, // 1: pb.OpenEscrowResponse.escrow:type_name -> pb.Escrow
+	4, // 2: pb.OpenEscrowResponse.buyer_trader:type_name -> pb.Trader
+	5, // 3: pb.OpenEscrowResponse.review:type_name -> pb.TransferReview
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rpc_open_escrow_proto_init() }
+func file_rpc_open_escrow_proto_init() {
+	if File_rpc_open_escrow_proto != nil {
+		return
+	}
+	file_escrow_proto_init()
+	file_trader_proto_init()
+	file_transfer_review_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_open_escrow_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenEscrowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_open_escrow_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenEscrowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_open_escrow_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_open_escrow_proto_goTypes,
+		DependencyIndexes: file_rpc_open_escrow_proto_depIdxs,
+		MessageInfos:      file_rpc_open_escrow_proto_msgTypes,
+	}.Build()
+	File_rpc_open_escrow_proto = out.File
+	file_rpc_open_escrow_proto_rawDesc = nil
+	file_rpc_open_escrow_proto_goTypes = nil
+	file_rpc_open_escrow_proto_depIdxs = nil
+}
@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: rpc_resolve_escrow.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ResolveEscrowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Release bool  `protobuf:"varint,2,opt,name=release,proto3" json:"release,omitempty"`
+}
+
+func (x *ResolveEscrowRequest) Reset() {
+	*x = ResolveEscrowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_resolve_escrow_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveEscrowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveEscrowRequest) ProtoMessage() {}
+
+func (x *ResolveEscrowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_resolve_escrow_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveEscrowRequest.ProtoReflect.Descriptor instead.
+func (*ResolveEscrowRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_resolve_escrow_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ResolveEscrowRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResolveEscrowRequest) GetRelease() bool {
+	if x != nil {
+		return x.Release
+	}
+	return false
+}
+
+type ResolveEscrowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Escrow *Escrow `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow,omitempty"`
+	Trader *Trader `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty"`
+}
+
+func (x *ResolveEscrowResponse) Reset() {
+	*x = ResolveEscrowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_resolve_escrow_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveEscrowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveEscrowResponse) ProtoMessage() {}
+
+func (x *ResolveEscrowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_resolve_escrow_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveEscrowResponse.ProtoReflect.Descriptor instead.
+func (*ResolveEscrowResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_resolve_escrow_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ResolveEscrowResponse) GetEscrow() *Escrow {
+	if x != nil {
+		return x.Escrow
+	}
+	return nil
+}
+
+func (x *ResolveEscrowResponse) GetTrader() *Trader {
+	if x != nil {
+		return x.Trader
+	}
+	return nil
+}
+
+var File_rpc_resolve_escrow_proto protoreflect.FileDescriptor
+
+var file_rpc_resolve_escrow_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x65, 0x73,
+	0x63, 0x72, 0x6f, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0c,
+	0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x40, 0x0a, 0x14, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x15,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x52, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x22, 0x0a, 0x06, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x42, 0x26, 0x5a,
+	0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x59, 0x75, 0x61, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x2f, 0x61, 0x6c, 0x6c, 0x65, 0x67, 0x72, 0x6f, 0x2d, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_resolve_escrow_proto_rawDescOnce sync.Once
+	file_rpc_resolve_escrow_proto_rawDescData = file_rpc_resolve_escrow_proto_rawDesc
+)
+
+func file_rpc_resolve_escrow_proto_rawDescGZIP() []byte {
+	file_rpc_resolve_escrow_proto_rawDescOnce.Do(func() {
+		file_rpc_resolve_escrow_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_resolve_escrow_proto_rawDescData)
+	})
+	return file_rpc_resolve_escrow_proto_rawDescData
+}
+
+var file_rpc_resolve_escrow_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_resolve_escrow_proto_goTypes = []interface{}{
+	(*ResolveEscrowRequest)(nil),  // 0: pb.ResolveEscrowRequest
+	(*ResolveEscrowResponse)(nil), // 1: pb.ResolveEscrowResponse
+	(*Escrow)(nil),                // 2: pb.Escrow
+	(*Trader)(nil),                // 3: pb.Trader
+}
+var file_rpc_resolve_escrow_proto_depIdxs = []int32{
+	2, // 0: pb.ResolveEscrowResponse.escrow:type_name -> pb.Escrow
+	3, // 1: pb.ResolveEscrowResponse.trader:type_name -> pb.Trader
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_resolve_escrow_proto_init() }
+func file_rpc_resolve_escrow_proto_init() {
+	if File_rpc_resolve_escrow_proto != nil {
+		return
+	}
+	file_escrow_proto_init()
+	file_trader_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_resolve_escrow_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveEscrowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_resolve_escrow_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveEscrowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_resolve_escrow_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_resolve_escrow_proto_goTypes,
+		DependencyIndexes: file_rpc_resolve_escrow_proto_depIdxs,
+		MessageInfos:      file_rpc_resolve_escrow_proto_msgTypes,
+	}.Build()
+	File_rpc_resolve_escrow_proto = out.File
+	file_rpc_resolve_escrow_proto_rawDesc = nil
+	file_rpc_resolve_escrow_proto_goTypes = nil
+	file_rpc_resolve_escrow_proto_depIdxs = nil
+}
@@ -0,0 +1,284 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: rpc_reverse_record.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReverseRecordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordId int64  `protobuf:"varint,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Number   string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+}
+
+func (x *ReverseRecordRequest) Reset() {
+	*x = ReverseRecordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_reverse_record_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseRecordRequest) ProtoMessage() {}
+
+func (x *ReverseRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_reverse_record_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseRecordRequest.ProtoReflect.Descriptor instead.
+func (*ReverseRecordRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_reverse_record_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReverseRecordRequest) GetRecordId() int64 {
+	if x != nil {
+		return x.RecordId
+	}
+	return 0
+}
+
+func (x *ReverseRecordRequest) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type ReverseRecordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Original   *Record `protobuf:"bytes,1,opt,name=original,proto3" json:"original,omitempty"`
+	Record     *Record `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	FromTrader *Trader `protobuf:"bytes,3,opt,name=from_trader,json=fromTrader,proto3" json:"from_trader,omitempty"`
+	ToTrader   *Trader `protobuf:"bytes,4,opt,name=to_trader,json=toTrader,proto3" json:"to_trader,omitempty"`
+	FromDetail *Detail `protobuf:"bytes,5,opt,name=from_detail,json=fromDetail,proto3" json:"from_detail,omitempty"`
+	ToDetail   *Detail `protobuf:"bytes,6,opt,name=to_detail,json=toDetail,proto3" json:"to_detail,omitempty"`
+}
+
+func (x *ReverseRecordResponse) Reset() {
+	*x = ReverseRecordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_reverse_record_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseRecordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseRecordResponse) ProtoMessage() {}
+
+func (x *ReverseRecordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_reverse_record_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseRecordResponse.ProtoReflect.Descriptor instead.
+func (*ReverseRecordResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_reverse_record_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReverseRecordResponse) GetOriginal() *Record {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *ReverseRecordResponse) GetRecord() *Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ReverseRecordResponse) GetFromTrader() *Trader {
+	if x != nil {
+		return x.FromTrader
+	}
+	return nil
+}
+
+func (x *ReverseRecordResponse) GetToTrader() *Trader {
+	if x != nil {
+		return x.ToTrader
+	}
+	return nil
+}
+
+func (x *ReverseRecordResponse) GetFromDetail() *Detail {
+	if x != nil {
+		return x.FromDetail
+	}
+	return nil
+}
+
+func (x *ReverseRecordResponse) GetToDetail() *Detail {
+	if x != nil {
+		return x.ToDetail
+	}
+	return nil
+}
+
+var File_rpc_reverse_record_proto protoreflect.FileDescriptor
+
+var file_rpc_reverse_record_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0c,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4b, 0x0a, 0x14, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x8f, 0x02, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x26, 0x0a, 0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x08, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2b, 0x0a,
+	0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0a,
+	0x66, 0x72, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x09, 0x74, 0x6f,
+	0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x08, 0x74, 0x6f, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x27, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x08, 0x74, 0x6f, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x59, 0x75, 0x61, 0x6e, 0x44, 0x61, 0x74, 0x61,
+	0x2f, 0x61, 0x6c, 0x6c, 0x65, 0x67, 0x72, 0x6f, 0x2d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_reverse_record_proto_rawDescOnce sync.Once
+	file_rpc_reverse_record_proto_rawDescData = file_rpc_reverse_record_proto_rawDesc
+)
+
+func file_rpc_reverse_record_proto_rawDescGZIP() []byte {
+	file_rpc_reverse_record_proto_rawDescOnce.Do(func() {
+		file_rpc_reverse_record_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_reverse_record_proto_rawDescData)
+	})
+	return file_rpc_reverse_record_proto_rawDescData
+}
+
+var file_rpc_reverse_record_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_reverse_record_proto_goTypes = []interface{}{
+	(*ReverseRecordRequest)(nil),  // 0: pb.ReverseRecordRequest
+	(*ReverseRecordResponse)(nil), // 1: pb.ReverseRecordResponse
+	(*Record)(nil),                // 2: pb.Record
+	(*Trader)(nil),                // 3: pb.Trader
+	(*Detail)(nil),                // 4: pb.Detail
+}
+var file_rpc_reverse_record_proto_depIdxs = []int32{
+	2, // 0: pb.ReverseRecordResponse.original:type_name -> pb.Record
+	2, // 1: pb.ReverseRecordResponse.record:type_name -> pb.Record
+	3, // 2: pb.ReverseRecordResponse.from_trader:type_name -> pb.Trader
+	3, // 3: pb.ReverseRecordResponse.to_trader:type_name -> pb.Trader
+	4, // 4: pb.ReverseRecordResponse.from_detail:type_name -> pb.Detail
+	4, // 5: pb.ReverseRecordResponse.to_detail:type_name -> pb.Detail
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_rpc_reverse_record_proto_init() }
+func file_rpc_reverse_record_proto_init() {
+	if File_rpc_reverse_record_proto != nil {
+		return
+	}
+	file_record_proto_init()
+	file_trader_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_reverse_record_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_reverse_record_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_reverse_record_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_reverse_record_proto_goTypes,
+		DependencyIndexes: file_rpc_reverse_record_proto_depIdxs,
+		MessageInfos:      file_rpc_reverse_record_proto_msgTypes,
+	}.Build()
+	File_rpc_reverse_record_proto = out.File
+	file_rpc_reverse_record_proto_rawDesc = nil
+	file_rpc_reverse_record_proto_goTypes = nil
+	file_rpc_reverse_record_proto_depIdxs = nil
+}
@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: rpc_review_kyc_submission.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReviewKYCSubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve bool   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ReviewKYCSubmissionRequest) Reset() {
+	*x = ReviewKYCSubmissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_review_kyc_submission_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewKYCSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewKYCSubmissionRequest) ProtoMessage() {}
+
+func (x *ReviewKYCSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_review_kyc_submission_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewKYCSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*ReviewKYCSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_review_kyc_submission_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReviewKYCSubmissionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewKYCSubmissionRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewKYCSubmissionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReviewKYCSubmissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submission *KYCSubmission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	Member     *Member        `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *ReviewKYCSubmissionResponse) Reset() {
+	*x = ReviewKYCSubmissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_review_kyc_submission_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewKYCSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewKYCSubmissionResponse) ProtoMessage() {}
+
+func (x *ReviewKYCSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_review_kyc_submission_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewKYCSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*ReviewKYCSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_review_kyc_submission_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewKYCSubmissionResponse) GetSubmission() *KYCSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+func (x *ReviewKYCSubmissionResponse) GetMember() *Member {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+var File_rpc_review_kyc_submission_proto protoreflect.FileDescriptor
+
+var file_rpc_review_kyc_submission_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6b, 0x79, 0x63,
+	0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x09, 0x6b, 0x79, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0c, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5e,
+	0x0a, 0x1a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x59, 0x43, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x74,
+	0x0a, 0x1b, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x59, 0x43, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x4b, 0x59, 0x43, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x59, 0x75, 0x61, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2f, 0x61, 0x6c, 0x6c, 0x65,
+	0x67, 0x72, 0x6f, 0x2d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_review_kyc_submission_proto_rawDescOnce sync.Once
+	file_rpc_review_kyc_submission_proto_rawDescData = file_rpc_review_kyc_submission_proto_rawDesc
+)
+
+func file_rpc_review_kyc_submission_proto_rawDescGZIP() []byte {
+	file_rpc_review_kyc_submission_proto_rawDescOnce.Do(func() {
+		file_rpc_review_kyc_submission_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_review_kyc_submission_proto_rawDescData)
+	})
+	return file_rpc_review_kyc_submission_proto_rawDescData
+}
+
+var file_rpc_review_kyc_submission_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_review_kyc_submission_proto_goTypes = []interface{}{
+	(*ReviewKYCSubmissionRequest)(nil),  // 0: pb.ReviewKYCSubmissionRequest
+	(*ReviewKYCSubmissionResponse)(nil), // 1: pb.ReviewKYCSubmissionResponse
+	(*KYCSubmission)(nil),               // 2: pb.KYCSubmission
+	(*Member)(nil),                      // 3: pb.Member
+}
+var file_rpc_review_kyc_submission_proto_depIdxs = []int32{
+	2, // 0: pb.ReviewKYCSubmissionResponse.submission:type_name -> pb.KYCSubmission
+	3, // 1: pb.ReviewKYCSubmissionResponse.member:type_name -> pb.Member
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_review_kyc_submission_proto_init() }
+func file_rpc_review_kyc_submission_proto_init() {
+	if File_rpc_review_kyc_submission_proto != nil {
+		return
+	}
+	file_kyc_proto_init()
+	file_member_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_review_kyc_submission_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewKYCSubmissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_review_kyc_submission_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewKYCSubmissionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_review_kyc_submission_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_review_kyc_submission_proto_goTypes,
+		DependencyIndexes: file_rpc_review_kyc_submission_proto_depIdxs,
+		MessageInfos:      file_rpc_review_kyc_submission_proto_msgTypes,
+	}.Build()
+	File_rpc_review_kyc_submission_proto = out.File
+	file_rpc_review_kyc_submission_proto_rawDesc = nil
+	file_rpc_review_kyc_submission_proto_goTypes = nil
+	file_rpc_review_kyc_submission_proto_depIdxs = nil
+}
@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: rpc_review_transfer.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReviewTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve bool  `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+}
+
+func (x *ReviewTransferRequest) Reset() {
+	*x = ReviewTransferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_review_transfer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewTransferRequest) ProtoMessage() {}
+
+func (x *ReviewTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_review_transfer_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewTransferRequest.ProtoReflect.Descriptor instead.
+func (*ReviewTransferRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_review_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReviewTransferRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReviewTransferRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type ReviewTransferResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Review     *TransferReview `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	FromTrader *Trader         `protobuf:"bytes,2,opt,name=from_trader,json=fromTrader,proto3" json:"from_trader,omitempty"`
+	Record     *Record         `protobuf:"bytes,3,opt,name=record,proto3" json:"record,omitempty"`
+	Escrow     *Escrow         `protobuf:"bytes,4,opt,name=escrow,proto3" json:"escrow,omitempty"`
+}
+
+func (x *ReviewTransferResponse) Reset() {
+	*x = ReviewTransferResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_review_transfer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewTransferResponse) ProtoMessage() {}
+
+func (x *ReviewTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_review_transfer_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewTransferResponse.ProtoReflect.Descriptor instead.
+func (*ReviewTransferResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_review_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewTransferResponse) GetReview() *TransferReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *ReviewTransferResponse) GetFromTrader() *Trader {
+	if x != nil {
+		return x.FromTrader
+	}
+	return nil
+}
+
+func (x *ReviewTransferResponse) GetRecord() *Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ReviewTransferResponse) GetEscrow() *Escrow {
+	if x != nil {
+		return x.Escrow
+	}
+	return nil
+}
+
+var File_rpc_review_transfer_proto protoreflect.FileDescriptor
+
+var file_rpc_review_transfer_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a,
+	0x0c, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x41, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x22, 0xb9, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x2b, 0x0a, 0x0b, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x66, 0x72, 0x6f,
+	0x6d, 0x54, 0x72, 0x61, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x22, 0x0a, 0x06, 0x65,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x70, 0x62,
+	0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x52, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x42,
+	0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x59, 0x75,
+	0x61, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2f, 0x61, 0x6c, 0x6c, 0x65, 0x67, 0x72, 0x6f, 0x2d, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_review_transfer_proto_rawDescOnce sync.Once
+	file_rpc_review_transfer_proto_rawDescData = file_rpc_review_transfer_proto_rawDesc
+)
+
+func file_rpc_review_transfer_proto_rawDescGZIP() []byte {
+	file_rpc_review_transfer_proto_rawDescOnce.Do(func() {
+		file_rpc_review_transfer_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_review_transfer_proto_rawDescData)
+	})
+	return file_rpc_review_transfer_proto_rawDescData
+}
+
+var file_rpc_review_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_review_transfer_proto_goTypes = []interface{}{
+	(*ReviewTransferRequest)(nil),  // 0: pb.ReviewTransferRequest
+	(*ReviewTransferResponse)(nil), // 1: pb.ReviewTransferResponse
+	(*TransferReview)(nil),         // 2: pb.TransferReview
+	(*Trader)(nil),                 // 3: pb.Trader
+	(*Record)(nil),                 // 4: pb.Record
+	(*Escrow)(nil),                 // 5: pb.Escrow
+}
+var file_rpc_review_transfer_proto_depIdxs = []int32{
+	2, // 0: pb.ReviewTransferResponse.review:type_name -> pb.TransferReview
+	3, // 1: pb.ReviewTransferResponse.from_trader:type_name -> pb.Trader
+	4, // 2: pb.ReviewTransferResponse.record:type_name -> pb.Record
+	5, // 3: pb.ReviewTransferResponse.escrow:type_name -> pb.Escrow
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_rpc_review_transfer_proto_init() }
+func file_rpc_review_transfer_proto_init() {
+	if File_rpc_review_transfer_proto != nil {
+		return
+	}
+	file_escrow_proto_init()
+	file_record_proto_init()
+	file_trader_proto_init()
+	file_transfer_review_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_review_transfer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewTransferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_review_transfer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewTransferResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_review_transfer_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_review_transfer_proto_goTypes,
+		DependencyIndexes: file_rpc_review_transfer_proto_depIdxs,
+		MessageInfos:      file_rpc_review_transfer_proto_msgTypes,
+	}.Build()
+	File_rpc_review_transfer_proto = out.File
+	file_rpc_review_transfer_proto_rawDesc = nil
+	file_rpc_review_transfer_proto_goTypes = nil
+	file_rpc_review_transfer_proto_depIdxs = nil
+}
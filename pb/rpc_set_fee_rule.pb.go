@@ -0,0 +1,280 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: rpc_set_fee_rule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetFeeRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Symbol  string     `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Role    string     `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Kind    string     `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	FlatFee string     `protobuf:"bytes,4,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee,omitempty"`
+	RateBps int32      `protobuf:"varint,5,opt,name=rate_bps,json=rateBps,proto3" json:"rate_bps,omitempty"`
+	MinFee  string     `protobuf:"bytes,6,opt,name=min_fee,json=minFee,proto3" json:"min_fee,omitempty"`
+	MaxFee  string     `protobuf:"bytes,7,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+	Tiers   []*FeeTier `protobuf:"bytes,8,rep,name=tiers,proto3" json:"tiers,omitempty"`
+}
+
+func (x *SetFeeRuleRequest) Reset() {
+	*x = SetFeeRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_set_fee_rule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFeeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeeRuleRequest) ProtoMessage() {}
+
+func (x *SetFeeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_fee_rule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeeRuleRequest.ProtoReflect.Descriptor instead.
+func (*SetFeeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_set_fee_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetFeeRuleRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *SetFeeRuleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SetFeeRuleRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SetFeeRuleRequest) GetFlatFee() string {
+	if x != nil {
+		return x.FlatFee
+	}
+	return ""
+}
+
+func (x *SetFeeRuleRequest) GetRateBps() int32 {
+	if x != nil {
+		return x.RateBps
+	}
+	return 0
+}
+
+func (x *SetFeeRuleRequest) GetMinFee() string {
+	if x != nil {
+		return x.MinFee
+	}
+	return ""
+}
+
+func (x *SetFeeRuleRequest) GetMaxFee() string {
+	if x != nil {
+		return x.MaxFee
+	}
+	return ""
+}
+
+func (x *SetFeeRuleRequest) GetTiers() []*FeeTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+type SetFeeRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *FeeRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *SetFeeRuleResponse) Reset() {
+	*x = SetFeeRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_set_fee_rule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFeeRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeeRuleResponse) ProtoMessage() {}
+
+func (x *SetFeeRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_fee_rule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeeRuleResponse.ProtoReflect.Descriptor instead.
+func (*SetFeeRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_set_fee_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetFeeRuleResponse) GetRule() *FeeRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+var File_rpc_set_fee_rule_proto protoreflect.FileDescriptor
+
+var file_rpc_set_fee_rule_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x09, 0x66, 0x65,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xde, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x46,
+	0x65, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x66, 0x6c, 0x61, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x66, 0x6c, 0x61, 0x74, 0x46, 0x65, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x62, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x61, 0x74, 0x65,
+	0x42, 0x70, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x61, 0x78, 0x46, 0x65, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x69, 0x65,
+	0x72, 0x52, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x22, 0x35, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x46,
+	0x65, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70,
+	0x62, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x42,
+	0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x59, 0x75,
+	0x61, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x2f, 0x61, 0x6c, 0x6c, 0x65, 0x67, 0x72, 0x6f, 0x2d, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rpc_set_fee_rule_proto_rawDescOnce sync.Once
+	file_rpc_set_fee_rule_proto_rawDescData = file_rpc_set_fee_rule_proto_rawDesc
+)
+
+func file_rpc_set_fee_rule_proto_rawDescGZIP() []byte {
+	file_rpc_set_fee_rule_proto_rawDescOnce.Do(func() {
+		file_rpc_set_fee_rule_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_set_fee_rule_proto_rawDescData)
+	})
+	return file_rpc_set_fee_rule_proto_rawDescData
+}
+
+var file_rpc_set_fee_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_set_fee_rule_proto_goTypes = []interface{}{
+	(*SetFeeRuleRequest)(nil),  // 0: pb.SetFeeRuleRequest
+	(*SetFeeRuleResponse)(nil), // 1: pb.SetFeeRuleResponse
+	(*FeeTier)(nil),            // 2: pb.FeeTier
+	(*FeeRule)(nil),            // 3: pb.FeeRule
+}
+var file_rpc_set_fee_rule_proto_depIdxs = []int32{
+	2, // 0: pb.SetFeeRuleRequest.tiers:type_name -> pb.FeeTier
+	3, // 1: pb.SetFeeRuleResponse.rule:type_name -> pb.FeeRule
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_set_fee_rule_proto_init() }
+func file_rpc_set_fee_rule_proto_init() {
+	if File_rpc_set_fee_rule_proto != nil {
+		return
+	}
+	file_fee_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_set_fee_rule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFeeRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_set_fee_rule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFeeRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rpc_set_fee_rule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_set_fee_rule_proto_goTypes,
+		DependencyIndexes: file_rpc_set_fee_rule_proto_depIdxs,
+		MessageInfos:      file_rpc_set_fee_rule_proto_msgTypes,
+	}.Build()
+	File_rpc_set_fee_rule_proto = out.File
+	file_rpc_set_fee_rule_proto_rawDesc = nil
+	file_rpc_set_fee_rule_proto_goTypes = nil
+	file_rpc_set_fee_rule_proto_depIdxs = nil
+}
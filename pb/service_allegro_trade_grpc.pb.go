@@ -19,10 +19,56 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AllegroTrade_CreateMember_FullMethodName = "/pb.AllegroTrade/CreateMember"
-	AllegroTrade_UpdateMember_FullMethodName = "/pb.AllegroTrade/UpdateMember"
-	AllegroTrade_LoginMember_FullMethodName  = "/pb.AllegroTrade/LoginMember"
-	AllegroTrade_VerifyEmail_FullMethodName  = "/pb.AllegroTrade/VerifyEmail"
+	AllegroTrade_CreateMember_FullMethodName              = "/pb.AllegroTrade/CreateMember"
+	AllegroTrade_UpdateMember_FullMethodName              = "/pb.AllegroTrade/UpdateMember"
+	AllegroTrade_LoginMember_FullMethodName               = "/pb.AllegroTrade/LoginMember"
+	AllegroTrade_VerifyEmail_FullMethodName               = "/pb.AllegroTrade/VerifyEmail"
+	AllegroTrade_CreateTrader_FullMethodName              = "/pb.AllegroTrade/CreateTrader"
+	AllegroTrade_GetTrader_FullMethodName                 = "/pb.AllegroTrade/GetTrader"
+	AllegroTrade_ListTraders_FullMethodName               = "/pb.AllegroTrade/ListTraders"
+	AllegroTrade_CreateTransfer_FullMethodName            = "/pb.AllegroTrade/CreateTransfer"
+	AllegroTrade_PlaceOrder_FullMethodName                = "/pb.AllegroTrade/PlaceOrder"
+	AllegroTrade_CancelOrder_FullMethodName               = "/pb.AllegroTrade/CancelOrder"
+	AllegroTrade_UpdateOverdraftLimit_FullMethodName      = "/pb.AllegroTrade/UpdateOverdraftLimit"
+	AllegroTrade_CreateDeposit_FullMethodName             = "/pb.AllegroTrade/CreateDeposit"
+	AllegroTrade_CreateWithdrawal_FullMethodName          = "/pb.AllegroTrade/CreateWithdrawal"
+	AllegroTrade_ReviewWithdrawal_FullMethodName          = "/pb.AllegroTrade/ReviewWithdrawal"
+	AllegroTrade_ListWithdrawals_FullMethodName           = "/pb.AllegroTrade/ListWithdrawals"
+	AllegroTrade_ReverseRecord_FullMethodName             = "/pb.AllegroTrade/ReverseRecord"
+	AllegroTrade_CreateScheduledTransfer_FullMethodName   = "/pb.AllegroTrade/CreateScheduledTransfer"
+	AllegroTrade_GetScheduledTransfer_FullMethodName      = "/pb.AllegroTrade/GetScheduledTransfer"
+	AllegroTrade_ListScheduledTransfers_FullMethodName    = "/pb.AllegroTrade/ListScheduledTransfers"
+	AllegroTrade_UpdateScheduledTransfer_FullMethodName   = "/pb.AllegroTrade/UpdateScheduledTransfer"
+	AllegroTrade_DeleteScheduledTransfer_FullMethodName   = "/pb.AllegroTrade/DeleteScheduledTransfer"
+	AllegroTrade_ListScheduledTransferRuns_FullMethodName = "/pb.AllegroTrade/ListScheduledTransferRuns"
+	AllegroTrade_CreateBatchTransfer_FullMethodName       = "/pb.AllegroTrade/CreateBatchTransfer"
+	AllegroTrade_OpenEscrow_FullMethodName                = "/pb.AllegroTrade/OpenEscrow"
+	AllegroTrade_ConfirmEscrow_FullMethodName             = "/pb.AllegroTrade/ConfirmEscrow"
+	AllegroTrade_DisputeEscrow_FullMethodName             = "/pb.AllegroTrade/DisputeEscrow"
+	AllegroTrade_CancelEscrow_FullMethodName              = "/pb.AllegroTrade/CancelEscrow"
+	AllegroTrade_ResolveEscrow_FullMethodName             = "/pb.AllegroTrade/ResolveEscrow"
+	AllegroTrade_ListEscrows_FullMethodName               = "/pb.AllegroTrade/ListEscrows"
+	AllegroTrade_SetFeeRule_FullMethodName                = "/pb.AllegroTrade/SetFeeRule"
+	AllegroTrade_ListFeeRules_FullMethodName              = "/pb.AllegroTrade/ListFeeRules"
+	AllegroTrade_DeleteFeeRule_FullMethodName             = "/pb.AllegroTrade/DeleteFeeRule"
+	AllegroTrade_CreateSymbol_FullMethodName              = "/pb.AllegroTrade/CreateSymbol"
+	AllegroTrade_UpdateSymbol_FullMethodName              = "/pb.AllegroTrade/UpdateSymbol"
+	AllegroTrade_ListSymbols_FullMethodName               = "/pb.AllegroTrade/ListSymbols"
+	AllegroTrade_ListRecords_FullMethodName               = "/pb.AllegroTrade/ListRecords"
+	AllegroTrade_ListDetails_FullMethodName               = "/pb.AllegroTrade/ListDetails"
+	AllegroTrade_ListTransactions_FullMethodName          = "/pb.AllegroTrade/ListTransactions"
+	AllegroTrade_ExportStatement_FullMethodName           = "/pb.AllegroTrade/ExportStatement"
+	AllegroTrade_EmailStatement_FullMethodName            = "/pb.AllegroTrade/EmailStatement"
+	AllegroTrade_UpdateTraderStatus_FullMethodName        = "/pb.AllegroTrade/UpdateTraderStatus"
+	AllegroTrade_SetTransferLimit_FullMethodName          = "/pb.AllegroTrade/SetTransferLimit"
+	AllegroTrade_ListTransferLimits_FullMethodName        = "/pb.AllegroTrade/ListTransferLimits"
+	AllegroTrade_DeleteTransferLimit_FullMethodName       = "/pb.AllegroTrade/DeleteTransferLimit"
+	AllegroTrade_ListTransferReviews_FullMethodName       = "/pb.AllegroTrade/ListTransferReviews"
+	AllegroTrade_ReviewTransfer_FullMethodName            = "/pb.AllegroTrade/ReviewTransfer"
+	AllegroTrade_SubmitKYC_FullMethodName                 = "/pb.AllegroTrade/SubmitKYC"
+	AllegroTrade_ListKYCSubmissions_FullMethodName        = "/pb.AllegroTrade/ListKYCSubmissions"
+	AllegroTrade_ReviewKYCSubmission_FullMethodName       = "/pb.AllegroTrade/ReviewKYCSubmission"
+	AllegroTrade_GetQuote_FullMethodName                  = "/pb.AllegroTrade/GetQuote"
 )
 
 // AllegroTradeClient is the client API for AllegroTrade service.
@@ -33,6 +79,53 @@ type AllegroTradeClient interface {
 	UpdateMember(ctx context.Context, in *UpdateMemberRequest, opts ...grpc.CallOption) (*UpdateMemberResponse, error)
 	LoginMember(ctx context.Context, in *LoginMemberRequest, opts ...grpc.CallOption) (*LoginMemberResponse, error)
 	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	CreateTrader(ctx context.Context, in *CreateTraderRequest, opts ...grpc.CallOption) (*CreateTraderResponse, error)
+	GetTrader(ctx context.Context, in *GetTraderRequest, opts ...grpc.CallOption) (*GetTraderResponse, error)
+	ListTraders(ctx context.Context, in *ListTradersRequest, opts ...grpc.CallOption) (*ListTradersResponse, error)
+	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error)
+	PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	UpdateOverdraftLimit(ctx context.Context, in *UpdateOverdraftLimitRequest, opts ...grpc.CallOption) (*UpdateOverdraftLimitResponse, error)
+	CreateDeposit(ctx context.Context, in *CreateDepositRequest, opts ...grpc.CallOption) (*CreateDepositResponse, error)
+	CreateWithdrawal(ctx context.Context, in *CreateWithdrawalRequest, opts ...grpc.CallOption) (*CreateWithdrawalResponse, error)
+	ReviewWithdrawal(ctx context.Context, in *ReviewWithdrawalRequest, opts ...grpc.CallOption) (*ReviewWithdrawalResponse, error)
+	ListWithdrawals(ctx context.Context, in *ListWithdrawalsRequest, opts ...grpc.CallOption) (*ListWithdrawalsResponse, error)
+	ReverseRecord(ctx context.Context, in *ReverseRecordRequest, opts ...grpc.CallOption) (*ReverseRecordResponse, error)
+	CreateScheduledTransfer(ctx context.Context, in *CreateScheduledTransferRequest, opts ...grpc.CallOption) (*CreateScheduledTransferResponse, error)
+	GetScheduledTransfer(ctx context.Context, in *GetScheduledTransferRequest, opts ...grpc.CallOption) (*GetScheduledTransferResponse, error)
+	ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error)
+	UpdateScheduledTransfer(ctx context.Context, in *UpdateScheduledTransferRequest, opts ...grpc.CallOption) (*UpdateScheduledTransferResponse, error)
+	DeleteScheduledTransfer(ctx context.Context, in *DeleteScheduledTransferRequest, opts ...grpc.CallOption) (*DeleteScheduledTransferResponse, error)
+	ListScheduledTransferRuns(ctx context.Context, in *ListScheduledTransferRunsRequest, opts ...grpc.CallOption) (*ListScheduledTransferRunsResponse, error)
+	CreateBatchTransfer(ctx context.Context, in *CreateBatchTransferRequest, opts ...grpc.CallOption) (*CreateBatchTransferResponse, error)
+	OpenEscrow(ctx context.Context, in *OpenEscrowRequest, opts ...grpc.CallOption) (*OpenEscrowResponse, error)
+	ConfirmEscrow(ctx context.Context, in *ConfirmEscrowRequest, opts ...grpc.CallOption) (*ConfirmEscrowResponse, error)
+	DisputeEscrow(ctx context.Context, in *DisputeEscrowRequest, opts ...grpc.CallOption) (*DisputeEscrowResponse, error)
+	CancelEscrow(ctx context.Context, in *CancelEscrowRequest, opts ...grpc.CallOption) (*CancelEscrowResponse, error)
+	ResolveEscrow(ctx context.Context, in *ResolveEscrowRequest, opts ...grpc.CallOption) (*ResolveEscrowResponse, error)
+	ListEscrows(ctx context.Context, in *ListEscrowsRequest, opts ...grpc.CallOption) (*ListEscrowsResponse, error)
+	SetFeeRule(ctx context.Context, in *SetFeeRuleRequest, opts ...grpc.CallOption) (*SetFeeRuleResponse, error)
+	ListFeeRules(ctx context.Context, in *ListFeeRulesRequest, opts ...grpc.CallOption) (*ListFeeRulesResponse, error)
+	DeleteFeeRule(ctx context.Context, in *DeleteFeeRuleRequest, opts ...grpc.CallOption) (*DeleteFeeRuleResponse, error)
+	CreateSymbol(ctx context.Context, in *CreateSymbolRequest, opts ...grpc.CallOption) (*CreateSymbolResponse, error)
+	UpdateSymbol(ctx context.Context, in *UpdateSymbolRequest, opts ...grpc.CallOption) (*UpdateSymbolResponse, error)
+	ListSymbols(ctx context.Context, in *ListSymbolsRequest, opts ...grpc.CallOption) (*ListSymbolsResponse, error)
+	ListRecords(ctx context.Context, in *ListRecordsRequest, opts ...grpc.CallOption) (*ListRecordsResponse, error)
+	ListDetails(ctx context.Context, in *ListDetailsRequest, opts ...grpc.CallOption) (*ListDetailsResponse, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	// over HTTP the statement is served as a file by GET /v1/download_statement
+	ExportStatement(ctx context.Context, in *ExportStatementRequest, opts ...grpc.CallOption) (AllegroTrade_ExportStatementClient, error)
+	EmailStatement(ctx context.Context, in *EmailStatementRequest, opts ...grpc.CallOption) (*EmailStatementResponse, error)
+	UpdateTraderStatus(ctx context.Context, in *UpdateTraderStatusRequest, opts ...grpc.CallOption) (*UpdateTraderStatusResponse, error)
+	SetTransferLimit(ctx context.Context, in *SetTransferLimitRequest, opts ...grpc.CallOption) (*SetTransferLimitResponse, error)
+	ListTransferLimits(ctx context.Context, in *ListTransferLimitsRequest, opts ...grpc.CallOption) (*ListTransferLimitsResponse, error)
+	DeleteTransferLimit(ctx context.Context, in *DeleteTransferLimitRequest, opts ...grpc.CallOption) (*DeleteTransferLimitResponse, error)
+	ListTransferReviews(ctx context.Context, in *ListTransferReviewsRequest, opts ...grpc.CallOption) (*ListTransferReviewsResponse, error)
+	ReviewTransfer(ctx context.Context, in *ReviewTransferRequest, opts ...grpc.CallOption) (*ReviewTransferResponse, error)
+	SubmitKYC(ctx context.Context, in *SubmitKYCRequest, opts ...grpc.CallOption) (*SubmitKYCResponse, error)
+	ListKYCSubmissions(ctx context.Context, in *ListKYCSubmissionsRequest, opts ...grpc.CallOption) (*ListKYCSubmissionsResponse, error)
+	ReviewKYCSubmission(ctx context.Context, in *ReviewKYCSubmissionRequest, opts ...grpc.CallOption) (*ReviewKYCSubmissionResponse, error)
+	GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error)
 }
 
 type allegroTradeClient struct {
@@ -79,6 +172,443 @@ func (c *allegroTradeClient) VerifyEmail(ctx context.Context, in *VerifyEmailReq
 	return out, nil
 }
 
+func (c *allegroTradeClient) CreateTrader(ctx context.Context, in *CreateTraderRequest, opts ...grpc.CallOption) (*CreateTraderResponse, error) {
+	out := new(CreateTraderResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_CreateTrader_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) GetTrader(ctx context.Context, in *GetTraderRequest, opts ...grpc.CallOption) (*GetTraderResponse, error) {
+	out := new(GetTraderResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_GetTrader_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ListTraders(ctx context.Context, in *ListTradersRequest, opts ...grpc.CallOption) (*ListTradersResponse, error) {
+	out := new(ListTradersResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ListTraders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*CreateTransferResponse, error) {
+	out := new(CreateTransferResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_CreateTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error) {
+	out := new(PlaceOrderResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_PlaceOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_CancelOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) UpdateOverdraftLimit(ctx context.Context, in *UpdateOverdraftLimitRequest, opts ...grpc.CallOption) (*UpdateOverdraftLimitResponse, error) {
+	out := new(UpdateOverdraftLimitResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_UpdateOverdraftLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) CreateDeposit(ctx context.Context, in *CreateDepositRequest, opts ...grpc.CallOption) (*CreateDepositResponse, error) {
+	out := new(CreateDepositResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_CreateDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) CreateWithdrawal(ctx context.Context, in *CreateWithdrawalRequest, opts ...grpc.CallOption) (*CreateWithdrawalResponse, error) {
+	out := new(CreateWithdrawalResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_CreateWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ReviewWithdrawal(ctx context.Context, in *ReviewWithdrawalRequest, opts ...grpc.CallOption) (*ReviewWithdrawalResponse, error) {
+	out := new(ReviewWithdrawalResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ReviewWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ListWithdrawals(ctx context.Context, in *ListWithdrawalsRequest, opts ...grpc.CallOption) (*ListWithdrawalsResponse, error) {
+	out := new(ListWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ListWithdrawals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ReverseRecord(ctx context.Context, in *ReverseRecordRequest, opts ...grpc.CallOption) (*ReverseRecordResponse, error) {
+	out := new(ReverseRecordResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ReverseRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) CreateScheduledTransfer(ctx context.Context, in *CreateScheduledTransferRequest, opts ...grpc.CallOption) (*CreateScheduledTransferResponse, error) {
+	out := new(CreateScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_CreateScheduledTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) GetScheduledTransfer(ctx context.Context, in *GetScheduledTransferRequest, opts ...grpc.CallOption) (*GetScheduledTransferResponse, error) {
+	out := new(GetScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_GetScheduledTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ListScheduledTransfers(ctx context.Context, in *ListScheduledTransfersRequest, opts ...grpc.CallOption) (*ListScheduledTransfersResponse, error) {
+	out := new(ListScheduledTransfersResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ListScheduledTransfers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) UpdateScheduledTransfer(ctx context.Context, in *UpdateScheduledTransferRequest, opts ...grpc.CallOption) (*UpdateScheduledTransferResponse, error) {
+	out := new(UpdateScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_UpdateScheduledTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) DeleteScheduledTransfer(ctx context.Context, in *DeleteScheduledTransferRequest, opts ...grpc.CallOption) (*DeleteScheduledTransferResponse, error) {
+	out := new(DeleteScheduledTransferResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_DeleteScheduledTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ListScheduledTransferRuns(ctx context.Context, in *ListScheduledTransferRunsRequest, opts ...grpc.CallOption) (*ListScheduledTransferRunsResponse, error) {
+	out := new(ListScheduledTransferRunsResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ListScheduledTransferRuns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) CreateBatchTransfer(ctx context.Context, in *CreateBatchTransferRequest, opts ...grpc.CallOption) (*CreateBatchTransferResponse, error) {
+	out := new(CreateBatchTransferResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_CreateBatchTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) OpenEscrow(ctx context.Context, in *OpenEscrowRequest, opts ...grpc.CallOption) (*OpenEscrowResponse, error) {
+	out := new(OpenEscrowResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_OpenEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ConfirmEscrow(ctx context.Context, in *ConfirmEscrowRequest, opts ...grpc.CallOption) (*ConfirmEscrowResponse, error) {
+	out := new(ConfirmEscrowResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ConfirmEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) DisputeEscrow(ctx context.Context, in *DisputeEscrowRequest, opts ...grpc.CallOption) (*DisputeEscrowResponse, error) {
+	out := new(DisputeEscrowResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_DisputeEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) CancelEscrow(ctx context.Context, in *CancelEscrowRequest, opts ...grpc.CallOption) (*CancelEscrowResponse, error) {
+	out := new(CancelEscrowResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_CancelEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ResolveEscrow(ctx context.Context, in *ResolveEscrowRequest, opts ...grpc.CallOption) (*ResolveEscrowResponse, error) {
+	out := new(ResolveEscrowResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ResolveEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ListEscrows(ctx context.Context, in *ListEscrowsRequest, opts ...grpc.CallOption) (*ListEscrowsResponse, error) {
+	out := new(ListEscrowsResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ListEscrows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) SetFeeRule(ctx context.Context, in *SetFeeRuleRequest, opts ...grpc.CallOption) (*SetFeeRuleResponse, error) {
+	out := new(SetFeeRuleResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_SetFeeRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ListFeeRules(ctx context.Context, in *ListFeeRulesRequest, opts ...grpc.CallOption) (*ListFeeRulesResponse, error) {
+	out := new(ListFeeRulesResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ListFeeRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) DeleteFeeRule(ctx context.Context, in *DeleteFeeRuleRequest, opts ...grpc.CallOption) (*DeleteFeeRuleResponse, error) {
+	out := new(DeleteFeeRuleResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_DeleteFeeRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) CreateSymbol(ctx context.Context, in *CreateSymbolRequest, opts ...grpc.CallOption) (*CreateSymbolResponse, error) {
+	out := new(CreateSymbolResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_CreateSymbol_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) UpdateSymbol(ctx context.Context, in *UpdateSymbolRequest, opts ...grpc.CallOption) (*UpdateSymbolResponse, error) {
+	out := new(UpdateSymbolResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_UpdateSymbol_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ListSymbols(ctx context.Context, in *ListSymbolsRequest, opts ...grpc.CallOption) (*ListSymbolsResponse, error) {
+	out := new(ListSymbolsResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ListSymbols_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ListRecords(ctx context.Context, in *ListRecordsRequest, opts ...grpc.CallOption) (*ListRecordsResponse, error) {
+	out := new(ListRecordsResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ListRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ListDetails(ctx context.Context, in *ListDetailsRequest, opts ...grpc.CallOption) (*ListDetailsResponse, error) {
+	out := new(ListDetailsResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ListDetails_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ListTransactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ExportStatement(ctx context.Context, in *ExportStatementRequest, opts ...grpc.CallOption) (AllegroTrade_ExportStatementClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AllegroTrade_ServiceDesc.Streams[0], AllegroTrade_ExportStatement_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &allegroTradeExportStatementClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AllegroTrade_ExportStatementClient interface {
+	Recv() (*ExportStatementResponse, error)
+	grpc.ClientStream
+}
+
+type allegroTradeExportStatementClient struct {
+	grpc.ClientStream
+}
+
+func (x *allegroTradeExportStatementClient) Recv() (*ExportStatementResponse, error) {
+	m := new(ExportStatementResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *allegroTradeClient) EmailStatement(ctx context.Context, in *EmailStatementRequest, opts ...grpc.CallOption) (*EmailStatementResponse, error) {
+	out := new(EmailStatementResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_EmailStatement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) UpdateTraderStatus(ctx context.Context, in *UpdateTraderStatusRequest, opts ...grpc.CallOption) (*UpdateTraderStatusResponse, error) {
+	out := new(UpdateTraderStatusResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_UpdateTraderStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) SetTransferLimit(ctx context.Context, in *SetTransferLimitRequest, opts ...grpc.CallOption) (*SetTransferLimitResponse, error) {
+	out := new(SetTransferLimitResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_SetTransferLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ListTransferLimits(ctx context.Context, in *ListTransferLimitsRequest, opts ...grpc.CallOption) (*ListTransferLimitsResponse, error) {
+	out := new(ListTransferLimitsResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ListTransferLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) DeleteTransferLimit(ctx context.Context, in *DeleteTransferLimitRequest, opts ...grpc.CallOption) (*DeleteTransferLimitResponse, error) {
+	out := new(DeleteTransferLimitResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_DeleteTransferLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ListTransferReviews(ctx context.Context, in *ListTransferReviewsRequest, opts ...grpc.CallOption) (*ListTransferReviewsResponse, error) {
+	out := new(ListTransferReviewsResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ListTransferReviews_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ReviewTransfer(ctx context.Context, in *ReviewTransferRequest, opts ...grpc.CallOption) (*ReviewTransferResponse, error) {
+	out := new(ReviewTransferResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ReviewTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) SubmitKYC(ctx context.Context, in *SubmitKYCRequest, opts ...grpc.CallOption) (*SubmitKYCResponse, error) {
+	out := new(SubmitKYCResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_SubmitKYC_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ListKYCSubmissions(ctx context.Context, in *ListKYCSubmissionsRequest, opts ...grpc.CallOption) (*ListKYCSubmissionsResponse, error) {
+	out := new(ListKYCSubmissionsResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ListKYCSubmissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) ReviewKYCSubmission(ctx context.Context, in *ReviewKYCSubmissionRequest, opts ...grpc.CallOption) (*ReviewKYCSubmissionResponse, error) {
+	out := new(ReviewKYCSubmissionResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_ReviewKYCSubmission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *allegroTradeClient) GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error) {
+	out := new(GetQuoteResponse)
+	err := c.cc.Invoke(ctx, AllegroTrade_GetQuote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AllegroTradeServer is the server API for AllegroTrade service.
 // All implementations must embed UnimplementedAllegroTradeServer
 // for forward compatibility
@@ -87,106 +617,1122 @@ type AllegroTradeServer interface {
 	UpdateMember(context.Context, *UpdateMemberRequest) (*UpdateMemberResponse, error)
 	LoginMember(context.Context, *LoginMemberRequest) (*LoginMemberResponse, error)
 	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	CreateTrader(context.Context, *CreateTraderRequest) (*CreateTraderResponse, error)
+	GetTrader(context.Context, *GetTraderRequest) (*GetTraderResponse, error)
+	ListTraders(context.Context, *ListTradersRequest) (*ListTradersResponse, error)
+	CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error)
+	PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	UpdateOverdraftLimit(context.Context, *UpdateOverdraftLimitRequest) (*UpdateOverdraftLimitResponse, error)
+	CreateDeposit(context.Context, *CreateDepositRequest) (*CreateDepositResponse, error)
+	CreateWithdrawal(context.Context, *CreateWithdrawalRequest) (*CreateWithdrawalResponse, error)
+	ReviewWithdrawal(context.Context, *ReviewWithdrawalRequest) (*ReviewWithdrawalResponse, error)
+	ListWithdrawals(context.Context, *ListWithdrawalsRequest) (*ListWithdrawalsResponse, error)
+	ReverseRecord(context.Context, *ReverseRecordRequest) (*ReverseRecordResponse, error)
+	CreateScheduledTransfer(context.Context, *CreateScheduledTransferRequest) (*CreateScheduledTransferResponse, error)
+	GetScheduledTransfer(context.Context, *GetScheduledTransferRequest) (*GetScheduledTransferResponse, error)
+	ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error)
+	UpdateScheduledTransfer(context.Context, *UpdateScheduledTransferRequest) (*UpdateScheduledTransferResponse, error)
+	DeleteScheduledTransfer(context.Context, *DeleteScheduledTransferRequest) (*DeleteScheduledTransferResponse, error)
+	ListScheduledTransferRuns(context.Context, *ListScheduledTransferRunsRequest) (*ListScheduledTransferRunsResponse, error)
+	CreateBatchTransfer(context.Context, *CreateBatchTransferRequest) (*CreateBatchTransferResponse, error)
+	OpenEscrow(context.Context, *OpenEscrowRequest) (*OpenEscrowResponse, error)
+	ConfirmEscrow(context.Context, *ConfirmEscrowRequest) (*ConfirmEscrowResponse, error)
+	DisputeEscrow(context.Context, *DisputeEscrowRequest) (*DisputeEscrowResponse, error)
+	CancelEscrow(context.Context, *CancelEscrowRequest) (*CancelEscrowResponse, error)
+	ResolveEscrow(context.Context, *ResolveEscrowRequest) (*ResolveEscrowResponse, error)
+	ListEscrows(context.Context, *ListEscrowsRequest) (*ListEscrowsResponse, error)
+	SetFeeRule(context.Context, *SetFeeRuleRequest) (*SetFeeRuleResponse, error)
+	ListFeeRules(context.Context, *ListFeeRulesRequest) (*ListFeeRulesResponse, error)
+	DeleteFeeRule(context.Context, *DeleteFeeRuleRequest) (*DeleteFeeRuleResponse, error)
+	CreateSymbol(context.Context, *CreateSymbolRequest) (*CreateSymbolResponse, error)
+	UpdateSymbol(context.Context, *UpdateSymbolRequest) (*UpdateSymbolResponse, error)
+	ListSymbols(context.Context, *ListSymbolsRequest) (*ListSymbolsResponse, error)
+	ListRecords(context.Context, *ListRecordsRequest) (*ListRecordsResponse, error)
+	ListDetails(context.Context, *ListDetailsRequest) (*ListDetailsResponse, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	// over HTTP the statement is served as a file by GET /v1/download_statement
+	ExportStatement(*ExportStatementRequest, AllegroTrade_ExportStatementServer) error
+	EmailStatement(context.Context, *EmailStatementRequest) (*EmailStatementResponse, error)
+	UpdateTraderStatus(context.Context, *UpdateTraderStatusRequest) (*UpdateTraderStatusResponse, error)
+	SetTransferLimit(context.Context, *SetTransferLimitRequest) (*SetTransferLimitResponse, error)
+	ListTransferLimits(context.Context, *ListTransferLimitsRequest) (*ListTransferLimitsResponse, error)
+	DeleteTransferLimit(context.Context, *DeleteTransferLimitRequest) (*DeleteTransferLimitResponse, error)
+	ListTransferReviews(context.Context, *ListTransferReviewsRequest) (*ListTransferReviewsResponse, error)
+	ReviewTransfer(context.Context, *ReviewTransferRequest) (*ReviewTransferResponse, error)
+	SubmitKYC(context.Context, *SubmitKYCRequest) (*SubmitKYCResponse, error)
+	ListKYCSubmissions(context.Context, *ListKYCSubmissionsRequest) (*ListKYCSubmissionsResponse, error)
+	ReviewKYCSubmission(context.Context, *ReviewKYCSubmissionRequest) (*ReviewKYCSubmissionResponse, error)
+	GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error)
+	mustEmbedUnimplementedAllegroTradeServer()
+}
+
+// UnimplementedAllegroTradeServer must be embedded to have forward compatible implementations.
+type UnimplementedAllegroTradeServer struct {
+}
+
+func (UnimplementedAllegroTradeServer) CreateMember(context.Context, *CreateMemberRequest) (*CreateMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMember not implemented")
+}
+func (UnimplementedAllegroTradeServer) UpdateMember(context.Context, *UpdateMemberRequest) (*UpdateMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMember not implemented")
+}
+func (UnimplementedAllegroTradeServer) LoginMember(context.Context, *LoginMemberRequest) (*LoginMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginMember not implemented")
+}
+func (UnimplementedAllegroTradeServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedAllegroTradeServer) CreateTrader(context.Context, *CreateTraderRequest) (*CreateTraderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrader not implemented")
+}
+func (UnimplementedAllegroTradeServer) GetTrader(context.Context, *GetTraderRequest) (*GetTraderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrader not implemented")
+}
+func (UnimplementedAllegroTradeServer) ListTraders(context.Context, *ListTradersRequest) (*ListTradersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTraders not implemented")
+}
+func (UnimplementedAllegroTradeServer) CreateTransfer(context.Context, *CreateTransferRequest) (*CreateTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (UnimplementedAllegroTradeServer) PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (UnimplementedAllegroTradeServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedAllegroTradeServer) UpdateOverdraftLimit(context.Context, *UpdateOverdraftLimitRequest) (*UpdateOverdraftLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOverdraftLimit not implemented")
+}
+func (UnimplementedAllegroTradeServer) CreateDeposit(context.Context, *CreateDepositRequest) (*CreateDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDeposit not implemented")
+}
+func (UnimplementedAllegroTradeServer) CreateWithdrawal(context.Context, *CreateWithdrawalRequest) (*CreateWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWithdrawal not implemented")
+}
+func (UnimplementedAllegroTradeServer) ReviewWithdrawal(context.Context, *ReviewWithdrawalRequest) (*ReviewWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewWithdrawal not implemented")
+}
+func (UnimplementedAllegroTradeServer) ListWithdrawals(context.Context, *ListWithdrawalsRequest) (*ListWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWithdrawals not implemented")
+}
+func (UnimplementedAllegroTradeServer) ReverseRecord(context.Context, *ReverseRecordRequest) (*ReverseRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseRecord not implemented")
+}
+func (UnimplementedAllegroTradeServer) CreateScheduledTransfer(context.Context, *CreateScheduledTransferRequest) (*CreateScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledTransfer not implemented")
+}
+func (UnimplementedAllegroTradeServer) GetScheduledTransfer(context.Context, *GetScheduledTransferRequest) (*GetScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledTransfer not implemented")
+}
+func (UnimplementedAllegroTradeServer) ListScheduledTransfers(context.Context, *ListScheduledTransfersRequest) (*ListScheduledTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTransfers not implemented")
+}
+func (UnimplementedAllegroTradeServer) UpdateScheduledTransfer(context.Context, *UpdateScheduledTransferRequest) (*UpdateScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledTransfer not implemented")
+}
+func (UnimplementedAllegroTradeServer) DeleteScheduledTransfer(context.Context, *DeleteScheduledTransferRequest) (*DeleteScheduledTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduledTransfer not implemented")
+}
+func (UnimplementedAllegroTradeServer) ListScheduledTransferRuns(context.Context, *ListScheduledTransferRunsRequest) (*ListScheduledTransferRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTransferRuns not implemented")
+}
+func (UnimplementedAllegroTradeServer) CreateBatchTransfer(context.Context, *CreateBatchTransferRequest) (*CreateBatchTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBatchTransfer not implemented")
+}
+func (UnimplementedAllegroTradeServer) OpenEscrow(context.Context, *OpenEscrowRequest) (*OpenEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenEscrow not implemented")
+}
+func (UnimplementedAllegroTradeServer) ConfirmEscrow(context.Context, *ConfirmEscrowRequest) (*ConfirmEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEscrow not implemented")
+}
+func (UnimplementedAllegroTradeServer) DisputeEscrow(context.Context, *DisputeEscrowRequest) (*DisputeEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeEscrow not implemented")
+}
+func (UnimplementedAllegroTradeServer) CancelEscrow(context.Context, *CancelEscrowRequest) (*CancelEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEscrow not implemented")
+}
+func (UnimplementedAllegroTradeServer) ResolveEscrow(context.Context, *ResolveEscrowRequest) (*ResolveEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveEscrow not implemented")
+}
+func (UnimplementedAllegroTradeServer) ListEscrows(context.Context, *ListEscrowsRequest) (*ListEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEscrows not implemented")
+}
+func (UnimplementedAllegroTradeServer) SetFeeRule(context.Context, *SetFeeRuleRequest) (*SetFeeRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeRule not implemented")
+}
+func (UnimplementedAllegroTradeServer) ListFeeRules(context.Context, *ListFeeRulesRequest) (*ListFeeRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeeRules not implemented")
+}
+func (UnimplementedAllegroTradeServer) DeleteFeeRule(context.Context, *DeleteFeeRuleRequest) (*DeleteFeeRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeeRule not implemented")
+}
+func (UnimplementedAllegroTradeServer) CreateSymbol(context.Context, *CreateSymbolRequest) (*CreateSymbolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSymbol not implemented")
+}
+func (UnimplementedAllegroTradeServer) UpdateSymbol(context.Context, *UpdateSymbolRequest) (*UpdateSymbolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSymbol not implemented")
+}
+func (UnimplementedAllegroTradeServer) ListSymbols(context.Context, *ListSymbolsRequest) (*ListSymbolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSymbols not implemented")
+}
+func (UnimplementedAllegroTradeServer) ListRecords(context.Context, *ListRecordsRequest) (*ListRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecords not implemented")
+}
+func (UnimplementedAllegroTradeServer) ListDetails(context.Context, *ListDetailsRequest) (*ListDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDetails not implemented")
+}
+func (UnimplementedAllegroTradeServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedAllegroTradeServer) ExportStatement(*ExportStatementRequest, AllegroTrade_ExportStatementServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportStatement not implemented")
+}
+func (UnimplementedAllegroTradeServer) EmailStatement(context.Context, *EmailStatementRequest) (*EmailStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmailStatement not implemented")
+}
+func (UnimplementedAllegroTradeServer) UpdateTraderStatus(context.Context, *UpdateTraderStatusRequest) (*UpdateTraderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTraderStatus not implemented")
+}
+func (UnimplementedAllegroTradeServer) SetTransferLimit(context.Context, *SetTransferLimitRequest) (*SetTransferLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferLimit not implemented")
+}
+func (UnimplementedAllegroTradeServer) ListTransferLimits(context.Context, *ListTransferLimitsRequest) (*ListTransferLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransferLimits not implemented")
+}
+func (UnimplementedAllegroTradeServer) DeleteTransferLimit(context.Context, *DeleteTransferLimitRequest) (*DeleteTransferLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransferLimit not implemented")
+}
+func (UnimplementedAllegroTradeServer) ListTransferReviews(context.Context, *ListTransferReviewsRequest) (*ListTransferReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransferReviews not implemented")
+}
+func (UnimplementedAllegroTradeServer) ReviewTransfer(context.Context, *ReviewTransferRequest) (*ReviewTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewTransfer not implemented")
+}
+func (UnimplementedAllegroTradeServer) SubmitKYC(context.Context, *SubmitKYCRequest) (*SubmitKYCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitKYC not implemented")
+}
+func (UnimplementedAllegroTradeServer) ListKYCSubmissions(context.Context, *ListKYCSubmissionsRequest) (*ListKYCSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKYCSubmissions not implemented")
+}
+func (UnimplementedAllegroTradeServer) ReviewKYCSubmission(context.Context, *ReviewKYCSubmissionRequest) (*ReviewKYCSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewKYCSubmission not implemented")
+}
+func (UnimplementedAllegroTradeServer) GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuote not implemented")
+}
+func (UnimplementedAllegroTradeServer) mustEmbedUnimplementedAllegroTradeServer() {}
+
+// UnsafeAllegroTradeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AllegroTradeServer will
+// result in compilation errors.
+type UnsafeAllegroTradeServer interface {
 	mustEmbedUnimplementedAllegroTradeServer()
 }
 
-// UnimplementedAllegroTradeServer must be embedded to have forward compatible implementations.
-type UnimplementedAllegroTradeServer struct {
+func RegisterAllegroTradeServer(s grpc.ServiceRegistrar, srv AllegroTradeServer) {
+	s.RegisterService(&AllegroTrade_ServiceDesc, srv)
+}
+
+func _AllegroTrade_CreateMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).CreateMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_CreateMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).CreateMember(ctx, req.(*CreateMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_UpdateMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).UpdateMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_UpdateMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).UpdateMember(ctx, req.(*UpdateMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_LoginMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).LoginMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_LoginMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).LoginMember(ctx, req.(*LoginMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_CreateTrader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTraderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).CreateTrader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_CreateTrader_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).CreateTrader(ctx, req.(*CreateTraderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_GetTrader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTraderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).GetTrader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_GetTrader_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).GetTrader(ctx, req.(*GetTraderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ListTraders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTradersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ListTraders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ListTraders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ListTraders(ctx, req.(*ListTradersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).CreateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_CreateTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).CreateTransfer(ctx, req.(*CreateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_PlaceOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).PlaceOrder(ctx, req.(*PlaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_UpdateOverdraftLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOverdraftLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).UpdateOverdraftLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_UpdateOverdraftLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).UpdateOverdraftLimit(ctx, req.(*UpdateOverdraftLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_CreateDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).CreateDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_CreateDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).CreateDeposit(ctx, req.(*CreateDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_CreateWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).CreateWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_CreateWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).CreateWithdrawal(ctx, req.(*CreateWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ReviewWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ReviewWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ReviewWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ReviewWithdrawal(ctx, req.(*ReviewWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ListWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ListWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ListWithdrawals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ListWithdrawals(ctx, req.(*ListWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ReverseRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ReverseRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ReverseRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ReverseRecord(ctx, req.(*ReverseRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_CreateScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).CreateScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_CreateScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).CreateScheduledTransfer(ctx, req.(*CreateScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_GetScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).GetScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_GetScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).GetScheduledTransfer(ctx, req.(*GetScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ListScheduledTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ListScheduledTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ListScheduledTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ListScheduledTransfers(ctx, req.(*ListScheduledTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_UpdateScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).UpdateScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_UpdateScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).UpdateScheduledTransfer(ctx, req.(*UpdateScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_DeleteScheduledTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduledTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).DeleteScheduledTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_DeleteScheduledTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).DeleteScheduledTransfer(ctx, req.(*DeleteScheduledTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ListScheduledTransferRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransferRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ListScheduledTransferRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ListScheduledTransferRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ListScheduledTransferRuns(ctx, req.(*ListScheduledTransferRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_CreateBatchTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBatchTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).CreateBatchTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_CreateBatchTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).CreateBatchTransfer(ctx, req.(*CreateBatchTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_OpenEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).OpenEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_OpenEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).OpenEscrow(ctx, req.(*OpenEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ConfirmEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ConfirmEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ConfirmEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ConfirmEscrow(ctx, req.(*ConfirmEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_DisputeEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisputeEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).DisputeEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_DisputeEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).DisputeEscrow(ctx, req.(*DisputeEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_CancelEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).CancelEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_CancelEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).CancelEscrow(ctx, req.(*CancelEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ResolveEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ResolveEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ResolveEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ResolveEscrow(ctx, req.(*ResolveEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ListEscrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEscrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ListEscrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ListEscrows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ListEscrows(ctx, req.(*ListEscrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_SetFeeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).SetFeeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_SetFeeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).SetFeeRule(ctx, req.(*SetFeeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ListFeeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeeRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ListFeeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ListFeeRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ListFeeRules(ctx, req.(*ListFeeRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_DeleteFeeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).DeleteFeeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_DeleteFeeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).DeleteFeeRule(ctx, req.(*DeleteFeeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_CreateSymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).CreateSymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_CreateSymbol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).CreateSymbol(ctx, req.(*CreateSymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_UpdateSymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).UpdateSymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_UpdateSymbol_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).UpdateSymbol(ctx, req.(*UpdateSymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ListSymbols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSymbolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ListSymbols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ListSymbols_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ListSymbols(ctx, req.(*ListSymbolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ListRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ListRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ListRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ListRecords(ctx, req.(*ListRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ListDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ListDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ListDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ListDetails(ctx, req.(*ListDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedAllegroTradeServer) CreateMember(context.Context, *CreateMemberRequest) (*CreateMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMember not implemented")
+func _AllegroTrade_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAllegroTradeServer) UpdateMember(context.Context, *UpdateMemberRequest) (*UpdateMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMember not implemented")
+
+func _AllegroTrade_ExportStatement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportStatementRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AllegroTradeServer).ExportStatement(m, &allegroTradeExportStatementServer{stream})
 }
-func (UnimplementedAllegroTradeServer) LoginMember(context.Context, *LoginMemberRequest) (*LoginMemberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginMember not implemented")
+
+type AllegroTrade_ExportStatementServer interface {
+	Send(*ExportStatementResponse) error
+	grpc.ServerStream
 }
-func (UnimplementedAllegroTradeServer) VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+
+type allegroTradeExportStatementServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedAllegroTradeServer) mustEmbedUnimplementedAllegroTradeServer() {}
 
-// UnsafeAllegroTradeServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AllegroTradeServer will
-// result in compilation errors.
-type UnsafeAllegroTradeServer interface {
-	mustEmbedUnimplementedAllegroTradeServer()
+func (x *allegroTradeExportStatementServer) Send(m *ExportStatementResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func RegisterAllegroTradeServer(s grpc.ServiceRegistrar, srv AllegroTradeServer) {
-	s.RegisterService(&AllegroTrade_ServiceDesc, srv)
+func _AllegroTrade_EmailStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).EmailStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_EmailStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).EmailStatement(ctx, req.(*EmailStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AllegroTrade_CreateMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMemberRequest)
+func _AllegroTrade_UpdateTraderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTraderStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AllegroTradeServer).CreateMember(ctx, in)
+		return srv.(AllegroTradeServer).UpdateTraderStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AllegroTrade_CreateMember_FullMethodName,
+		FullMethod: AllegroTrade_UpdateTraderStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AllegroTradeServer).CreateMember(ctx, req.(*CreateMemberRequest))
+		return srv.(AllegroTradeServer).UpdateTraderStatus(ctx, req.(*UpdateTraderStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AllegroTrade_UpdateMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMemberRequest)
+func _AllegroTrade_SetTransferLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransferLimitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AllegroTradeServer).UpdateMember(ctx, in)
+		return srv.(AllegroTradeServer).SetTransferLimit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AllegroTrade_UpdateMember_FullMethodName,
+		FullMethod: AllegroTrade_SetTransferLimit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AllegroTradeServer).UpdateMember(ctx, req.(*UpdateMemberRequest))
+		return srv.(AllegroTradeServer).SetTransferLimit(ctx, req.(*SetTransferLimitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AllegroTrade_LoginMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginMemberRequest)
+func _AllegroTrade_ListTransferLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransferLimitsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AllegroTradeServer).LoginMember(ctx, in)
+		return srv.(AllegroTradeServer).ListTransferLimits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AllegroTrade_LoginMember_FullMethodName,
+		FullMethod: AllegroTrade_ListTransferLimits_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AllegroTradeServer).LoginMember(ctx, req.(*LoginMemberRequest))
+		return srv.(AllegroTradeServer).ListTransferLimits(ctx, req.(*ListTransferLimitsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AllegroTrade_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyEmailRequest)
+func _AllegroTrade_DeleteTransferLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransferLimitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AllegroTradeServer).VerifyEmail(ctx, in)
+		return srv.(AllegroTradeServer).DeleteTransferLimit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AllegroTrade_VerifyEmail_FullMethodName,
+		FullMethod: AllegroTrade_DeleteTransferLimit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AllegroTradeServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
+		return srv.(AllegroTradeServer).DeleteTransferLimit(ctx, req.(*DeleteTransferLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ListTransferReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransferReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ListTransferReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ListTransferReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ListTransferReviews(ctx, req.(*ListTransferReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ReviewTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ReviewTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ReviewTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ReviewTransfer(ctx, req.(*ReviewTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_SubmitKYC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitKYCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).SubmitKYC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_SubmitKYC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).SubmitKYC(ctx, req.(*SubmitKYCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ListKYCSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKYCSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ListKYCSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ListKYCSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ListKYCSubmissions(ctx, req.(*ListKYCSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_ReviewKYCSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewKYCSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).ReviewKYCSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_ReviewKYCSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).ReviewKYCSubmission(ctx, req.(*ReviewKYCSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AllegroTrade_GetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AllegroTradeServer).GetQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AllegroTrade_GetQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AllegroTradeServer).GetQuote(ctx, req.(*GetQuoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -214,7 +1760,193 @@ var AllegroTrade_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyEmail",
 			Handler:    _AllegroTrade_VerifyEmail_Handler,
 		},
+		{
+			MethodName: "CreateTrader",
+			Handler:    _AllegroTrade_CreateTrader_Handler,
+		},
+		{
+			MethodName: "GetTrader",
+			Handler:    _AllegroTrade_GetTrader_Handler,
+		},
+		{
+			MethodName: "ListTraders",
+			Handler:    _AllegroTrade_ListTraders_Handler,
+		},
+		{
+			MethodName: "CreateTransfer",
+			Handler:    _AllegroTrade_CreateTransfer_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _AllegroTrade_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _AllegroTrade_CancelOrder_Handler,
+		},
+		{
+			MethodName: "UpdateOverdraftLimit",
+			Handler:    _AllegroTrade_UpdateOverdraftLimit_Handler,
+		},
+		{
+			MethodName: "CreateDeposit",
+			Handler:    _AllegroTrade_CreateDeposit_Handler,
+		},
+		{
+			MethodName: "CreateWithdrawal",
+			Handler:    _AllegroTrade_CreateWithdrawal_Handler,
+		},
+		{
+			MethodName: "ReviewWithdrawal",
+			Handler:    _AllegroTrade_ReviewWithdrawal_Handler,
+		},
+		{
+			MethodName: "ListWithdrawals",
+			Handler:    _AllegroTrade_ListWithdrawals_Handler,
+		},
+		{
+			MethodName: "ReverseRecord",
+			Handler:    _AllegroTrade_ReverseRecord_Handler,
+		},
+		{
+			MethodName: "CreateScheduledTransfer",
+			Handler:    _AllegroTrade_CreateScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "GetScheduledTransfer",
+			Handler:    _AllegroTrade_GetScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransfers",
+			Handler:    _AllegroTrade_ListScheduledTransfers_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledTransfer",
+			Handler:    _AllegroTrade_UpdateScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "DeleteScheduledTransfer",
+			Handler:    _AllegroTrade_DeleteScheduledTransfer_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransferRuns",
+			Handler:    _AllegroTrade_ListScheduledTransferRuns_Handler,
+		},
+		{
+			MethodName: "CreateBatchTransfer",
+			Handler:    _AllegroTrade_CreateBatchTransfer_Handler,
+		},
+		{
+			MethodName: "OpenEscrow",
+			Handler:    _AllegroTrade_OpenEscrow_Handler,
+		},
+		{
+			MethodName: "ConfirmEscrow",
+			Handler:    _AllegroTrade_ConfirmEscrow_Handler,
+		},
+		{
+			MethodName: "DisputeEscrow",
+			Handler:    _AllegroTrade_DisputeEscrow_Handler,
+		},
+		{
+			MethodName: "CancelEscrow",
+			Handler:    _AllegroTrade_CancelEscrow_Handler,
+		},
+		{
+			MethodName: "ResolveEscrow",
+			Handler:    _AllegroTrade_ResolveEscrow_Handler,
+		},
+		{
+			MethodName: "ListEscrows",
+			Handler:    _AllegroTrade_ListEscrows_Handler,
+		},
+		{
+			MethodName: "SetFeeRule",
+			Handler:    _AllegroTrade_SetFeeRule_Handler,
+		},
+		{
+			MethodName: "ListFeeRules",
+			Handler:    _AllegroTrade_ListFeeRules_Handler,
+		},
+		{
+			MethodName: "DeleteFeeRule",
+			Handler:    _AllegroTrade_DeleteFeeRule_Handler,
+		},
+		{
+			MethodName: "CreateSymbol",
+			Handler:    _AllegroTrade_CreateSymbol_Handler,
+		},
+		{
+			MethodName: "UpdateSymbol",
+			Handler:    _AllegroTrade_UpdateSymbol_Handler,
+		},
+		{
+			MethodName: "ListSymbols",
+			Handler:    _AllegroTrade_ListSymbols_Handler,
+		},
+		{
+			MethodName: "ListRecords",
+			Handler:    _AllegroTrade_ListRecords_Handler,
+		},
+		{
+			MethodName: "ListDetails",
+			Handler:    _AllegroTrade_ListDetails_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _AllegroTrade_ListTransactions_Handler,
+		},
+		{
+			MethodName: "EmailStatement",
+			Handler:    _AllegroTrade_EmailStatement_Handler,
+		},
+		{
+			MethodName: "UpdateTraderStatus",
+			Handler:    _AllegroTrade_UpdateTraderStatus_Handler,
+		},
+		{
+			MethodName: "SetTransferLimit",
+			Handler:    _AllegroTrade_SetTransferLimit_Handler,
+		},
+		{
+			MethodName: "ListTransferLimits",
+			Handler:    _AllegroTrade_ListTransferLimits_Handler,
+		},
+		{
+			MethodName: "DeleteTransferLimit",
+			Handler:    _AllegroTrade_DeleteTransferLimit_Handler,
+		},
+		{
+			MethodName: "ListTransferReviews",
+			Handler:    _AllegroTrade_ListTransferReviews_Handler,
+		},
+		{
+			MethodName: "ReviewTransfer",
+			Handler:    _AllegroTrade_ReviewTransfer_Handler,
+		},
+		{
+			MethodName: "SubmitKYC",
+			Handler:    _AllegroTrade_SubmitKYC_Handler,
+		},
+		{
+			MethodName: "ListKYCSubmissions",
+			Handler:    _AllegroTrade_ListKYCSubmissions_Handler,
+		},
+		{
+			MethodName: "ReviewKYCSubmission",
+			Handler:    _AllegroTrade_ReviewKYCSubmission_Handler,
+		},
+		{
+			MethodName: "GetQuote",
+			Handler:    _AllegroTrade_GetQuote_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportStatement",
+			Handler:       _AllegroTrade_ExportStatement_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service_allegro_trade.proto",
 }
@@ -0,0 +1,68 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Cache keeps the last known price of each pair asked for, so quoting a price
+// does not cost a lookup every time. A price is looked up again once it is
+// older than the ttl; if that lookup fails, the last known price is served
+// rather than none. Historical prices do not change and go to the oracle.
+type Cache struct {
+	oracle Oracle
+	ttl    time.Duration
+	mu     sync.Mutex
+	prices map[pair]cachedPrice
+}
+
+type cachedPrice struct {
+	price       Price
+	fetchedTime time.Time
+}
+
+func NewCache(oracle Oracle, ttl time.Duration) *Cache {
+	return &Cache{
+		oracle: oracle,
+		ttl:    ttl,
+		prices: make(map[pair]cachedPrice),
+	}
+}
+
+func (cache *Cache) GetPrice(ctx context.Context, base string, quote string) (Price, error) {
+	key := pair{base: base, quote: quote}
+
+	cache.mu.Lock()
+	cached, ok := cache.prices[key]
+	cache.mu.Unlock()
+	if ok && time.Since(cached.fetchedTime) < cache.ttl {
+		return cached.price, nil
+	}
+
+	price, err := cache.oracle.GetPrice(ctx, base, quote)
+	if err != nil {
+		if !ok || errors.Is(err, ErrPriceNotFound) {
+			return Price{}, err
+		}
+
+		// only try the oracle again once the ttl has passed
+		log.Error().Err(err).Str("base", base).Str("quote", quote).Msg("get price err")
+		price = cached.price
+	}
+
+	cache.mu.Lock()
+	cache.prices[key] = cachedPrice{
+		price:       price,
+		fetchedTime: time.Now(),
+	}
+	cache.mu.Unlock()
+	return price, nil
+}
+
+func (cache *Cache) GetPriceAt(ctx context.Context, base string, quote string, at time.Time) (Price, error) {
+	return cache.oracle.GetPriceAt(ctx, base, quote, at)
+}
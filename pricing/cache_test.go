@@ -0,0 +1,66 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+// stubOracle answers with whatever it is set to and counts the lookups.
+type stubOracle struct {
+	price Price
+	err   error
+	calls int
+}
+
+func (oracle *stubOracle) GetPrice(ctx context.Context, base string, quote string) (Price, error) {
+	oracle.calls++
+	return oracle.price, oracle.err
+}
+
+func (oracle *stubOracle) GetPriceAt(ctx context.Context, base string, quote string, at time.Time) (Price, error) {
+	oracle.calls++
+	return oracle.price, oracle.err
+}
+
+func TestCacheServesFreshPrice(t *testing.T) {
+	oracle := &stubOracle{price: Price{Base: util.ETH, Quote: util.BTC, Rate: 5500000}}
+	cache := NewCache(oracle, time.Hour)
+
+	for i := 0; i < 3; i++ {
+		price, err := cache.GetPrice(context.Background(), util.ETH, util.BTC)
+		require.NoError(t, err)
+		require.Equal(t, int64(5500000), price.Rate)
+	}
+	require.Equal(t, 1, oracle.calls)
+
+	_, err := cache.GetPriceAt(context.Background(), util.ETH, util.BTC, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 2, oracle.calls)
+}
+
+func TestCacheKeepsLastKnownPrice(t *testing.T) {
+	oracle := &stubOracle{price: Price{Base: util.ETH, Quote: util.BTC, Rate: 5500000}}
+	cache := NewCache(oracle, 0)
+
+	_, err := cache.GetPrice(context.Background(), util.ETH, util.BTC)
+	require.NoError(t, err)
+
+	oracle.err = errors.New("feed down")
+	price, err := cache.GetPrice(context.Background(), util.ETH, util.BTC)
+	require.NoError(t, err)
+	require.Equal(t, int64(5500000), price.Rate)
+	require.Equal(t, 2, oracle.calls)
+
+	// a pair never seen has no last known price
+	_, err = cache.GetPrice(context.Background(), util.BTC, util.ETH)
+	require.Error(t, err)
+
+	oracle.err = ErrPriceNotFound
+	_, err = cache.GetPrice(context.Background(), util.ETH, util.BTC)
+	require.ErrorIs(t, err, ErrPriceNotFound)
+}
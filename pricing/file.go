@@ -0,0 +1,137 @@
+package pricing
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const FileSource = "file"
+
+type pair struct {
+	base  string
+	quote string
+}
+
+// FileOracle serves prices read from a local file, so prices are known without
+// any feed being reachable. A file ending in .json holds an array of objects
+// with base, quote, rate and time; any other file is CSV with a header line
+// and the columns base,quote,rate,time. Rates are decimal strings and times
+// are RFC 3339.
+type FileOracle struct {
+	// the history of each pair, oldest first
+	prices map[pair][]Price
+}
+
+// fileLine is one price of a JSON file.
+type fileLine struct {
+	Base  string    `json:"base"`
+	Quote string    `json:"quote"`
+	Rate  string    `json:"rate"`
+	Time  time.Time `json:"time"`
+}
+
+// NewFileOracle reads every price in a file.
+func NewFileOracle(path string) (*FileOracle, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open price file err: %w", err)
+	}
+	defer file.Close()
+
+	var lines []fileLine
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.NewDecoder(file).Decode(&lines)
+	} else {
+		lines, err = readCSV(file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read price file %s err: %w", path, err)
+	}
+
+	oracle := &FileOracle{
+		prices: make(map[pair][]Price),
+	}
+	for i, line := range lines {
+		rate, err := ParseRate(line.Rate)
+		if err != nil {
+			return nil, fmt.Errorf("price %d of %s err: %w", i+1, path, err)
+		}
+		if line.Base == "" || line.Quote == "" || line.Time.IsZero() {
+			return nil, fmt.Errorf("price %d of %s err: base, quote and time are required", i+1, path)
+		}
+
+		key := pair{base: line.Base, quote: line.Quote}
+		oracle.prices[key] = append(oracle.prices[key], Price{
+			Base:   line.Base,
+			Quote:  line.Quote,
+			Rate:   rate,
+			Source: FileSource,
+			Time:   line.Time,
+		})
+	}
+
+	for _, history := range oracle.prices {
+		sort.SliceStable(history, func(i, j int) bool {
+			return history[i].Time.Before(history[j].Time)
+		})
+	}
+	return oracle, nil
+}
+
+func readCSV(r io.Reader) ([]fileLine, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	lines := make([]fileLine, 0, len(records)-1)
+	// the first record is the header
+	for _, record := range records[1:] {
+		at, err := time.Parse(time.RFC3339, record[3])
+		if err != nil {
+			return nil, fmt.Errorf("line %d err: time should be RFC 3339", len(lines)+2)
+		}
+		lines = append(lines, fileLine{
+			Base:  record[0],
+			Quote: record[1],
+			Rate:  record[2],
+			Time:  at,
+		})
+	}
+	return lines, nil
+}
+
+func (oracle *FileOracle) GetPrice(ctx context.Context, base string, quote string) (Price, error) {
+	history := oracle.prices[pair{base: base, quote: quote}]
+	if len(history) == 0 {
+		return Price{}, ErrPriceNotFound
+	}
+	return history[len(history)-1], nil
+}
+
+func (oracle *FileOracle) GetPriceAt(ctx context.Context, base string, quote string, at time.Time) (Price, error) {
+	history := oracle.prices[pair{base: base, quote: quote}]
+	// the number of prices from no later than at
+	n := sort.Search(len(history), func(i int) bool {
+		return history[i].Time.After(at)
+	})
+	if n == 0 {
+		return Price{}, ErrPriceNotFound
+	}
+	return history[n-1], nil
+}
@@ -0,0 +1,113 @@
+package pricing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func writePriceFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestFileOracleCSV(t *testing.T) {
+	path := writePriceFile(t, "prices.csv", `base,quote,rate,time
+ETH,USDT,1900.5,2026-03-02T00:00:00Z
+ETH,USDT,1850,2026-03-01T00:00:00Z
+BTC,USDT,64000.12345678,2026-03-01T00:00:00Z
+`)
+
+	oracle, err := NewFileOracle(path)
+	require.NoError(t, err)
+
+	price, err := oracle.GetPrice(context.Background(), util.ETH, "USDT")
+	require.NoError(t, err)
+	require.Equal(t, "1900.5", FormatRate(price.Rate))
+	require.Equal(t, FileSource, price.Source)
+	require.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), price.Time)
+
+	price, err = oracle.GetPriceAt(context.Background(), util.ETH, "USDT", time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, "1850", FormatRate(price.Rate))
+
+	// a price is known from its time on
+	price, err = oracle.GetPriceAt(context.Background(), util.ETH, "USDT", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, "1900.5", FormatRate(price.Rate))
+
+	_, err = oracle.GetPriceAt(context.Background(), util.ETH, "USDT", time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC))
+	require.ErrorIs(t, err, ErrPriceNotFound)
+
+	price, err = oracle.GetPrice(context.Background(), util.BTC, "USDT")
+	require.NoError(t, err)
+	require.Equal(t, int64(6400012345678), price.Rate)
+
+	_, err = oracle.GetPrice(context.Background(), "USDT", util.ETH)
+	require.ErrorIs(t, err, ErrPriceNotFound)
+}
+
+func TestFileOracleJSON(t *testing.T) {
+	path := writePriceFile(t, "prices.json", `[
+  {"base": "ETH", "quote": "BTC", "rate": "0.055", "time": "2026-03-01T00:00:00Z"}
+]`)
+
+	oracle, err := NewFileOracle(path)
+	require.NoError(t, err)
+
+	price, err := oracle.GetPrice(context.Background(), util.ETH, util.BTC)
+	require.NoError(t, err)
+	require.Equal(t, int64(5500000), price.Rate)
+}
+
+func TestFileOracleInvalidFile(t *testing.T) {
+	testCases := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{
+			name:    "ZeroRate",
+			file:    "prices.csv",
+			content: "base,quote,rate,time\nETH,USDT,0,2026-03-01T00:00:00Z\n",
+		},
+		{
+			name:    "InvalidRate",
+			file:    "prices.csv",
+			content: "base,quote,rate,time\nETH,USDT,abc,2026-03-01T00:00:00Z\n",
+		},
+		{
+			name:    "InvalidTime",
+			file:    "prices.csv",
+			content: "base,quote,rate,time\nETH,USDT,1850,2026-03-01\n",
+		},
+		{
+			name:    "MissingColumn",
+			file:    "prices.csv",
+			content: "base,quote,rate,time\nETH,USDT,1850\n",
+		},
+		{
+			name:    "MissingQuote",
+			file:    "prices.json",
+			content: `[{"base": "ETH", "rate": "1850", "time": "2026-03-01T00:00:00Z"}]`,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewFileOracle(writePriceFile(t, tc.file, tc.content))
+			require.Error(t, err)
+		})
+	}
+
+	_, err := NewFileOracle(filepath.Join(t.TempDir(), "missing.csv"))
+	require.Error(t, err)
+}
@@ -0,0 +1,51 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YuanData/allegro-trade/money"
+)
+
+// RateDecimals is the precision rates are kept with, so a rate of 1850.5 is
+// 185050000000.
+const RateDecimals = 8
+
+var ErrPriceNotFound = errors.New("price not found")
+
+// Price is the rate of a base symbol in a quote symbol: how much of the quote
+// one whole base unit is worth at a time.
+type Price struct {
+	Base   string
+	Quote  string
+	Rate   int64
+	Source string
+	Time   time.Time
+}
+
+// Oracle tells the price of a symbol in another.
+type Oracle interface {
+	// GetPrice returns the latest price of base in quote.
+	GetPrice(ctx context.Context, base string, quote string) (Price, error)
+	// GetPriceAt returns the price of base in quote as it was at a time, which is
+	// the latest price from no later than that time.
+	GetPriceAt(ctx context.Context, base string, quote string, at time.Time) (Price, error)
+}
+
+// ParseRate converts a decimal rate such as "1850.5" into the units it is kept in.
+func ParseRate(value string) (int64, error) {
+	rate, err := money.Parse(value, RateDecimals, money.RoundHalfEven)
+	if err != nil {
+		return 0, err
+	}
+	if rate <= 0 {
+		return 0, errors.New("rate should be above zero")
+	}
+	return rate, nil
+}
+
+// FormatRate is the decimal string of a rate.
+func FormatRate(rate int64) string {
+	return money.Format(rate, RateDecimals)
+}
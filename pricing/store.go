@@ -0,0 +1,54 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+)
+
+// StoreOracle serves prices from the prices table, where any feed may write them.
+type StoreOracle struct {
+	store db.Querier
+}
+
+func NewStoreOracle(store db.Querier) *StoreOracle {
+	return &StoreOracle{
+		store: store,
+	}
+}
+
+func (oracle *StoreOracle) GetPrice(ctx context.Context, base string, quote string) (Price, error) {
+	price, err := oracle.store.GetLatestPrice(ctx, db.GetLatestPriceParams{
+		Base:  base,
+		Quote: quote,
+	})
+	return storedPrice(price, err)
+}
+
+func (oracle *StoreOracle) GetPriceAt(ctx context.Context, base string, quote string, at time.Time) (Price, error) {
+	price, err := oracle.store.GetPriceAt(ctx, db.GetPriceAtParams{
+		Base:   base,
+		Quote:  quote,
+		AtTime: at,
+	})
+	return storedPrice(price, err)
+}
+
+func storedPrice(price db.Price, err error) (Price, error) {
+	if err != nil {
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return Price{}, ErrPriceNotFound
+		}
+		return Price{}, err
+	}
+
+	return Price{
+		Base:   price.Base,
+		Quote:  price.Quote,
+		Rate:   price.Rate,
+		Source: price.Source,
+		Time:   price.PriceTime,
+	}, nil
+}
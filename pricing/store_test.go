@@ -0,0 +1,39 @@
+package pricing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/YuanData/allegro-trade/db/mock"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func TestStoreOracle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	oracle := NewStoreOracle(store)
+
+	priceTime := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	store.EXPECT().
+		GetLatestPrice(gomock.Any(), gomock.Eq(db.GetLatestPriceParams{Base: util.ETH, Quote: util.BTC})).
+		Times(1).
+		Return(db.Price{Base: util.ETH, Quote: util.BTC, Rate: 5500000, Source: "feed", PriceTime: priceTime}, nil)
+
+	price, err := oracle.GetPrice(context.Background(), util.ETH, util.BTC)
+	require.NoError(t, err)
+	require.Equal(t, Price{Base: util.ETH, Quote: util.BTC, Rate: 5500000, Source: "feed", Time: priceTime}, price)
+
+	store.EXPECT().
+		GetPriceAt(gomock.Any(), gomock.Eq(db.GetPriceAtParams{Base: util.BTC, Quote: util.ETH, AtTime: priceTime})).
+		Times(1).
+		Return(db.Price{}, db.ErrRecordNotFound)
+
+	_, err = oracle.GetPriceAt(context.Background(), util.BTC, util.ETH, priceTime)
+	require.ErrorIs(t, err, ErrPriceNotFound)
+}
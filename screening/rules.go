@@ -0,0 +1,160 @@
+package screening
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YuanData/allegro-trade/money"
+)
+
+var (
+	ErrUnknownSymbol    = errors.New("symbol does not exist")
+	ErrInvalidThreshold = errors.New("threshold must be positive")
+)
+
+const (
+	LargeAmountReason     = "large amount"
+	RapidTransfersReason  = "rapid successive transfers"
+	NewCounterpartyReason = "new counterparty"
+	PasswordChangedReason = "recently changed password"
+)
+
+// Rules configure the rule engine. A zero value turns its rule off.
+type Rules struct {
+	// LargeAmounts maps a symbol to the decimal amount from which a transfer
+	// counts as large.
+	LargeAmounts map[string]string
+	// RapidCount is how many transfers already sent within RapidWindow make the
+	// next one rapid.
+	RapidCount  int64
+	RapidWindow time.Duration
+	// NewCounterparty holds transfers to a trader the sender never paid before.
+	NewCounterparty bool
+	// PasswordWindow is how long after a password change the member counts as
+	// having changed it recently.
+	PasswordWindow time.Duration
+}
+
+// RuleEngine is the default screener. Each rule that is on and matches holds a
+// transfer for review: a large amount, rapid successive transfers, a new
+// counterparty or a recent password change. When all three of a large amount,
+// a new counterparty and a recent password change come together, which is
+// what a taken over member looks like, the transfer is rejected.
+type RuleEngine struct {
+	rules Rules
+	// thresholds are the large amounts in the smallest unit of each symbol
+	thresholds map[string]int64
+}
+
+// NewRuleEngine converts the large amounts to the smallest unit of their
+// symbol, with the decimals the lookup reports, so a threshold that names an
+// unknown symbol or does not fit an amount fails at startup rather than on
+// every transfer. Symbols are matched regardless of case, as config keys may
+// come lowercased.
+func NewRuleEngine(rules Rules, decimals func(symbol string) (int32, bool)) (*RuleEngine, error) {
+	thresholds := make(map[string]int64, len(rules.LargeAmounts))
+	for symbol, amount := range rules.LargeAmounts {
+		symbol = strings.ToUpper(symbol)
+
+		scale, ok := decimals(symbol)
+		if !ok {
+			return nil, fmt.Errorf("large amount of %s: %w", symbol, ErrUnknownSymbol)
+		}
+
+		threshold, err := money.Parse(amount, scale, money.RoundUp)
+		if err != nil {
+			return nil, fmt.Errorf("large amount of %s: %w", symbol, err)
+		}
+		if threshold <= 0 {
+			return nil, fmt.Errorf("large amount of %s: %w", symbol, ErrInvalidThreshold)
+		}
+		thresholds[symbol] = threshold
+	}
+
+	return &RuleEngine{rules: rules, thresholds: thresholds}, nil
+}
+
+func (engine *RuleEngine) Screen(ctx context.Context, history History, transfer Transfer) (Verdict, error) {
+	verdict := Verdict{Decision: Approve}
+
+	large := engine.isLarge(transfer)
+	if large {
+		verdict.Reasons = append(verdict.Reasons, LargeAmountReason)
+	}
+
+	rapid, err := engine.isRapid(ctx, history, transfer)
+	if err != nil {
+		return verdict, err
+	}
+	if rapid {
+		verdict.Reasons = append(verdict.Reasons, RapidTransfersReason)
+	}
+
+	newCounterparty, err := engine.isNewCounterparty(ctx, history, transfer)
+	if err != nil {
+		return verdict, err
+	}
+	if newCounterparty {
+		verdict.Reasons = append(verdict.Reasons, NewCounterpartyReason)
+	}
+
+	passwordChanged, err := engine.passwordChanged(ctx, history, transfer)
+	if err != nil {
+		return verdict, err
+	}
+	if passwordChanged {
+		verdict.Reasons = append(verdict.Reasons, PasswordChangedReason)
+	}
+
+	switch {
+	case large && newCounterparty && passwordChanged:
+		verdict.Decision = Reject
+	case len(verdict.Reasons) > 0:
+		verdict.Decision = Review
+	}
+	return verdict, nil
+}
+
+func (engine *RuleEngine) isLarge(transfer Transfer) bool {
+	threshold, ok := engine.thresholds[strings.ToUpper(transfer.Symbol)]
+	return ok && transfer.Number >= threshold
+}
+
+func (engine *RuleEngine) isRapid(ctx context.Context, history History, transfer Transfer) (bool, error) {
+	if engine.rules.RapidCount <= 0 || engine.rules.RapidWindow <= 0 {
+		return false, nil
+	}
+
+	count, err := history.CountTransfersSince(ctx, transfer.FromTraderID, time.Now().Add(-engine.rules.RapidWindow))
+	if err != nil {
+		return false, err
+	}
+	return count >= engine.rules.RapidCount, nil
+}
+
+func (engine *RuleEngine) isNewCounterparty(ctx context.Context, history History, transfer Transfer) (bool, error) {
+	if !engine.rules.NewCounterparty {
+		return false, nil
+	}
+
+	paid, err := history.HasPaid(ctx, transfer.FromTraderID, transfer.ToTraderID)
+	if err != nil {
+		return false, err
+	}
+	return !paid, nil
+}
+
+func (engine *RuleEngine) passwordChanged(ctx context.Context, history History, transfer Transfer) (bool, error) {
+	if engine.rules.PasswordWindow <= 0 {
+		return false, nil
+	}
+
+	changed, err := history.PasswordChangedTime(ctx, transfer.Holder)
+	if err != nil {
+		return false, err
+	}
+	return !changed.IsZero() && time.Since(changed) < engine.rules.PasswordWindow, nil
+}
@@ -0,0 +1,254 @@
+package screening
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/YuanData/allegro-trade/money"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+type fakeHistory struct {
+	recent          int64
+	paid            bool
+	passwordChanged time.Time
+}
+
+func (history fakeHistory) CountTransfersSince(ctx context.Context, fromTraderID int64, since time.Time) (int64, error) {
+	return history.recent, nil
+}
+
+func (history fakeHistory) HasPaid(ctx context.Context, fromTraderID int64, toTraderID int64) (bool, error) {
+	return history.paid, nil
+}
+
+func (history fakeHistory) PasswordChangedTime(ctx context.Context, membername string) (time.Time, error) {
+	return history.passwordChanged, nil
+}
+
+// decimalsOf looks symbols up in a fixed table, standing in for the registry.
+func decimalsOf(table map[string]int32) func(string) (int32, bool) {
+	return func(symbol string) (int32, bool) {
+		decimals, ok := table[symbol]
+		return decimals, ok
+	}
+}
+
+func TestRuleEngine(t *testing.T) {
+	engine, err := NewRuleEngine(Rules{
+		LargeAmounts:    map[string]string{"eth": "10"},
+		RapidCount:      3,
+		RapidWindow:     time.Minute,
+		NewCounterparty: true,
+		PasswordWindow:  24 * time.Hour,
+	}, decimalsOf(map[string]int32{util.ETH: 2}))
+	require.NoError(t, err)
+
+	transfer := Transfer{
+		FromTraderID: 1,
+		ToTraderID:   2,
+		Holder:       util.RandomHolder(),
+		Symbol:       util.ETH,
+		Decimals:     2,
+		Number:       500,
+	}
+	large := transfer
+	large.Number = 1000
+
+	changed := time.Now().Add(-time.Hour)
+
+	testCases := []struct {
+		name     string
+		history  fakeHistory
+		transfer Transfer
+		decision Decision
+		reasons  []string
+	}{
+		{
+			name:     "Approve",
+			history:  fakeHistory{recent: 2, paid: true},
+			transfer: transfer,
+			decision: Approve,
+		},
+		{
+			name:     "LargeAmount",
+			history:  fakeHistory{paid: true},
+			transfer: large,
+			decision: Review,
+			reasons:  []string{LargeAmountReason},
+		},
+		{
+			name:     "RapidTransfers",
+			history:  fakeHistory{recent: 3, paid: true},
+			transfer: transfer,
+			decision: Review,
+			reasons:  []string{RapidTransfersReason},
+		},
+		{
+			name:     "NewCounterparty",
+			history:  fakeHistory{},
+			transfer: transfer,
+			decision: Review,
+			reasons:  []string{NewCounterpartyReason},
+		},
+		{
+			name:     "PasswordChanged",
+			history:  fakeHistory{paid: true, passwordChanged: changed},
+			transfer: transfer,
+			decision: Review,
+			reasons:  []string{PasswordChangedReason},
+		},
+		{
+			name:     "PasswordChangedNewCounterparty",
+			history:  fakeHistory{passwordChanged: changed},
+			transfer: transfer,
+			decision: Review,
+			reasons:  []string{NewCounterpartyReason, PasswordChangedReason},
+		},
+		{
+			name:     "PasswordChangedLongAgo",
+			history:  fakeHistory{paid: true, passwordChanged: time.Now().Add(-48 * time.Hour)},
+			transfer: transfer,
+			decision: Approve,
+		},
+		{
+			name:     "TakenOver",
+			history:  fakeHistory{passwordChanged: changed},
+			transfer: large,
+			decision: Reject,
+			reasons:  []string{LargeAmountReason, NewCounterpartyReason, PasswordChangedReason},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			verdict, err := engine.Screen(context.Background(), tc.history, tc.transfer)
+			require.NoError(t, err)
+			require.Equal(t, tc.decision, verdict.Decision)
+			require.Equal(t, tc.reasons, verdict.Reasons)
+		})
+	}
+}
+
+// strictHistory fails the test when asked anything, to show a rule that is off
+// costs no query.
+type strictHistory struct {
+	t *testing.T
+}
+
+func (history strictHistory) CountTransfersSince(ctx context.Context, fromTraderID int64, since time.Time) (int64, error) {
+	history.t.Fatal("CountTransfersSince asked")
+	return 0, nil
+}
+
+func (history strictHistory) HasPaid(ctx context.Context, fromTraderID int64, toTraderID int64) (bool, error) {
+	history.t.Fatal("HasPaid asked")
+	return false, nil
+}
+
+func (history strictHistory) PasswordChangedTime(ctx context.Context, membername string) (time.Time, error) {
+	history.t.Fatal("PasswordChangedTime asked")
+	return time.Time{}, nil
+}
+
+// passwordOnly answers the password rule and leaves the rest to strictHistory.
+type passwordOnly struct {
+	strictHistory
+	changed time.Time
+}
+
+func (history passwordOnly) PasswordChangedTime(ctx context.Context, membername string) (time.Time, error) {
+	return history.changed, nil
+}
+
+// counterpartyOnly answers the new counterparty rule and leaves the rest to strictHistory.
+type counterpartyOnly struct {
+	strictHistory
+	paid bool
+}
+
+func (history counterpartyOnly) HasPaid(ctx context.Context, fromTraderID int64, toTraderID int64) (bool, error) {
+	return history.paid, nil
+}
+
+func TestRuleEngineNewCounterpartyRule(t *testing.T) {
+	engine, err := NewRuleEngine(Rules{NewCounterparty: true}, decimalsOf(nil))
+	require.NoError(t, err)
+
+	transfer := Transfer{FromTraderID: 1, ToTraderID: 2, Holder: util.RandomHolder(), Symbol: util.ETH, Number: 500}
+
+	verdict, err := engine.Screen(context.Background(), counterpartyOnly{strictHistory: strictHistory{t}}, transfer)
+	require.NoError(t, err)
+	require.Equal(t, Review, verdict.Decision)
+	require.Equal(t, []string{NewCounterpartyReason}, verdict.Reasons)
+
+	verdict, err = engine.Screen(context.Background(), counterpartyOnly{strictHistory: strictHistory{t}, paid: true}, transfer)
+	require.NoError(t, err)
+	require.Equal(t, Approve, verdict.Decision)
+	require.Empty(t, verdict.Reasons)
+}
+
+func TestRuleEnginePasswordRule(t *testing.T) {
+	engine, err := NewRuleEngine(Rules{PasswordWindow: 24 * time.Hour}, decimalsOf(nil))
+	require.NoError(t, err)
+
+	transfer := Transfer{FromTraderID: 1, ToTraderID: 2, Holder: util.RandomHolder(), Symbol: util.ETH, Number: 500}
+
+	history := passwordOnly{strictHistory: strictHistory{t}, changed: time.Now().Add(-time.Hour)}
+	verdict, err := engine.Screen(context.Background(), history, transfer)
+	require.NoError(t, err)
+	require.Equal(t, Review, verdict.Decision)
+	require.Equal(t, []string{PasswordChangedReason}, verdict.Reasons)
+
+	history.changed = time.Now().Add(-48 * time.Hour)
+	verdict, err = engine.Screen(context.Background(), history, transfer)
+	require.NoError(t, err)
+	require.Equal(t, Approve, verdict.Decision)
+	require.Empty(t, verdict.Reasons)
+}
+
+func TestRuleEngineInvalidAmount(t *testing.T) {
+	decimals := decimalsOf(map[string]int32{util.ETH: 18})
+
+	_, err := NewRuleEngine(Rules{LargeAmounts: map[string]string{"ETH": "ten"}}, decimals)
+	require.ErrorIs(t, err, money.ErrInvalidAmount)
+
+	_, err = NewRuleEngine(Rules{LargeAmounts: map[string]string{"ETH": "0"}}, decimals)
+	require.ErrorIs(t, err, ErrInvalidThreshold)
+
+	_, err = NewRuleEngine(Rules{LargeAmounts: map[string]string{"DOGE": "10"}}, decimals)
+	require.ErrorIs(t, err, ErrUnknownSymbol)
+}
+
+func TestRuleEngineEighteenDecimals(t *testing.T) {
+	decimals := decimalsOf(map[string]int32{util.ETH: 18})
+
+	// 50 ETH in wei does not fit an int64 amount
+	_, err := NewRuleEngine(Rules{LargeAmounts: map[string]string{"ETH": "50"}}, decimals)
+	require.ErrorIs(t, err, money.ErrOverflow)
+
+	engine, err := NewRuleEngine(Rules{LargeAmounts: map[string]string{"ETH": "5"}}, decimals)
+	require.NoError(t, err)
+
+	transfer := Transfer{
+		FromTraderID: 1,
+		ToTraderID:   2,
+		Holder:       util.RandomHolder(),
+		Symbol:       util.ETH,
+		Decimals:     18,
+		Number:       4_999_999_999_999_999_999,
+	}
+	verdict, err := engine.Screen(context.Background(), fakeHistory{paid: true}, transfer)
+	require.NoError(t, err)
+	require.Equal(t, Approve, verdict.Decision)
+
+	transfer.Number = 5_000_000_000_000_000_000
+	verdict, err = engine.Screen(context.Background(), fakeHistory{paid: true}, transfer)
+	require.NoError(t, err)
+	require.Equal(t, Review, verdict.Decision)
+	require.Equal(t, []string{LargeAmountReason}, verdict.Reasons)
+}
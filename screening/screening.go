@@ -0,0 +1,49 @@
+package screening
+
+import (
+	"context"
+	"time"
+)
+
+// Decision is what a screener wants done with a transfer.
+type Decision string
+
+const (
+	Approve Decision = "approve"
+	Reject  Decision = "reject"
+	Review  Decision = "review"
+)
+
+// Transfer is a transfer about to be recorded.
+type Transfer struct {
+	FromTraderID int64
+	ToTraderID   int64
+	Holder       string
+	Symbol       string
+	Decimals     int32
+	Number       int64
+}
+
+// Verdict is the decision on a transfer with the reasons behind it, which are
+// shown to the priests reviewing a held transfer.
+type Verdict struct {
+	Decision Decision
+	Reasons  []string
+}
+
+// History answers what a screener may want to know beyond the transfer itself.
+// It reads within the transaction recording the transfer.
+type History interface {
+	// CountTransfersSince counts the transfers sent by a trader since a time.
+	CountTransfersSince(ctx context.Context, fromTraderID int64, since time.Time) (int64, error)
+	// HasPaid reports whether a trader ever sent a transfer to another.
+	HasPaid(ctx context.Context, fromTraderID int64, toTraderID int64) (bool, error)
+	// PasswordChangedTime is when a member last changed their password, zero if never.
+	PasswordChangedTime(ctx context.Context, membername string) (time.Time, error)
+}
+
+// Screener decides whether a transfer goes through, is rejected, or is held
+// until a priest reviews it. It is asked before the transfer is recorded.
+type Screener interface {
+	Screen(ctx context.Context, history History, transfer Transfer) (Verdict, error)
+}
@@ -0,0 +1,126 @@
+package statement
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/money"
+)
+
+const (
+	openingLine  = "opening"
+	movementLine = "movement"
+	closingLine  = "closing"
+)
+
+type encoder interface {
+	opening(trader db.Trader, at time.Time, balance int64) error
+	movement(detail db.Detail, balance int64) error
+	closing(trader db.Trader, at time.Time, balance int64) error
+	flush() error
+}
+
+func newEncoder(w io.Writer, format Format, decimals int32) (encoder, error) {
+	switch format {
+	case CSVFormat:
+		enc := &csvEncoder{writer: csv.NewWriter(w), decimals: decimals}
+		return enc, enc.writer.Write([]string{"type", "time", "trader_id", "symbol", "detail_id", "record_id", "number", "balance"})
+	case JSONLinesFormat:
+		return &jsonLinesEncoder{encoder: json.NewEncoder(w), decimals: decimals}, nil
+	}
+	return nil, ErrUnknownFormat
+}
+
+type csvEncoder struct {
+	writer   *csv.Writer
+	decimals int32
+}
+
+func (enc *csvEncoder) opening(trader db.Trader, at time.Time, balance int64) error {
+	return enc.writer.Write([]string{
+		openingLine, at.Format(time.RFC3339), strconv.FormatInt(trader.ID, 10), trader.Symbol,
+		"", "", "", money.Format(balance, enc.decimals),
+	})
+}
+
+func (enc *csvEncoder) movement(detail db.Detail, balance int64) error {
+	recordID := ""
+	if detail.RecordID.Valid {
+		recordID = strconv.FormatInt(detail.RecordID.Int64, 10)
+	}
+
+	return enc.writer.Write([]string{
+		movementLine, detail.CreatedTime.UTC().Format(time.RFC3339), strconv.FormatInt(detail.TraderID, 10), "",
+		strconv.FormatInt(detail.ID, 10), recordID, money.Format(detail.Number, enc.decimals), money.Format(balance, enc.decimals),
+	})
+}
+
+func (enc *csvEncoder) closing(trader db.Trader, at time.Time, balance int64) error {
+	return enc.writer.Write([]string{
+		closingLine, at.Format(time.RFC3339), strconv.FormatInt(trader.ID, 10), trader.Symbol,
+		"", "", "", money.Format(balance, enc.decimals),
+	})
+}
+
+func (enc *csvEncoder) flush() error {
+	enc.writer.Flush()
+	return enc.writer.Error()
+}
+
+// jsonLine is one line of a JSON Lines statement. Amounts are decimal strings,
+// as everywhere else in the API.
+type jsonLine struct {
+	Type     string    `json:"type"`
+	Time     time.Time `json:"time"`
+	TraderID int64     `json:"trader_id"`
+	Symbol   string    `json:"symbol,omitempty"`
+	DetailID int64     `json:"detail_id,omitempty"`
+	RecordID int64     `json:"record_id,omitempty"`
+	Number   string    `json:"number,omitempty"`
+	Balance  string    `json:"balance"`
+}
+
+type jsonLinesEncoder struct {
+	encoder  *json.Encoder
+	decimals int32
+}
+
+func (enc *jsonLinesEncoder) opening(trader db.Trader, at time.Time, balance int64) error {
+	return enc.encoder.Encode(jsonLine{
+		Type:     openingLine,
+		Time:     at,
+		TraderID: trader.ID,
+		Symbol:   trader.Symbol,
+		Balance:  money.Format(balance, enc.decimals),
+	})
+}
+
+func (enc *jsonLinesEncoder) movement(detail db.Detail, balance int64) error {
+	return enc.encoder.Encode(jsonLine{
+		Type:     movementLine,
+		Time:     detail.CreatedTime.UTC(),
+		TraderID: detail.TraderID,
+		DetailID: detail.ID,
+		RecordID: detail.RecordID.Int64,
+		Number:   money.Format(detail.Number, enc.decimals),
+		Balance:  money.Format(balance, enc.decimals),
+	})
+}
+
+func (enc *jsonLinesEncoder) closing(trader db.Trader, at time.Time, balance int64) error {
+	return enc.encoder.Encode(jsonLine{
+		Type:     closingLine,
+		Time:     at,
+		TraderID: trader.ID,
+		Symbol:   trader.Symbol,
+		Balance:  money.Format(balance, enc.decimals),
+	})
+}
+
+func (enc *jsonLinesEncoder) flush() error {
+	return nil
+}
@@ -0,0 +1,143 @@
+package statement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+)
+
+type Format string
+
+const (
+	CSVFormat        Format = "csv"
+	JSONLinesFormat  Format = "jsonl"
+	defaultBatchSize        = 500
+)
+
+var ErrUnknownFormat = errors.New("unknown statement format")
+
+// ParseFormat returns the format of a name given by a client.
+func ParseFormat(value string) (Format, error) {
+	switch format := Format(value); format {
+	case CSVFormat, JSONLinesFormat:
+		return format, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+// ContentType is the media type a statement of the format is served with.
+func (format Format) ContentType() string {
+	if format == JSONLinesFormat {
+		return "application/jsonl"
+	}
+	return "text/csv"
+}
+
+// Period is the half-open span [From, To) a statement covers.
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+// MonthPeriod returns the calendar month in UTC, which is what statements are
+// cut on.
+func MonthPeriod(year int, month time.Month) Period {
+	from := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return Period{
+		From: from,
+		To:   from.AddDate(0, 1, 0),
+	}
+}
+
+// FileName is the name a statement of the trader for the period is saved under.
+func FileName(traderID int64, period Period, format Format) string {
+	return fmt.Sprintf("statement-%d-%s.%s", traderID, period.From.Format("2006-01"), format)
+}
+
+type Summary struct {
+	Opening   int64
+	Closing   int64
+	Movements int
+}
+
+// Generator writes trader statements from the details of the ledger.
+type Generator struct {
+	store     db.Querier
+	batchSize int32
+}
+
+func NewGenerator(store db.Querier) *Generator {
+	return &Generator{
+		store:     store,
+		batchSize: defaultBatchSize,
+	}
+}
+
+// Write writes the statement of a trader for a period: the opening balance, every
+// detail within the period with the balance after it, and the closing balance.
+// Details are read in batches, so a statement of any length is written without
+// holding it in memory.
+func (generator *Generator) Write(ctx context.Context, w io.Writer, format Format, trader db.Trader, period Period) (Summary, error) {
+	var summary Summary
+
+	symbol, err := generator.store.GetSymbol(ctx, trader.Symbol)
+	if err != nil {
+		return summary, fmt.Errorf("get symbol err: %w", err)
+	}
+
+	enc, err := newEncoder(w, format, symbol.Decimals)
+	if err != nil {
+		return summary, err
+	}
+
+	summary.Opening, err = generator.store.GetBalanceAt(ctx, db.GetBalanceAtParams{
+		TraderID: trader.ID,
+		AtTime:   period.From,
+	})
+	if err != nil {
+		return summary, fmt.Errorf("get opening balance err: %w", err)
+	}
+
+	if err = enc.opening(trader, period.From, summary.Opening); err != nil {
+		return summary, err
+	}
+
+	balance := summary.Opening
+	afterID := int64(0)
+	for {
+		details, err := generator.store.ListDetailsInPeriod(ctx, db.ListDetailsInPeriodParams{
+			TraderID: trader.ID,
+			FromTime: period.From,
+			ToTime:   period.To,
+			AfterID:  afterID,
+			Limit:    generator.batchSize,
+		})
+		if err != nil {
+			return summary, fmt.Errorf("list details err: %w", err)
+		}
+
+		for _, detail := range details {
+			balance += detail.Number
+			if err = enc.movement(detail, balance); err != nil {
+				return summary, err
+			}
+		}
+		summary.Movements += len(details)
+
+		if len(details) < int(generator.batchSize) {
+			break
+		}
+		afterID = details[len(details)-1].ID
+	}
+
+	summary.Closing = balance
+	if err = enc.closing(trader, period.To, summary.Closing); err != nil {
+		return summary, err
+	}
+
+	return summary, enc.flush()
+}
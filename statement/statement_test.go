@@ -0,0 +1,94 @@
+package statement
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/YuanData/allegro-trade/db/mock"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func TestWriteStatement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	generator := NewGenerator(store)
+	generator.batchSize = 2
+
+	trader := db.Trader{ID: 7, Holder: "alice", Symbol: util.BTC}
+	period := MonthPeriod(2026, time.September)
+	createdTime := time.Date(2026, time.September, 3, 12, 0, 0, 0, time.UTC)
+	details := []db.Detail{
+		{ID: 11, TraderID: trader.ID, Number: 150000000, CreatedTime: createdTime},
+		{ID: 12, TraderID: trader.ID, Number: -25000000, CreatedTime: createdTime, RecordID: pgtype.Int8{Int64: 4, Valid: true}},
+		{ID: 15, TraderID: trader.ID, Number: -5000000, CreatedTime: createdTime},
+	}
+
+	store.EXPECT().GetSymbol(gomock.Any(), util.BTC).Times(2).Return(db.Symbol{Code: util.BTC, Decimals: 8}, nil)
+	store.EXPECT().GetBalanceAt(gomock.Any(), db.GetBalanceAtParams{
+		TraderID: trader.ID,
+		AtTime:   period.From,
+	}).Times(2).Return(int64(100000000), nil)
+
+	for _, batch := range []struct {
+		afterID int64
+		details []db.Detail
+	}{{0, details[:2]}, {12, details[2:]}} {
+		store.EXPECT().ListDetailsInPeriod(gomock.Any(), db.ListDetailsInPeriodParams{
+			TraderID: trader.ID,
+			FromTime: period.From,
+			ToTime:   period.To,
+			AfterID:  batch.afterID,
+			Limit:    2,
+		}).Times(2).Return(batch.details, nil)
+	}
+
+	var buf bytes.Buffer
+	summary, err := generator.Write(context.Background(), &buf, CSVFormat, trader, period)
+	require.NoError(t, err)
+	require.Equal(t, Summary{Opening: 100000000, Closing: 220000000, Movements: 3}, summary)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 6)
+	require.Equal(t, "opening,2026-09-01T00:00:00Z,7,BTC,,,,1", lines[1])
+	require.Equal(t, "movement,2026-09-03T12:00:00Z,7,,12,4,-0.25,2.25", lines[3])
+	require.Equal(t, "closing,2026-10-01T00:00:00Z,7,BTC,,,,2.2", lines[5])
+
+	buf.Reset()
+	_, err = generator.Write(context.Background(), &buf, JSONLinesFormat, trader, period)
+	require.NoError(t, err)
+
+	lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 5)
+
+	var line jsonLine
+	require.NoError(t, json.Unmarshal([]byte(lines[2]), &line))
+	require.Equal(t, movementLine, line.Type)
+	require.Equal(t, int64(12), line.DetailID)
+	require.Equal(t, "-0.25", line.Number)
+	require.Equal(t, "2.25", line.Balance)
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("jsonl")
+	require.NoError(t, err)
+	require.Equal(t, JSONLinesFormat, format)
+
+	_, err = ParseFormat("pdf")
+	require.ErrorIs(t, err, ErrUnknownFormat)
+}
+
+func TestMonthPeriod(t *testing.T) {
+	period := MonthPeriod(2026, time.December)
+	require.Equal(t, time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC), period.To)
+	require.Equal(t, "statement-7-2026-12.csv", FileName(7, period, CSVFormat))
+}
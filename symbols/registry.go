@@ -0,0 +1,119 @@
+package symbols
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+)
+
+const loadTimeout = 5 * time.Second
+
+// Registry keeps the symbols table in memory so validating a symbol does not
+// cost a query. The cache is reloaded once it is older than its ttl, which is
+// how changes made through another server instance show up here, and right
+// away after Invalidate is called for a change made through this one.
+type Registry struct {
+	store      db.Querier
+	ttl        time.Duration
+	mu         sync.RWMutex
+	symbols    map[string]db.Symbol
+	loadedTime time.Time
+}
+
+func NewRegistry(store db.Querier, ttl time.Duration) *Registry {
+	return &Registry{
+		store:   store,
+		ttl:     ttl,
+		symbols: make(map[string]db.Symbol),
+	}
+}
+
+// Load replaces the cached symbols with the ones stored in the database.
+func (registry *Registry) Load(ctx context.Context) error {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	return registry.load(ctx)
+}
+
+// Get returns a symbol whether it is enabled or not.
+func (registry *Registry) Get(code string) (db.Symbol, bool) {
+	registry.refreshIfStale()
+
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	symbol, ok := registry.symbols[code]
+	return symbol, ok
+}
+
+// Decimals returns the decimals of a symbol whether it is enabled or not.
+func (registry *Registry) Decimals(code string) (int32, bool) {
+	symbol, ok := registry.Get(code)
+	return symbol.Decimals, ok
+}
+
+// IsEnabled reports whether a symbol exists and accepts new traders, transfers
+// and orders.
+func (registry *Registry) IsEnabled(code string) bool {
+	symbol, ok := registry.Get(code)
+	return ok && symbol.Enabled
+}
+
+// Invalidate makes the next lookup reload the symbols.
+func (registry *Registry) Invalidate() {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.loadedTime = time.Time{}
+}
+
+func (registry *Registry) refreshIfStale() {
+	registry.mu.RLock()
+	stale := registry.stale()
+	registry.mu.RUnlock()
+	if !stale {
+		return
+	}
+
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	// another lookup may have reloaded the symbols while this one waited for the lock
+	if !registry.stale() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), loadTimeout)
+	defer cancel()
+
+	err := registry.load(ctx)
+	if err != nil {
+		// keep serving the symbols we have rather than rejecting every symbol,
+		// and only try again once the ttl has passed
+		log.Error().Err(err).Msg("reload symbols err")
+		registry.loadedTime = time.Now()
+	}
+}
+
+func (registry *Registry) stale() bool {
+	return time.Since(registry.loadedTime) >= registry.ttl
+}
+
+func (registry *Registry) load(ctx context.Context) error {
+	symbols, err := registry.store.ListSymbols(ctx)
+	if err != nil {
+		return fmt.Errorf("list symbols err: %w", err)
+	}
+
+	registry.symbols = make(map[string]db.Symbol, len(symbols))
+	for _, symbol := range symbols {
+		registry.symbols[symbol.Code] = symbol
+	}
+	registry.loadedTime = time.Now()
+	return nil
+}
@@ -0,0 +1,88 @@
+package symbols
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/YuanData/allegro-trade/db/mock"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func TestRegistryLookup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	registry := NewRegistry(store, time.Hour)
+
+	store.EXPECT().ListSymbols(gomock.Any()).Times(1).Return([]db.Symbol{
+		{Code: util.ETH, Decimals: 9, MinTransfer: 1, Enabled: true},
+		{Code: "DOGE", Decimals: 8, MinTransfer: 100, Enabled: false},
+	}, nil)
+	require.NoError(t, registry.Load(context.Background()))
+
+	require.True(t, registry.IsEnabled(util.ETH))
+	require.False(t, registry.IsEnabled("DOGE"))
+	require.False(t, registry.IsEnabled(util.BTC))
+
+	symbol, ok := registry.Get("DOGE")
+	require.True(t, ok)
+	require.Equal(t, int64(100), symbol.MinTransfer)
+
+	decimals, ok := registry.Decimals(util.ETH)
+	require.True(t, ok)
+	require.Equal(t, int32(9), decimals)
+
+	_, ok = registry.Decimals(util.BTC)
+	require.False(t, ok)
+}
+
+func TestRegistryInvalidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	registry := NewRegistry(store, time.Hour)
+
+	gomock.InOrder(
+		store.EXPECT().ListSymbols(gomock.Any()).Times(1).Return([]db.Symbol{
+			{Code: util.ETH, Enabled: true},
+		}, nil),
+		store.EXPECT().ListSymbols(gomock.Any()).Times(1).Return([]db.Symbol{
+			{Code: util.ETH, Enabled: true},
+			{Code: "DOGE", Enabled: true},
+		}, nil),
+	)
+
+	require.False(t, registry.IsEnabled("DOGE"))
+	require.False(t, registry.IsEnabled("DOGE"))
+
+	registry.Invalidate()
+	require.True(t, registry.IsEnabled("DOGE"))
+}
+
+func TestRegistryKeepsSymbolsWhenReloadFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	registry := NewRegistry(store, time.Hour)
+
+	gomock.InOrder(
+		store.EXPECT().ListSymbols(gomock.Any()).Times(1).Return([]db.Symbol{
+			{Code: util.ETH, Enabled: true},
+		}, nil),
+		store.EXPECT().ListSymbols(gomock.Any()).Times(1).Return(nil, errors.New("connection refused")),
+	)
+
+	require.True(t, registry.IsEnabled(util.ETH))
+
+	registry.Invalidate()
+	require.True(t, registry.IsEnabled(util.ETH))
+	require.True(t, registry.IsEnabled(util.ETH))
+}
@@ -19,6 +19,22 @@ type Config struct {
 	EmailSenderName      string        `mapstructure:"EMAIL_SENDER_NAME"`
 	EmailSenderAddress   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
 	EmailSenderPassword  string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	ReconcileSchedule    string        `mapstructure:"RECONCILE_SCHEDULE"`
+	ReconcileAlertEmail  string        `mapstructure:"RECONCILE_ALERT_EMAIL"`
+	HoldExpirySchedule   string        `mapstructure:"HOLD_EXPIRY_SCHEDULE"`
+	OrderHoldTTL         time.Duration `mapstructure:"ORDER_HOLD_TTL"`
+	WithdrawalHoldTTL    time.Duration `mapstructure:"WITHDRAWAL_HOLD_TTL"`
+	ScheduledTransferSchedule string   `mapstructure:"SCHEDULED_TRANSFER_SCHEDULE"`
+	EscrowExpirySchedule string        `mapstructure:"ESCROW_EXPIRY_SCHEDULE"`
+	SymbolCacheTTL       time.Duration `mapstructure:"SYMBOL_CACHE_TTL"`
+	BalanceSnapshotSchedule string     `mapstructure:"BALANCE_SNAPSHOT_SCHEDULE"`
+	ScreeningLargeAmounts map[string]string `mapstructure:"SCREENING_LARGE_AMOUNTS"`
+	ScreeningRapidCount   int64         `mapstructure:"SCREENING_RAPID_COUNT"`
+	ScreeningRapidWindow  time.Duration `mapstructure:"SCREENING_RAPID_WINDOW"`
+	ScreeningNewCounterparty bool `mapstructure:"SCREENING_NEW_COUNTERPARTY"`
+	ScreeningPasswordWindow time.Duration `mapstructure:"SCREENING_PASSWORD_WINDOW"`
+	PriceFile             string        `mapstructure:"PRICE_FILE"`
+	PriceCacheTTL         time.Duration `mapstructure:"PRICE_CACHE_TTL"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -0,0 +1,21 @@
+package util
+
+import "time"
+
+// EscrowMembername holds the per-symbol traders that keep escrowed funds until
+// an escrow is released to the seller or refunded to the buyer.
+const EscrowMembername = "allegro_escrow"
+
+const (
+	OpenEscrowStatus      = "open"
+	DisputedEscrowStatus  = "disputed"
+	ReleasedEscrowStatus  = "released"
+	CancelledEscrowStatus = "cancelled"
+	ExpiredEscrowStatus   = "expired"
+)
+
+// An escrow expires after a timeout chosen by the buyer within these bounds.
+const (
+	MinEscrowTimeout = 10 * time.Minute
+	MaxEscrowTimeout = 30 * 24 * time.Hour
+)
@@ -0,0 +1,36 @@
+package util
+
+// HouseMembername holds the per-symbol traders that collect transfer fees.
+const HouseMembername = "allegro_house"
+
+const (
+	FlatFee       = "flat"
+	PercentageFee = "percentage"
+	TieredFee     = "tiered"
+)
+
+// MaxRateBps is a rate of 100%, in basis points.
+const MaxRateBps = 10000
+
+// RateFee charges rateBps basis points of number, rounded up so that any
+// non-zero rate earns at least one unit. The result is then kept between minFee
+// and maxFee, where a zero maxFee means no maximum.
+func RateFee(number int64, rateBps int32, minFee int64, maxFee int64) int64 {
+	rate := int64(rateBps)
+
+	// split number so the multiplication cannot overflow; rate is at most 100%
+	fee := number / MaxRateBps * rate
+	rest := number % MaxRateBps * rate
+	fee += rest / MaxRateBps
+	if rest%MaxRateBps != 0 {
+		fee++
+	}
+
+	if fee < minFee {
+		fee = minFee
+	}
+	if maxFee > 0 && fee > maxFee {
+		fee = maxFee
+	}
+	return fee
+}
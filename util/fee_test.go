@@ -0,0 +1,36 @@
+package util
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateFee(t *testing.T) {
+	testCases := []struct {
+		name    string
+		number  int64
+		rateBps int32
+		minFee  int64
+		maxFee  int64
+		fee     int64
+	}{
+		{name: "Exact", number: 10000, rateBps: 25, fee: 25},
+		{name: "RoundsUp", number: 10001, rateBps: 25, fee: 26},
+		{name: "SmallNumber", number: 1, rateBps: 1, fee: 1},
+		{name: "ZeroRate", number: 10000, rateBps: 0, fee: 0},
+		{name: "MinFee", number: 100, rateBps: 100, minFee: 5, fee: 5},
+		{name: "MaxFee", number: 1000000, rateBps: 100, maxFee: 500, fee: 500},
+		{name: "NoOverflow", number: math.MaxInt64, rateBps: MaxRateBps, fee: math.MaxInt64},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			fee := RateFee(tc.number, tc.rateBps, tc.minFee, tc.maxFee)
+			require.Equal(t, tc.fee, fee)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package util
+
+const (
+	ActiveHoldStatus   = "active"
+	ReleasedHoldStatus = "released"
+	CapturedHoldStatus = "captured"
+)
+
+const (
+	OrderHoldReason      = "order"
+	WithdrawalHoldReason = "withdrawal"
+	ReviewHoldReason     = "transfer_review"
+)
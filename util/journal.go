@@ -0,0 +1,18 @@
+package util
+
+// SystemMembername holds the per-symbol system traders that take the other
+// side of postings when money enters, leaves or changes symbol. For deposits
+// and withdrawals they act as the omnibus traders mirroring external funds.
+const SystemMembername = "allegro_system"
+
+const (
+	TransferEntry         = "transfer"
+	ExchangeEntry         = "exchange"
+	DepositEntry          = "deposit"
+	WithdrawalEntry       = "withdrawal"
+	WithdrawalRefundEntry = "withdrawal_refund"
+	ReversalEntry         = "reversal"
+	EscrowFundEntry       = "escrow_fund"
+	EscrowReleaseEntry    = "escrow_release"
+	EscrowRefundEntry     = "escrow_refund"
+)
@@ -0,0 +1,35 @@
+package util
+
+const (
+	NoneKYCLevel  = "none"
+	BasicKYCLevel = "basic"
+	FullKYCLevel  = "full"
+)
+
+// KYC levels a member needs for what they do; priests are not held to them.
+const (
+	TraderKYCLevel     = BasicKYCLevel
+	TransferKYCLevel   = BasicKYCLevel
+	WithdrawalKYCLevel = FullKYCLevel
+)
+
+const (
+	PassportDocument       = "passport"
+	NationalIDDocument     = "national_id"
+	DrivingLicenceDocument = "driving_licence"
+)
+
+// MinKYCAge is the age in years a member must have reached to pass KYC.
+const MinKYCAge = 18
+
+var kycRanks = map[string]int{
+	NoneKYCLevel:  0,
+	BasicKYCLevel: 1,
+	FullKYCLevel:  2,
+}
+
+// MeetsKYCLevel reports whether a member at level may do what needs required.
+func MeetsKYCLevel(level string, required string) bool {
+	rank, ok := kycRanks[level]
+	return ok && rank >= kycRanks[required]
+}
@@ -0,0 +1,16 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeetsKYCLevel(t *testing.T) {
+	require.True(t, MeetsKYCLevel(NoneKYCLevel, NoneKYCLevel))
+	require.False(t, MeetsKYCLevel(NoneKYCLevel, BasicKYCLevel))
+	require.True(t, MeetsKYCLevel(BasicKYCLevel, TransferKYCLevel))
+	require.False(t, MeetsKYCLevel(BasicKYCLevel, WithdrawalKYCLevel))
+	require.True(t, MeetsKYCLevel(FullKYCLevel, WithdrawalKYCLevel))
+	require.False(t, MeetsKYCLevel("", BasicKYCLevel))
+}
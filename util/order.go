@@ -0,0 +1,17 @@
+package util
+
+const (
+	BuySide  = "buy"
+	SellSide = "sell"
+)
+
+const (
+	LimitKind  = "limit"
+	MarketKind = "market"
+)
+
+const (
+	OpenOrderStatus      = "open"
+	FilledOrderStatus    = "filled"
+	CancelledOrderStatus = "cancelled"
+)
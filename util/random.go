@@ -37,6 +37,7 @@ func RandomAmount() int64 {
 	return RandomInt(0, 5000)
 }
 
+// RandomSymbol picks one of the symbols every database is seeded with.
 func RandomSymbol() string {
 	symbols := []string{ETH, BTC, ADA}
 	n := len(symbols)
@@ -3,4 +3,5 @@ package util
 const (
 	PrayerRole = "prayer"
 	PriestRole    = "priest"
+	SystemRole    = "system"
 )
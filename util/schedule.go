@@ -0,0 +1,33 @@
+package util
+
+import (
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	SkipOnInsufficientFunds  = "skip"
+	RetryOnInsufficientFunds = "retry"
+)
+
+const (
+	SucceededRunStatus = "succeeded"
+	HeldRunStatus      = "held"
+	SkippedRunStatus   = "skipped"
+	FailedRunStatus    = "failed"
+)
+
+// MinScheduleInterval is the shortest gap allowed between two runs of a schedule.
+const MinScheduleInterval = time.Minute
+
+// NextRunTime returns the first time after the given one that matches a cronspec.
+// A cronspec is either a standard five-field cron expression, a descriptor such
+// as @weekly, or an interval such as @every 24h.
+func NextRunTime(cronspec string, after time.Time) (time.Time, error) {
+	schedule, err := cron.ParseStandard(cronspec)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(after), nil
+}
@@ -0,0 +1,28 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextRunTime(t *testing.T) {
+	// a Wednesday
+	after := time.Date(2023, time.May, 10, 8, 30, 0, 0, time.UTC)
+
+	next, err := NextRunTime("0 9 * * MON", after)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, time.May, 15, 9, 0, 0, 0, time.UTC), next)
+
+	next, err = NextRunTime("@every 24h", after)
+	require.NoError(t, err)
+	require.Equal(t, after.Add(24*time.Hour), next)
+
+	next, err = NextRunTime("@daily", after)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2023, time.May, 11, 0, 0, 0, 0, time.UTC), next)
+
+	_, err = NextRunTime("every monday", after)
+	require.Error(t, err)
+}
@@ -0,0 +1,7 @@
+package util
+
+const (
+	PendingReviewStatus  = "pending"
+	ApprovedReviewStatus = "approved"
+	RejectedReviewStatus = "rejected"
+)
@@ -6,10 +6,27 @@ const (
 	ADA = "ADA"
 )
 
-func IsSupportedSymbol(symbol string) bool {
+// MaxSymbolDecimals keeps the smallest unit of any symbol within an int64 amount
+// that still holds whole units.
+const MaxSymbolDecimals = 18
+
+// symbolLookup decides which symbols are supported. It starts out knowing the
+// symbols every database is seeded with and is replaced by the symbol registry
+// when a server starts.
+var symbolLookup = func(symbol string) bool {
 	switch symbol {
 	case ETH, BTC, ADA:
 		return true
 	}
 	return false
 }
+
+// SetSymbolLookup replaces the lookup behind IsSupportedSymbol. It is meant to be
+// called once at startup, before any request is served.
+func SetSymbolLookup(lookup func(symbol string) bool) {
+	symbolLookup = lookup
+}
+
+func IsSupportedSymbol(symbol string) bool {
+	return symbolLookup(symbol)
+}
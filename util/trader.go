@@ -0,0 +1,24 @@
+package util
+
+const (
+	ActiveTraderStatus = "active"
+	FrozenTraderStatus = "frozen"
+	ClosedTraderStatus = "closed"
+)
+
+// traderStatusChanges lists the statuses a trader may move to from each status.
+// Closing is final: a closed trader holds nothing and is never reopened.
+var traderStatusChanges = map[string][]string{
+	ActiveTraderStatus: {FrozenTraderStatus, ClosedTraderStatus},
+	FrozenTraderStatus: {ActiveTraderStatus, ClosedTraderStatus},
+}
+
+// CanChangeTraderStatus reports whether a trader may move from one status to another.
+func CanChangeTraderStatus(from string, to string) bool {
+	for _, status := range traderStatusChanges[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanChangeTraderStatus(t *testing.T) {
+	testCases := []struct {
+		from    string
+		to      string
+		allowed bool
+	}{
+		{ActiveTraderStatus, FrozenTraderStatus, true},
+		{ActiveTraderStatus, ClosedTraderStatus, true},
+		{FrozenTraderStatus, ActiveTraderStatus, true},
+		{FrozenTraderStatus, ClosedTraderStatus, true},
+		{ClosedTraderStatus, ActiveTraderStatus, false},
+		{ClosedTraderStatus, FrozenTraderStatus, false},
+		{ActiveTraderStatus, ActiveTraderStatus, false},
+		{ActiveTraderStatus, "unknown", false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.allowed, CanChangeTraderStatus(tc.from, tc.to), "%s to %s", tc.from, tc.to)
+	}
+}
@@ -0,0 +1,11 @@
+package util
+
+const (
+	InDirection  = "in"
+	OutDirection = "out"
+)
+
+const (
+	CreatedTimeSort = "created_time"
+	NumberSort      = "number"
+)
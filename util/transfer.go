@@ -0,0 +1,5 @@
+package util
+
+// MaxBatchLegs caps how many traders one batch transfer may pay, which keeps
+// the number of rows locked by its transaction bounded.
+const MaxBatchLegs = 500
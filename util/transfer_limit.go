@@ -0,0 +1,15 @@
+package util
+
+import "time"
+
+const (
+	DailyLimitPeriod   = "daily"
+	MonthlyLimitPeriod = "monthly"
+)
+
+// Transfer limits apply to the amount sent within a rolling window that ends
+// at the transfer.
+const (
+	DailyLimitWindow   = 24 * time.Hour
+	MonthlyLimitWindow = 30 * 24 * time.Hour
+)
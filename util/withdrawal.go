@@ -0,0 +1,7 @@
+package util
+
+const (
+	PendingWithdrawalStatus  = "pending"
+	ApprovedWithdrawalStatus = "approved"
+	RejectedWithdrawalStatus = "rejected"
+)
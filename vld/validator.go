@@ -4,11 +4,19 @@ import (
 	"fmt"
 	"net/mail"
 	"regexp"
+	"time"
+
+	"github.com/YuanData/allegro-trade/money"
+	"github.com/YuanData/allegro-trade/pagination"
+	"github.com/YuanData/allegro-trade/statement"
+	"github.com/YuanData/allegro-trade/util"
 )
 
 var (
-	isValidMembername = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
-	isValidNameEntire = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidMembername  = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
+	isValidNameEntire  = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidSymbolCode  = regexp.MustCompile(`^[A-Z0-9]+$`).MatchString
+	isValidCountryCode = regexp.MustCompile(`^[A-Z]{2}$`).MatchString
 )
 
 func ValidateString(value string, minLength int, maxLength int) error {
@@ -63,3 +71,294 @@ func ValidateEmailId(value int64) error {
 func ValidateSecretCode(value string) error {
 	return ValidateString(value, 32, 128)
 }
+
+func ValidateSymbol(value string) error {
+	if !util.IsSupportedSymbol(value) {
+		return fmt.Errorf("is an unsupported symbol")
+	}
+	return nil
+}
+
+func ValidateSymbolCode(value string) error {
+	if err := ValidateString(value, 2, 10); err != nil {
+		return err
+	}
+	if !isValidSymbolCode(value) {
+		return fmt.Errorf("should only include uppercase letters or numbers")
+	}
+	return nil
+}
+
+func ValidateSymbolName(value string) error {
+	return ValidateString(value, 1, 64)
+}
+
+func ValidateDecimals(value int32) error {
+	if value < 0 || value > util.MaxSymbolDecimals {
+		return fmt.Errorf("must be between 0 and %d", util.MaxSymbolDecimals)
+	}
+	return nil
+}
+
+// ValidateAmount checks that a decimal amount is well formed and positive. How
+// many decimals it may have depends on its symbol and is checked when the amount
+// is converted into minor units.
+func ValidateAmount(value string) error {
+	amount, err := money.Parse(value, 0, money.RoundUp)
+	if err != nil {
+		return err
+	}
+	if amount <= 0 {
+		return fmt.Errorf("must be a positive amount")
+	}
+	return nil
+}
+
+// ValidateNonNegativeAmount is ValidateAmount for amounts that may be zero,
+// which includes leaving them empty.
+func ValidateNonNegativeAmount(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	amount, err := money.Parse(value, 0, money.RoundUp)
+	if err != nil {
+		return err
+	}
+	if amount < 0 {
+		return fmt.Errorf("must not be negative")
+	}
+	return nil
+}
+
+func ValidateId(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidatePageNum(value int32) error {
+	if value < 1 {
+		return fmt.Errorf("must be at least 1")
+	}
+	return nil
+}
+
+func ValidatePageLmt(value int32) error {
+	if value < 5 || value > 10 {
+		return fmt.Errorf("must be between 5 and 10")
+	}
+	return nil
+}
+
+// ValidateCursorPageLmt checks the page size of a listing continued with page
+// tokens, where 0 asks for the default size.
+func ValidateCursorPageLmt(value int32) error {
+	if value < 0 || value > pagination.MaxPageLmt {
+		return fmt.Errorf("must be between 0 and %d", pagination.MaxPageLmt)
+	}
+	return nil
+}
+
+func ValidateSide(value string) error {
+	if value != util.BuySide && value != util.SellSide {
+		return fmt.Errorf("should be either %s or %s", util.BuySide, util.SellSide)
+	}
+	return nil
+}
+
+func ValidateKind(value string) error {
+	if value != util.LimitKind && value != util.MarketKind {
+		return fmt.Errorf("should be either %s or %s", util.LimitKind, util.MarketKind)
+	}
+	return nil
+}
+
+func ValidateDirection(value string) error {
+	if value != util.InDirection && value != util.OutDirection {
+		return fmt.Errorf("should be either %s or %s", util.InDirection, util.OutDirection)
+	}
+	return nil
+}
+
+func ValidateTransactionSort(value string) error {
+	if value != util.CreatedTimeSort && value != util.NumberSort {
+		return fmt.Errorf("should be either %s or %s", util.CreatedTimeSort, util.NumberSort)
+	}
+	return nil
+}
+
+// ValidateStatementMonth checks the month of a statement, which may be the
+// current month but not one that has not started yet.
+func ValidateStatementMonth(year int32, month int32) error {
+	if month < 1 || month > 12 {
+		return fmt.Errorf("month must be between 1 and 12")
+	}
+	if year < 2000 || time.Date(int(year), time.Month(month), 1, 0, 0, 0, 0, time.UTC).After(time.Now()) {
+		return fmt.Errorf("must be a month from 2000 up to now")
+	}
+	return nil
+}
+
+func ValidateStatementFormat(value string) error {
+	if _, err := statement.ParseFormat(value); err != nil {
+		return fmt.Errorf("should be either %s or %s", statement.CSVFormat, statement.JSONLinesFormat)
+	}
+	return nil
+}
+
+func ValidateIdempotencyKey(value string) error {
+	return ValidateString(value, 1, 128)
+}
+
+func ValidateReference(value string) error {
+	return ValidateString(value, 1, 128)
+}
+
+func ValidateDestination(value string) error {
+	return ValidateString(value, 1, 256)
+}
+
+func ValidateWithdrawalStatus(value string) error {
+	switch value {
+	case util.PendingWithdrawalStatus, util.ApprovedWithdrawalStatus, util.RejectedWithdrawalStatus:
+		return nil
+	}
+	return fmt.Errorf("is an unsupported withdrawal status")
+}
+
+func ValidateTraderStatus(value string) error {
+	switch value {
+	case util.ActiveTraderStatus, util.FrozenTraderStatus, util.ClosedTraderStatus:
+		return nil
+	}
+	return fmt.Errorf("is an unsupported trader status")
+}
+
+func ValidateStatusReason(value string) error {
+	return ValidateString(value, 1, 256)
+}
+
+func ValidateReviewStatus(value string) error {
+	switch value {
+	case util.PendingReviewStatus, util.ApprovedReviewStatus, util.RejectedReviewStatus:
+		return nil
+	}
+	return fmt.Errorf("is an unsupported review status")
+}
+
+func ValidateKYCLevel(value string) error {
+	switch value {
+	case util.NoneKYCLevel, util.BasicKYCLevel, util.FullKYCLevel:
+		return nil
+	}
+	return fmt.Errorf("is an unsupported kyc level")
+}
+
+func ValidateSubmittedKYCLevel(value string) error {
+	if value != util.BasicKYCLevel && value != util.FullKYCLevel {
+		return fmt.Errorf("should be either %s or %s", util.BasicKYCLevel, util.FullKYCLevel)
+	}
+	return nil
+}
+
+func ValidateLegalName(value string) error {
+	return ValidateString(value, 2, 128)
+}
+
+// ValidateDateOfBirth parses a date such as 1990-04-30 and checks the member
+// is old enough to pass KYC.
+func ValidateDateOfBirth(value string) (time.Time, error) {
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return date, fmt.Errorf("should be a date such as 1990-04-30")
+	}
+	if date.AddDate(util.MinKYCAge, 0, 0).After(time.Now()) {
+		return date, fmt.Errorf("must be at least %d years ago", util.MinKYCAge)
+	}
+	return date, nil
+}
+
+func ValidateCountryCode(value string) error {
+	if !isValidCountryCode(value) {
+		return fmt.Errorf("should be a two letter ISO 3166 code such as DE")
+	}
+	return nil
+}
+
+func ValidateDocumentType(value string) error {
+	switch value {
+	case util.PassportDocument, util.NationalIDDocument, util.DrivingLicenceDocument:
+		return nil
+	}
+	return fmt.Errorf("is an unsupported document type")
+}
+
+func ValidateDocumentNumber(value string) error {
+	return ValidateString(value, 4, 64)
+}
+
+func ValidateCronspec(value string) error {
+	if err := ValidateString(value, 1, 128); err != nil {
+		return err
+	}
+
+	first, err := util.NextRunTime(value, time.Now())
+	if err != nil {
+		return fmt.Errorf("is an invalid cronspec")
+	}
+	second, _ := util.NextRunTime(value, first)
+	if second.Sub(first) < util.MinScheduleInterval {
+		return fmt.Errorf("must not run more often than every %s", util.MinScheduleInterval)
+	}
+	return nil
+}
+
+func ValidateFundsPolicy(value string) error {
+	if value != util.SkipOnInsufficientFunds && value != util.RetryOnInsufficientFunds {
+		return fmt.Errorf("should be either %s or %s", util.SkipOnInsufficientFunds, util.RetryOnInsufficientFunds)
+	}
+	return nil
+}
+
+func ValidateBatchLegs(count int) error {
+	if count < 1 || count > util.MaxBatchLegs {
+		return fmt.Errorf("must have between 1 and %d legs", util.MaxBatchLegs)
+	}
+	return nil
+}
+
+func ValidateEscrowTimeout(value time.Duration) error {
+	if value < util.MinEscrowTimeout || value > util.MaxEscrowTimeout {
+		return fmt.Errorf("must be between %s and %s", util.MinEscrowTimeout, util.MaxEscrowTimeout)
+	}
+	return nil
+}
+
+func ValidateFeeKind(value string) error {
+	switch value {
+	case util.FlatFee, util.PercentageFee, util.TieredFee:
+		return nil
+	}
+	return fmt.Errorf("is an unsupported fee kind")
+}
+
+func ValidateFeeRole(value string) error {
+	switch value {
+	case "", util.PrayerRole, util.PriestRole:
+		return nil
+	}
+	return fmt.Errorf("is an unsupported role")
+}
+
+func ValidateLimitRole(value string) error {
+	return ValidateFeeRole(value)
+}
+
+func ValidateRateBps(value int32) error {
+	if value < 0 || value > util.MaxRateBps {
+		return fmt.Errorf("must be between 0 and %d", util.MaxRateBps)
+	}
+	return nil
+}
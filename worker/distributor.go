@@ -12,6 +12,16 @@ type TaskDistributor interface {
 		payload *PayloadSendVerifyEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskRunScheduledTransfer(
+		ctx context.Context,
+		payload *PayloadRunScheduledTransfer,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskEmailStatement(
+		ctx context.Context,
+		payload *PayloadEmailStatement,
+		opts ...asynq.Option,
+	) error
 }
 
 type RedisTaskDistributor struct {
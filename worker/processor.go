@@ -18,15 +18,23 @@ const (
 type TaskProcessor interface {
 	Start() error
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskReconcileLedger(ctx context.Context, task *asynq.Task) error
+	ProcessTaskReleaseExpiredHolds(ctx context.Context, task *asynq.Task) error
+	ProcessTaskDispatchScheduledTransfers(ctx context.Context, task *asynq.Task) error
+	ProcessTaskRunScheduledTransfer(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExpireEscrows(ctx context.Context, task *asynq.Task) error
+	ProcessTaskEmailStatement(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSnapshotBalances(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
-	server *asynq.Server
-	store  db.Store
-	mailer mail.EmailSender
+	server      *asynq.Server
+	store       db.Store
+	mailer      mail.EmailSender
+	distributor TaskDistributor
 }
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer mail.EmailSender) TaskProcessor {
+func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer mail.EmailSender, distributor TaskDistributor) TaskProcessor {
 	logger := NewLogger()
 	redis.SetLogger(logger)
 
@@ -46,9 +54,10 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer
 	)
 
 	return &RedisTaskProcessor{
-		server: server,
-		store:  store,
-		mailer: mailer,
+		server:      server,
+		store:       store,
+		mailer:      mailer,
+		distributor: distributor,
 	}
 }
 
@@ -56,6 +65,13 @@ func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
 
 	mux.HandleFunc(TaskSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskReconcileLedger, processor.ProcessTaskReconcileLedger)
+	mux.HandleFunc(TaskReleaseExpiredHolds, processor.ProcessTaskReleaseExpiredHolds)
+	mux.HandleFunc(TaskDispatchScheduledTransfers, processor.ProcessTaskDispatchScheduledTransfers)
+	mux.HandleFunc(TaskRunScheduledTransfer, processor.ProcessTaskRunScheduledTransfer)
+	mux.HandleFunc(TaskExpireEscrows, processor.ProcessTaskExpireEscrows)
+	mux.HandleFunc(TaskEmailStatement, processor.ProcessTaskEmailStatement)
+	mux.HandleFunc(TaskSnapshotBalances, processor.ProcessTaskSnapshotBalances)
 
 	return processor.server.Start(mux)
 }
@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"github.com/hibiken/asynq"
+)
+
+type TaskScheduler interface {
+	Start() error
+	ScheduleTaskReconcileLedger(
+		cronspec string,
+		payload *PayloadReconcileLedger,
+		opts ...asynq.Option,
+	) error
+	ScheduleTaskReleaseExpiredHolds(
+		cronspec string,
+		payload *PayloadReleaseExpiredHolds,
+		opts ...asynq.Option,
+	) error
+	ScheduleTaskDispatchScheduledTransfers(
+		cronspec string,
+		payload *PayloadDispatchScheduledTransfers,
+		opts ...asynq.Option,
+	) error
+	ScheduleTaskExpireEscrows(
+		cronspec string,
+		payload *PayloadExpireEscrows,
+		opts ...asynq.Option,
+	) error
+	ScheduleTaskSnapshotBalances(
+		cronspec string,
+		payload *PayloadSnapshotBalances,
+		opts ...asynq.Option,
+	) error
+}
+
+type RedisTaskScheduler struct {
+	scheduler *asynq.Scheduler
+}
+
+func NewRedisTaskScheduler(redisOpt asynq.RedisClientOpt) TaskScheduler {
+	scheduler := asynq.NewScheduler(redisOpt, &asynq.SchedulerOpts{
+		Logger: NewLogger(),
+	})
+	return &RedisTaskScheduler{
+		scheduler: scheduler,
+	}
+}
+
+func (scheduler *RedisTaskScheduler) Start() error {
+	return scheduler.scheduler.Start()
+}
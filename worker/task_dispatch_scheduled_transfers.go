@@ -0,0 +1,100 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+const TaskDispatchScheduledTransfers = "task:dispatch_scheduled_transfers"
+
+// scheduledTransferMaxRetry bounds how often a run is retried, whether it failed
+// for a transient error or, under the retry policy, for insufficient funds.
+const scheduledTransferMaxRetry = 5
+
+type PayloadDispatchScheduledTransfers struct {
+	BatchSize int32 `json:"batch_size"`
+}
+
+func (scheduler *RedisTaskScheduler) ScheduleTaskDispatchScheduledTransfers(
+	cronspec string,
+	payload *PayloadDispatchScheduledTransfers,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskDispatchScheduledTransfers, jsonPayload, opts...)
+	entryID, err := scheduler.scheduler.Register(cronspec, task)
+	if err != nil {
+		return fmt.Errorf("failed to register task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("cronspec", cronspec).
+		Str("entry_id", entryID).Msg("scheduled task")
+	return nil
+}
+
+// ProcessTaskDispatchScheduledTransfers enqueues one run for every due schedule
+// and moves the schedule on to its next run time. The run is enqueued before the
+// schedule is claimed, under a task id derived from the scheduled time, so a
+// dispatch that dies halfway neither loses nor duplicates a run.
+func (processor *RedisTaskProcessor) ProcessTaskDispatchScheduledTransfers(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadDispatchScheduledTransfers
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	schedules, err := processor.store.ListDueScheduledTransfers(ctx, payload.BatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to list due scheduled transfers: %w", err)
+	}
+
+	dispatched := 0
+	for _, schedule := range schedules {
+		// runs missed while the worker was down are not caught up
+		nextRunTime, err := util.NextRunTime(schedule.Cronspec, time.Now())
+		if err != nil {
+			log.Error().Err(err).Int64("schedule_id", schedule.ID).Msg("invalid cronspec")
+			continue
+		}
+
+		err = processor.distributor.DistributeTaskRunScheduledTransfer(ctx, &PayloadRunScheduledTransfer{
+			ScheduleID:    schedule.ID,
+			ScheduledTime: schedule.NextRunTime,
+		},
+			asynq.TaskID(fmt.Sprintf("scheduled_transfer:%d:%d", schedule.ID, schedule.NextRunTime.Unix())),
+			asynq.MaxRetry(scheduledTransferMaxRetry),
+			asynq.Queue(QueueCritical),
+		)
+		if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			return fmt.Errorf("failed to distribute scheduled transfer [%d]: %w", schedule.ID, err)
+		}
+
+		_, err = processor.store.ClaimScheduledTransfer(ctx, db.ClaimScheduledTransferParams{
+			ID:            schedule.ID,
+			ScheduledTime: schedule.NextRunTime,
+			NextRunTime:   nextRunTime,
+		})
+		if err != nil {
+			// paused, updated or claimed by another dispatch since it was listed
+			if errors.Is(err, db.ErrRecordNotFound) {
+				continue
+			}
+			return fmt.Errorf("failed to claim scheduled transfer [%d]: %w", schedule.ID, err)
+		}
+		dispatched++
+	}
+
+	log.Info().Str("type", task.Type()).Int("dispatched", dispatched).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	"github.com/YuanData/allegro-trade/statement"
+)
+
+const TaskEmailStatement = "task:email_statement"
+
+type PayloadEmailStatement struct {
+	TraderID int64  `json:"trader_id"`
+	Year     int    `json:"year"`
+	Month    int    `json:"month"`
+	Format   string `json:"format"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskEmailStatement(
+	ctx context.Context,
+	payload *PayloadEmailStatement,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskEmailStatement, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskEmailStatement writes the statement into a temporary file and mails
+// it to the member holding the trader as an attachment.
+func (processor *RedisTaskProcessor) ProcessTaskEmailStatement(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadEmailStatement
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	format, err := statement.ParseFormat(payload.Format)
+	if err != nil {
+		return fmt.Errorf("failed to parse format: %s: %w", err, asynq.SkipRetry)
+	}
+
+	trader, err := processor.store.GetTrader(ctx, payload.TraderID)
+	if err != nil {
+		return fmt.Errorf("failed to get trader: %w", err)
+	}
+
+	member, err := processor.store.GetMember(ctx, trader.Holder)
+	if err != nil {
+		return fmt.Errorf("failed to get member: %w", err)
+	}
+
+	dir, err := os.MkdirTemp("", "statement")
+	if err != nil {
+		return fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	period := statement.MonthPeriod(payload.Year, time.Month(payload.Month))
+	path := filepath.Join(dir, statement.FileName(trader.ID, period, format))
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create statement file: %w", err)
+	}
+
+	summary, err := statement.NewGenerator(processor.store).Write(ctx, file, format, trader, period)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write statement: %w", err)
+	}
+
+	subject := fmt.Sprintf("Allegro Trade statement %s for trader %d", period.From.Format("2006-01"), trader.ID)
+	content := fmt.Sprintf("%s, the %s statement of your %s trader %d is attached.",
+		member.NameEntire, period.From.Format("January 2006"), trader.Symbol, trader.ID)
+	to := []string{member.Email}
+
+	err = processor.mailer.SendEmail(subject, content, to, nil, nil, []string{path})
+	if err != nil {
+		return fmt.Errorf("failed to send statement email: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Int64("trader_id", trader.ID).
+		Int("movements", summary.Movements).Str("email", member.Email).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,72 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+)
+
+const TaskExpireEscrows = "task:expire_escrows"
+
+type PayloadExpireEscrows struct {
+	BatchSize int32 `json:"batch_size"`
+}
+
+func (scheduler *RedisTaskScheduler) ScheduleTaskExpireEscrows(
+	cronspec string,
+	payload *PayloadExpireEscrows,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskExpireEscrows, jsonPayload, opts...)
+	entryID, err := scheduler.scheduler.Register(cronspec, task)
+	if err != nil {
+		return fmt.Errorf("failed to register task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("cronspec", cronspec).
+		Str("entry_id", entryID).Msg("scheduled task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskExpireEscrows(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadExpireEscrows
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	escrows, err := processor.store.ListExpiredEscrows(ctx, payload.BatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to list expired escrows: %w", err)
+	}
+
+	expired := 0
+	for _, escrow := range escrows {
+		_, err = processor.store.ExpireEscrowTx(ctx, escrow.ID)
+		if err != nil {
+			// confirmed, disputed or cancelled since it was listed
+			if errors.Is(err, db.ErrEscrowNotOpen) {
+				continue
+			}
+			// the buyer cannot take the refund until the trader is active again
+			if errors.Is(err, db.ErrTraderNotActive) {
+				log.Warn().Err(err).Int64("escrow_id", escrow.ID).Msg("escrow left open")
+				continue
+			}
+			return fmt.Errorf("failed to expire escrow [%d]: %w", escrow.ID, err)
+		}
+		expired++
+	}
+
+	log.Info().Str("type", task.Type()).Int("expired", expired).Msg("processed task")
+	return nil
+}
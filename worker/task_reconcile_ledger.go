@@ -0,0 +1,76 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskReconcileLedger = "task:reconcile_ledger"
+
+type PayloadReconcileLedger struct {
+	AlertEmail string `json:"alert_email"`
+}
+
+func (scheduler *RedisTaskScheduler) ScheduleTaskReconcileLedger(
+	cronspec string,
+	payload *PayloadReconcileLedger,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskReconcileLedger, jsonPayload, opts...)
+	entryID, err := scheduler.scheduler.Register(cronspec, task)
+	if err != nil {
+		return fmt.Errorf("failed to register task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("cronspec", cronspec).
+		Str("entry_id", entryID).Msg("scheduled task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskReconcileLedger(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadReconcileLedger
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	report, err := processor.store.Reconcile(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to reconcile ledger: %w", err)
+	}
+
+	if report.OK() {
+		log.Info().Str("type", task.Type()).
+			Int64("checked_traders", report.CheckedTraders).Msg("processed task")
+		return nil
+	}
+
+	content, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+
+	log.Error().Str("type", task.Type()).RawJSON("report", content).Msg("ledger drift found")
+
+	if payload.AlertEmail == "" {
+		return nil
+	}
+
+	subject := "Ledger reconciliation mismatch"
+	to := []string{payload.AlertEmail}
+
+	err = processor.mailer.SendEmail(subject, fmt.Sprintf("<pre>%s</pre>", html.EscapeString(string(content))), to, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send reconcile alert: %w", err)
+	}
+	return nil
+}
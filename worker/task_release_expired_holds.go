@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+)
+
+const TaskReleaseExpiredHolds = "task:release_expired_holds"
+
+type PayloadReleaseExpiredHolds struct {
+	BatchSize int32 `json:"batch_size"`
+}
+
+func (scheduler *RedisTaskScheduler) ScheduleTaskReleaseExpiredHolds(
+	cronspec string,
+	payload *PayloadReleaseExpiredHolds,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskReleaseExpiredHolds, jsonPayload, opts...)
+	entryID, err := scheduler.scheduler.Register(cronspec, task)
+	if err != nil {
+		return fmt.Errorf("failed to register task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("cronspec", cronspec).
+		Str("entry_id", entryID).Msg("scheduled task")
+	return nil
+}
+
+func (processor *RedisTaskProcessor) ProcessTaskReleaseExpiredHolds(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadReleaseExpiredHolds
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	holds, err := processor.store.ListExpiredHolds(ctx, payload.BatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to list expired holds: %w", err)
+	}
+
+	released := 0
+	for _, hold := range holds {
+		_, err = processor.store.ExpireHoldTx(ctx, hold.ID)
+		if err != nil {
+			// captured or released since it was listed
+			if errors.Is(err, db.ErrHoldNotActive) {
+				continue
+			}
+			return fmt.Errorf("failed to release hold [%d]: %w", hold.ID, err)
+		}
+		released++
+	}
+
+	log.Info().Str("type", task.Type()).Int("released", released).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/YuanData/allegro-trade/db/mock"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func TestProcessTaskReleaseExpiredHolds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	processor := &RedisTaskProcessor{store: store}
+
+	expired := []db.Hold{
+		{ID: 1, Reason: util.OrderHoldReason, Status: util.ActiveHoldStatus},
+		{ID: 2, Reason: util.WithdrawalHoldReason, Status: util.ActiveHoldStatus},
+	}
+	store.EXPECT().ListExpiredHolds(gomock.Any(), gomock.Eq(int32(100))).Times(1).Return(expired, nil)
+
+	store.EXPECT().ExpireHoldTx(gomock.Any(), gomock.Eq(int64(1))).Times(1).
+		Return(db.HoldResult{Hold: db.Hold{ID: 1, Status: util.ReleasedHoldStatus}}, nil)
+	store.EXPECT().ExpireHoldTx(gomock.Any(), gomock.Eq(int64(2))).Times(1).
+		Return(db.HoldResult{}, fmt.Errorf("hold [2]: %w", db.ErrHoldNotActive))
+
+	payload, err := json.Marshal(PayloadReleaseExpiredHolds{BatchSize: 100})
+	require.NoError(t, err)
+
+	err = processor.ProcessTaskReleaseExpiredHolds(context.Background(), asynq.NewTask(TaskReleaseExpiredHolds, payload))
+	require.NoError(t, err)
+}
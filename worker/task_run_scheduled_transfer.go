@@ -0,0 +1,114 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/rs/zerolog/log"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+const TaskRunScheduledTransfer = "task:run_scheduled_transfer"
+
+type PayloadRunScheduledTransfer struct {
+	ScheduleID    int64     `json:"schedule_id"`
+	ScheduledTime time.Time `json:"scheduled_time"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskRunScheduledTransfer(
+	ctx context.Context,
+	payload *PayloadRunScheduledTransfer,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskRunScheduledTransfer, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskRunScheduledTransfer moves the money of one scheduled run. The record
+// is written under an idempotency key derived from the scheduled time, so a retry
+// after a partial failure never moves the money twice. A schedule paused after the
+// run was dispatched has the run recorded as skipped. Under the skip policy a run
+// without enough funds is recorded as skipped at once; otherwise the run is retried
+// and recorded as failed once its retries are used up.
+func (processor *RedisTaskProcessor) ProcessTaskRunScheduledTransfer(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadRunScheduledTransfer
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	retried, _ := asynq.GetRetryCount(ctx)
+	maxRetry, _ := asynq.GetMaxRetry(ctx)
+
+	arg := db.CreateScheduledTransferRunParams{
+		ScheduleID:    payload.ScheduleID,
+		ScheduledTime: payload.ScheduledTime,
+		Attempts:      int32(retried + 1),
+	}
+
+	result, err := processor.store.RunScheduledTransferTx(ctx, db.RunScheduledTransferTxParams{
+		ScheduleID:    payload.ScheduleID,
+		ScheduledTime: payload.ScheduledTime,
+	})
+	switch {
+	case errors.Is(err, db.ErrRecordNotFound):
+		return fmt.Errorf("scheduled transfer deleted: %w", asynq.SkipRetry)
+	case err == nil:
+		arg.Status = util.SucceededRunStatus
+		arg.RecordID = pgtype.Int8{Int64: result.Transfer.Record.ID, Valid: true}
+	case errors.Is(err, db.ErrScheduleNotActive):
+		// paused after the run was dispatched
+		arg.Status = util.SkippedRunStatus
+		arg.Error = err.Error()
+	case errors.Is(err, db.ErrInsufficientFunds) && result.Schedule.OnInsufficientFunds == util.SkipOnInsufficientFunds:
+		arg.Status = util.SkippedRunStatus
+		arg.Error = err.Error()
+	case errors.Is(err, db.ErrTransferLimitExceeded):
+		// the allowance frees up as the window moves on, so later runs may fit
+		arg.Status = util.SkippedRunStatus
+		arg.Error = err.Error()
+	case errors.Is(err, db.ErrTransferHeld):
+		// a priest decides on the transfer, a retry would find the same review
+		arg.Status = util.HeldRunStatus
+		arg.Error = err.Error()
+	case errors.Is(err, db.ErrTraderNotActive) || errors.Is(err, db.ErrTransferRejected):
+		// retrying cannot help until a priest reactivates the trader
+		arg.Status = util.FailedRunStatus
+		arg.Error = err.Error()
+	case retried < maxRetry:
+		return fmt.Errorf("failed to run scheduled transfer: %w", err)
+	default:
+		arg.Status = util.FailedRunStatus
+		arg.Error = err.Error()
+	}
+
+	run, err := processor.store.CreateScheduledTransferRun(ctx, arg)
+	if err != nil {
+		// already recorded by an earlier attempt
+		if errors.Is(err, db.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to create scheduled transfer run: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Int64("schedule_id", payload.ScheduleID).
+		Str("status", run.Status).Int32("attempts", run.Attempts).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,123 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/YuanData/allegro-trade/db/mock"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+	"github.com/YuanData/allegro-trade/util"
+)
+
+func TestProcessTaskRunScheduledTransfer(t *testing.T) {
+	payload := PayloadRunScheduledTransfer{
+		ScheduleID:    1,
+		ScheduledTime: time.Unix(time.Now().Unix(), 0).UTC(),
+	}
+	schedule := db.ScheduledTransfer{
+		ID:                  payload.ScheduleID,
+		FromTraderID:        2,
+		ToTraderID:          3,
+		Number:              100,
+		OnInsufficientFunds: util.SkipOnInsufficientFunds,
+		Active:              true,
+	}
+
+	testCases := []struct {
+		name       string
+		buildStubs func(store *mockdb.MockStore)
+		checkError func(t *testing.T, err error)
+	}{
+		{
+			name: "OK",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RunScheduledTransferTx(gomock.Any(), gomock.Eq(db.RunScheduledTransferTxParams{
+						ScheduleID:    payload.ScheduleID,
+						ScheduledTime: payload.ScheduledTime,
+					})).
+					Times(1).
+					Return(db.RunScheduledTransferTxResult{
+						Schedule: schedule,
+						Transfer: db.RecordTxResult{Record: db.Record{ID: 4}},
+					}, nil)
+				store.EXPECT().
+					CreateScheduledTransferRun(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateScheduledTransferRunParams) (db.ScheduledTransferRun, error) {
+						require.Equal(t, util.SucceededRunStatus, arg.Status)
+						require.Equal(t, int64(4), arg.RecordID.Int64)
+						return db.ScheduledTransferRun{Status: arg.Status, Attempts: arg.Attempts}, nil
+					})
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "PausedAfterDispatch",
+			buildStubs: func(store *mockdb.MockStore) {
+				paused := schedule
+				paused.Active = false
+
+				store.EXPECT().
+					RunScheduledTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.RunScheduledTransferTxResult{Schedule: paused},
+						fmt.Errorf("scheduled transfer [%d]: %w", paused.ID, db.ErrScheduleNotActive))
+				store.EXPECT().
+					CreateScheduledTransferRun(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateScheduledTransferRunParams) (db.ScheduledTransferRun, error) {
+						require.Equal(t, util.SkippedRunStatus, arg.Status)
+						require.False(t, arg.RecordID.Valid)
+						require.Contains(t, arg.Error, db.ErrScheduleNotActive.Error())
+						return db.ScheduledTransferRun{Status: arg.Status, Attempts: arg.Attempts}, nil
+					})
+			},
+			checkError: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Deleted",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					RunScheduledTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.RunScheduledTransferTxResult{}, db.ErrRecordNotFound)
+				store.EXPECT().
+					CreateScheduledTransferRun(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkError: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, asynq.SkipRetry)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			store := mockdb.NewMockStore(ctrl)
+			tc.buildStubs(store)
+			processor := &RedisTaskProcessor{store: store}
+
+			jsonPayload, err := json.Marshal(payload)
+			require.NoError(t, err)
+
+			err = processor.ProcessTaskRunScheduledTransfer(context.Background(), asynq.NewTask(TaskRunScheduledTransfer, jsonPayload))
+			tc.checkError(t, err)
+		})
+	}
+}
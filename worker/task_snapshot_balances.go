@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+)
+
+const TaskSnapshotBalances = "task:snapshot_balances"
+
+type PayloadSnapshotBalances struct {
+	BatchSize int32 `json:"batch_size"`
+}
+
+func (scheduler *RedisTaskScheduler) ScheduleTaskSnapshotBalances(
+	cronspec string,
+	payload *PayloadSnapshotBalances,
+	opts ...asynq.Option,
+) error {
+	if payload.BatchSize <= 0 {
+		return fmt.Errorf("invalid batch size: %d", payload.BatchSize)
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSnapshotBalances, jsonPayload, opts...)
+	entryID, err := scheduler.scheduler.Register(cronspec, task)
+	if err != nil {
+		return fmt.Errorf("failed to register task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Str("cronspec", cronspec).
+		Str("entry_id", entryID).Msg("scheduled task")
+	return nil
+}
+
+// ProcessTaskSnapshotBalances writes the balance of every trader as of the last
+// midnight in UTC, so balances at a time only replay the details since then.
+// Details carry the start time of their transaction, so the task is meant to
+// run a few minutes after midnight, once transfers begun before it have
+// committed. Running it again for the same midnight rewrites the same balances.
+func (processor *RedisTaskProcessor) ProcessTaskSnapshotBalances(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSnapshotBalances
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+	if payload.BatchSize <= 0 {
+		return fmt.Errorf("invalid batch size %d: %w", payload.BatchSize, asynq.SkipRetry)
+	}
+
+	snapshotTime := time.Now().UTC().Truncate(24 * time.Hour)
+	arg := db.CreateBalanceSnapshotsParams{
+		SnapshotTime: snapshotTime,
+		Limit:        payload.BatchSize,
+	}
+
+	snapshotted := 0
+	for {
+		snapshots, err := processor.store.CreateBalanceSnapshots(ctx, arg)
+		if err != nil {
+			return fmt.Errorf("failed to create balance snapshots after trader [%d]: %w", arg.AfterID, err)
+		}
+		snapshotted += len(snapshots)
+
+		if len(snapshots) < int(arg.Limit) {
+			break
+		}
+		arg.AfterID = snapshots[len(snapshots)-1].TraderID
+	}
+
+	log.Info().Str("type", task.Type()).Time("snapshot_time", snapshotTime).
+		Int("snapshotted", snapshotted).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/require"
+	mockdb "github.com/YuanData/allegro-trade/db/mock"
+	db "github.com/YuanData/allegro-trade/db/sqlc"
+)
+
+func TestProcessTaskSnapshotBalances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	processor := &RedisTaskProcessor{store: store}
+
+	first := store.EXPECT().CreateBalanceSnapshots(gomock.Any(), gomock.Any()).Times(1).
+		Return([]db.BalanceSnapshot{{TraderID: 1}, {TraderID: 2}}, nil)
+	store.EXPECT().CreateBalanceSnapshots(gomock.Any(), gomock.Any()).Times(1).After(first).
+		DoAndReturn(func(_ context.Context, arg db.CreateBalanceSnapshotsParams) ([]db.BalanceSnapshot, error) {
+			require.Equal(t, int64(2), arg.AfterID)
+			return []db.BalanceSnapshot{{TraderID: 3}}, nil
+		})
+
+	payload, err := json.Marshal(PayloadSnapshotBalances{BatchSize: 2})
+	require.NoError(t, err)
+
+	err = processor.ProcessTaskSnapshotBalances(context.Background(), asynq.NewTask(TaskSnapshotBalances, payload))
+	require.NoError(t, err)
+}
+
+func TestProcessTaskSnapshotBalancesInvalidBatchSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mockdb.NewMockStore(ctrl)
+	processor := &RedisTaskProcessor{store: store}
+
+	store.EXPECT().CreateBalanceSnapshots(gomock.Any(), gomock.Any()).Times(0)
+
+	for _, batchSize := range []int32{0, -1} {
+		payload, err := json.Marshal(PayloadSnapshotBalances{BatchSize: batchSize})
+		require.NoError(t, err)
+
+		err = processor.ProcessTaskSnapshotBalances(context.Background(), asynq.NewTask(TaskSnapshotBalances, payload))
+		require.True(t, errors.Is(err, asynq.SkipRetry))
+	}
+}